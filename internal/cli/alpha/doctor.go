@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/doctor"
+)
+
+// NewDoctorCommand returns a new doctor command, providing the `kubebuilder alpha doctor`
+// feature to find the mismatches between the PROJECT file and the files of a project.
+//
+// IMPORTANT: Like `alpha generate`, this command relies on the layout scaffolded by Kubebuilder's
+// go/v4 and kustomize/v2 plugins and is not intended to be used by other projects.
+func NewDoctorCommand() *cobra.Command {
+	opts := doctor.Doctor{}
+
+	doctorCmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check that the project matches the resources tracked in its PROJECT file",
+		Long: `The 'doctor' command cross-checks each resource tracked in the PROJECT file against the files
+and scaffold markers of the project, and reports the mismatches, such as:
+  • APIs without the Go types or their CRD listed in config/crd/kustomization.yaml
+  • controllers and webhooks without their files or not set up in cmd/main.go
+  • types not registered in the scheme of the manager
+  • external and core types tracked with an API or without their import path
+  • scaffold markers removed from the files where the plugins insert code
+
+With --fix, the mechanical findings (e.g. the code wiring a resource into cmd/main.go or
+config/crd/kustomization.yaml) are fixed, and the remaining ones are reported.
+
+The command exits with a non-zero code if any error is found, so it can be used in CI.`,
+		Example: `
+  # Check the project in the current directory
+  kubebuilder alpha doctor
+
+  # Check the project in ./path/to/project and fix the mechanical findings
+  kubebuilder alpha doctor --input-dir="./path/to/project" --fix
+`,
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			return opts.Validate()
+		},
+		Run: func(cmd *cobra.Command, _ []string) {
+			findings, err := opts.Diagnose()
+			if err != nil {
+				slog.Error("failed to check project", "error", err)
+				os.Exit(1)
+			}
+			printFindings(cmd.OutOrStdout(), findings)
+			if doctor.HasErrors(findings) {
+				os.Exit(1)
+			}
+		},
+	}
+
+	doctorCmd.Flags().StringVar(&opts.InputDir, "input-dir", "",
+		"Path to the directory containing the PROJECT file. Defaults to the current working directory.")
+	doctorCmd.Flags().BoolVar(&opts.Fix, "fix", false,
+		"Fix the mechanical findings, such as the code wiring the resources into cmd/main.go.")
+
+	return doctorCmd
+}
+
+// printFindings writes the findings and a summary to w
+func printFindings(w io.Writer, findings []doctor.Finding) {
+	if len(findings) == 0 {
+		_, _ = fmt.Fprintln(w, "No problems found.")
+		return
+	}
+
+	var errs, warnings, fixable int
+	for _, finding := range findings {
+		_, _ = fmt.Fprintln(w, finding)
+		switch finding.Severity {
+		case doctor.Error:
+			errs++
+		case doctor.Warning:
+			warnings++
+		}
+		if finding.Fixable() {
+			fixable++
+		}
+	}
+
+	_, _ = fmt.Fprintf(w, "\nFound %d error(s) and %d warning(s)", errs, warnings)
+	if fixable > 0 {
+		_, _ = fmt.Fprintf(w, ", %d can be fixed with --fix", fixable)
+	}
+	_, _ = fmt.Fprintln(w, ".")
+}
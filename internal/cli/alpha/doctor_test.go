@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alpha
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/doctor"
+)
+
+var _ = Describe("NewDoctorCommand", func() {
+	It("should create the doctor command with its flags", func() {
+		cmd := NewDoctorCommand()
+		Expect(cmd).NotTo(BeNil())
+		Expect(cmd.Use).To(Equal("doctor"))
+		Expect(cmd.Short).NotTo(BeEmpty())
+		Expect(cmd.Example).To(ContainSubstring("kubebuilder alpha doctor"))
+		Expect(cmd.Flags().Lookup("input-dir")).NotTo(BeNil())
+		Expect(cmd.Flags().Lookup("fix")).NotTo(BeNil())
+	})
+})
+
+var _ = Describe("printFindings", func() {
+	It("should report that no problems were found", func() {
+		var out bytes.Buffer
+		printFindings(&out, nil)
+		Expect(out.String()).To(Equal("No problems found.\n"))
+	})
+
+	It("should print the findings and a summary", func() {
+		var out bytes.Buffer
+		printFindings(&out, []doctor.Finding{
+			{Severity: doctor.Warning, Message: `cmd/main.go does not contain the "// +kubebuilder:scaffold:builder" marker`},
+			{Severity: doctor.Error, Resource: "crew.example.com/v1, Kind=Captain", Message: "api/v1/captain_types.go not found"},
+		})
+		Expect(out.String()).To(Equal(`[warning] cmd/main.go does not contain the "// +kubebuilder:scaffold:builder" marker
+[error] crew.example.com/v1, Kind=Captain: api/v1/captain_types.go not found
+
+Found 1 error(s) and 1 warning(s).
+`))
+	})
+})
@@ -0,0 +1,373 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	kustomizecommonv2 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+	golangv4 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+const (
+	mainPath             = "cmd/main.go"
+	crdKustomizationPath = "config/crd/kustomization.yaml"
+)
+
+var (
+	importsMarker     = machinery.NewMarkerFor(mainPath, "imports")
+	schemeMarker      = machinery.NewMarkerFor(mainPath, "scheme")
+	builderMarker     = machinery.NewMarkerFor(mainPath, "builder")
+	crdResourceMarker = machinery.NewMarkerFor(crdKustomizationPath, "crdkustomizeresource")
+)
+
+// projectFile is a file of the project where the resources are wired by the plugins
+type projectFile struct {
+	path    string
+	content string
+	exists  bool
+}
+
+// readProjectFile reads the file at path, which is allowed to not exist
+func readProjectFile(fs afero.Fs, path string) (projectFile, error) {
+	content, err := afero.ReadFile(fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return projectFile{path: path}, nil
+	} else if err != nil {
+		return projectFile{}, fmt.Errorf("failed to read %q file: %w", path, err)
+	}
+	return projectFile{path: path, content: string(content), exists: true}, nil
+}
+
+// contains checks if the file exists and contains code
+func (f projectFile) contains(code string) bool {
+	return f.exists && strings.Contains(f.content, code)
+}
+
+// hasMarkers checks if the file exists and contains all the markers
+func (f projectFile) hasMarkers(markers ...machinery.Marker) bool {
+	for _, marker := range markers {
+		if !f.contains(marker.String()) {
+			return false
+		}
+	}
+	return f.exists
+}
+
+// checker cross-checks the resources of a project against its files
+type checker struct {
+	fs  afero.Fs
+	cfg config.Config
+
+	main             projectFile
+	crdKustomization projectFile
+
+	findings []Finding
+}
+
+// check returns the mismatches between the PROJECT file and the files of the project
+func check(fs afero.Fs, cfg config.Config) ([]Finding, error) {
+	c := &checker{fs: fs, cfg: cfg}
+
+	var err error
+	if c.main, err = readProjectFile(fs, mainPath); err != nil {
+		return nil, err
+	}
+	if c.crdKustomization, err = readProjectFile(fs, crdKustomizationPath); err != nil {
+		return nil, err
+	}
+	c.checkMarkers()
+
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get resources: %w", err)
+	}
+	for _, res := range resources {
+		if err = c.checkResource(res); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.findings, nil
+}
+
+// checkMarkers checks that the files where the resources are wired can be updated by the plugins
+func (c *checker) checkMarkers() {
+	if !c.main.exists {
+		c.add(Finding{
+			Severity: Error,
+			Message:  fmt.Sprintf("%s not found", mainPath),
+			Hint:     "restore the entry point of the manager, which sets up the controllers and webhooks",
+		})
+	}
+
+	for _, check := range []struct {
+		file    projectFile
+		markers []machinery.Marker
+	}{
+		{c.main, []machinery.Marker{importsMarker, schemeMarker, builderMarker}},
+		{c.crdKustomization, []machinery.Marker{crdResourceMarker}},
+	} {
+		if !check.file.exists {
+			continue
+		}
+		for _, marker := range check.markers {
+			if !check.file.contains(marker.String()) {
+				c.add(Finding{
+					Severity: Warning,
+					Message:  fmt.Sprintf("%s does not contain the %q scaffold marker", check.file.path, marker),
+					Hint: "add the marker back where the plugins are expected to insert code, " +
+						"otherwise new resources are not wired in this file",
+				})
+			}
+		}
+	}
+}
+
+// checkResource checks that the files scaffolded for the resource exist and that it is wired into the project
+func (c *checker) checkResource(res resource.Resource) error {
+	if res.API == nil {
+		res.API = &resource.API{}
+	}
+	if res.Webhooks == nil {
+		res.Webhooks = &resource.Webhooks{}
+	}
+	name := fmt.Sprintf("%s/%s, Kind=%s", res.QualifiedGroup(), res.Version, res.Kind)
+
+	if res.IsExternal() || res.Core {
+		if res.HasAPI() {
+			c.add(Finding{
+				Severity: Error,
+				Resource: name,
+				Message:  "external and core types cannot have an API scaffolded",
+				Hint:     "remove the `api` field of the resource from the PROJECT file",
+			})
+		}
+		if res.Path == "" {
+			c.add(Finding{
+				Severity: Error,
+				Resource: name,
+				Message:  "the Go package of the types is unknown",
+				Hint:     "set the `path` field of the resource in the PROJECT file to the import path of the types",
+			})
+		}
+	} else if res.HasAPI() {
+		if err := c.checkAPI(name, res); err != nil {
+			return err
+		}
+	}
+
+	c.checkScheme(name, res)
+
+	if res.HasController() {
+		if err := c.checkControllers(name, res); err != nil {
+			return err
+		}
+	}
+
+	if res.HasDefaultingWebhook() || res.HasValidationWebhook() || res.HasConversionWebhook() {
+		if err := c.checkWebhooks(name, res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkAPI checks that the types of the API exist and that its CRD is listed in the CRD kustomization
+func (c *checker) checkAPI(name string, res resource.Resource) error {
+	typesPath := filepath.Join(c.apiDir(res), strings.ToLower(res.Kind)+"_types.go")
+	if err := c.checkFileExists(name, typesPath, fmt.Sprintf(
+		"restore the file, or remove the API with `kubebuilder delete api --group %s --version %s --kind %s`",
+		res.Group, res.Version, res.Kind)); err != nil {
+		return err
+	}
+
+	if !c.crdKustomization.exists {
+		return nil
+	}
+	crdPath := fmt.Sprintf("bases/%s_%s.yaml", res.QualifiedGroup(), res.Plural)
+	if !c.crdKustomization.contains(crdPath) {
+		finding := Finding{
+			Severity: Error,
+			Resource: name,
+			Message:  fmt.Sprintf("the CRD is not listed in %s", crdKustomizationPath),
+			Hint:     fmt.Sprintf("add %q to the resources of %s", crdPath, crdKustomizationPath),
+		}
+		if c.crdKustomization.hasMarkers(crdResourceMarker) {
+			finding.fix = c.scaffoldFix(kustomizecommonv2.NewCRDKustomizationScaffolder(c.cfg, res))
+		}
+		c.add(finding)
+	}
+
+	return nil
+}
+
+// checkScheme checks that the types of the resource are registered in the scheme of the manager
+func (c *checker) checkScheme(name string, res resource.Resource) {
+	if !c.main.exists || res.Core || res.Path == "" || (!res.HasAPI() && !res.IsExternal()) {
+		return
+	}
+
+	if !c.main.contains(fmt.Sprintf("%q", res.Path)) {
+		finding := Finding{
+			Severity: Error,
+			Resource: name,
+			Message:  fmt.Sprintf("the types are not registered in the scheme of %s", mainPath),
+			Hint:     fmt.Sprintf("import %q and call its AddToScheme function in %s", res.Path, mainPath),
+		}
+		if c.main.hasMarkers(importsMarker, schemeMarker) {
+			finding.fix = c.scaffoldFix(golangv4.NewWireScaffolder(c.cfg, res, golangv4.WireOptions{Resource: true}))
+		}
+		c.add(finding)
+	}
+}
+
+// checkControllers checks that the controllers of the resource exist and are set up by the manager
+func (c *checker) checkControllers(name string, res resource.Resource) error {
+	controllerDir := filepath.Join("internal", "controller")
+	controllerPackage := "controller"
+	if c.cfg.IsMultiGroup() && res.Group != "" {
+		controllerDir = filepath.Join(controllerDir, res.Group)
+		controllerPackage = res.PackageName() + "controller"
+	}
+
+	for _, controllerName := range res.GetControllerNames() {
+		controllerPath := filepath.Join(controllerDir, resource.NormalizeFileName(controllerName)+"_controller.go")
+		if err := c.checkFileExists(name, controllerPath,
+			"restore the file, or remove the controller from the resource in the PROJECT file"); err != nil {
+			return err
+		}
+
+		reconciler := resource.NormalizeReconcilerName(controllerName, res.Kind)
+		if c.main.exists && !c.main.contains(fmt.Sprintf("&%s.%s{", controllerPackage, reconciler)) {
+			finding := Finding{
+				Severity: Error,
+				Resource: name,
+				Message:  fmt.Sprintf("the controller %q is not set up in %s", controllerName, mainPath),
+				Hint:     fmt.Sprintf("call the SetupWithManager method of %s.%s in %s", controllerPackage, reconciler, mainPath),
+			}
+			if c.main.hasMarkers(importsMarker, builderMarker) {
+				finding.fix = c.scaffoldFix(golangv4.NewWireScaffolder(c.cfg, res, golangv4.WireOptions{
+					Controllers: []string{controllerName},
+				}))
+			}
+			c.add(finding)
+		}
+	}
+
+	return nil
+}
+
+// checkWebhooks checks that the webhooks of the resource exist and are set up by the manager
+func (c *checker) checkWebhooks(name string, res resource.Resource) error {
+	webhookDir := filepath.Join("internal", "webhook", res.Version)
+	webhookPackage := "webhook" + res.Version
+	if c.cfg.IsMultiGroup() && res.Group != "" {
+		webhookDir = filepath.Join("internal", "webhook", res.Group, res.Version)
+		webhookPackage = "webhook" + res.ImportAlias()
+	}
+	webhookFile := strings.ToLower(res.Kind) + "_webhook.go"
+	webhookPath := filepath.Join(webhookDir, webhookFile)
+	setup := fmt.Sprintf("%s.Setup%sWebhookWithManager(mgr)", webhookPackage, res.Kind)
+
+	// Deprecated: webhooks were scaffolded under the API before go/v4 decoupled them
+	legacyPath := filepath.Join(c.apiDir(res), webhookFile)
+	isLegacy := false
+	if exists, err := afero.Exists(c.fs, webhookPath); err != nil {
+		return fmt.Errorf("failed to check if %q exists: %w", webhookPath, err)
+	} else if !exists {
+		if isLegacy, err = afero.Exists(c.fs, legacyPath); err != nil {
+			return fmt.Errorf("failed to check if %q exists: %w", legacyPath, err)
+		}
+	}
+	if isLegacy {
+		setup = fmt.Sprintf("(&%s.%s{}).SetupWebhookWithManager(mgr)", res.ImportAlias(), res.Kind)
+	} else if err := c.checkFileExists(name, webhookPath, fmt.Sprintf(
+		"restore the file, or remove the webhooks with `kubebuilder delete webhook --group %s --version %s --kind %s`",
+		res.Group, res.Version, res.Kind)); err != nil {
+		return err
+	}
+
+	if c.main.exists && !c.main.contains(setup) {
+		finding := Finding{
+			Severity: Error,
+			Resource: name,
+			Message:  fmt.Sprintf("the webhooks are not set up in %s", mainPath),
+			Hint:     fmt.Sprintf("call %s in %s", setup, mainPath),
+		}
+		if c.main.hasMarkers(importsMarker, builderMarker) {
+			finding.fix = c.scaffoldFix(golangv4.NewWireScaffolder(c.cfg, res, golangv4.WireOptions{
+				Webhook:      true,
+				IsLegacyPath: isLegacy,
+			}))
+		}
+		c.add(finding)
+	}
+
+	return nil
+}
+
+// apiDir returns the directory of the Go package of the API of the resource
+func (c *checker) apiDir(res resource.Resource) string {
+	if c.cfg.IsMultiGroup() && res.Group != "" {
+		return filepath.Join("api", res.Group, res.Version)
+	}
+	return filepath.Join("api", res.Version)
+}
+
+// checkFileExists adds an Error finding if the file at path, scaffolded for the resource, does not exist
+func (c *checker) checkFileExists(name, path, hint string) error {
+	exists, err := afero.Exists(c.fs, path)
+	if err != nil {
+		return fmt.Errorf("failed to check if %q exists: %w", path, err)
+	}
+	if !exists {
+		c.add(Finding{
+			Severity: Error,
+			Resource: name,
+			Message:  fmt.Sprintf("%s not found", filepath.ToSlash(path)),
+			Hint:     hint,
+		})
+	}
+	return nil
+}
+
+// scaffoldFix returns a fix that runs the scaffolder
+func (c *checker) scaffoldFix(scaffolder plugins.Scaffolder) func(machinery.Filesystem) error {
+	return func(fs machinery.Filesystem) error {
+		scaffolder.InjectFS(fs)
+		if err := scaffolder.Scaffold(); err != nil {
+			return fmt.Errorf("failed to scaffold: %w", err)
+		}
+		return nil
+	}
+}
+
+func (c *checker) add(finding Finding) {
+	c.findings = append(c.findings, finding)
+}
@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"fmt"
+	log "log/slog"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/common"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// Severity indicates how serious a finding is
+type Severity string
+
+const (
+	// Error findings break the build or the deployment of the project
+	Error Severity = "error"
+	// Warning findings prevent the plugins from updating the project files
+	Warning Severity = "warning"
+)
+
+// Finding is a mismatch between the PROJECT file and the files of the project
+type Finding struct {
+	// Severity indicates how serious the finding is
+	Severity Severity
+	// Resource identifies the resource the finding is about, empty for project-wide findings
+	Resource string
+	// Message describes the mismatch
+	Message string
+	// Hint describes how to address the mismatch manually
+	Hint string
+
+	// fix addresses the mismatch, nil if it needs to be addressed manually
+	fix func(fs machinery.Filesystem) error
+}
+
+// Fixable returns true if the finding can be addressed with --fix
+func (f Finding) Fixable() bool {
+	return f.fix != nil
+}
+
+// String implements fmt.Stringer
+func (f Finding) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] ", f.Severity)
+	if f.Resource != "" {
+		fmt.Fprintf(&b, "%s: ", f.Resource)
+	}
+	b.WriteString(f.Message)
+	if f.Fixable() {
+		b.WriteString(" (fixable with --fix)")
+	} else if f.Hint != "" {
+		fmt.Fprintf(&b, "\n    hint: %s", f.Hint)
+	}
+	return b.String()
+}
+
+// Doctor contains configuration for the doctor operation
+type Doctor struct {
+	// InputDir is the path of the project, defaults to the current working directory
+	InputDir string
+	// Fix addresses the mechanical findings, e.g. missing setup code in cmd/main.go
+	Fix bool
+}
+
+// Validate checks the input info provided for the doctor operation
+func (opts *Doctor) Validate() error {
+	inputDir, err := common.GetInputPath(opts.InputDir)
+	if err != nil {
+		return fmt.Errorf("failed to get input path: %w", err)
+	}
+	opts.InputDir = inputDir
+	return nil
+}
+
+// Diagnose cross-checks the resources tracked in the PROJECT file against the files and scaffold markers
+// of the project, addressing the mechanical findings when Fix is set. It returns the findings left.
+func (opts *Doctor) Diagnose() ([]Finding, error) {
+	projectConfig, err := common.LoadProjectConfig(opts.InputDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load project config: %w", err)
+	}
+	cfg := projectConfig.Config()
+	fs := machinery.Filesystem{FS: afero.NewBasePathFs(afero.NewOsFs(), opts.InputDir)}
+
+	findings, err := check(fs.FS, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if !opts.Fix {
+		return findings, nil
+	}
+
+	fixed := 0
+	for _, finding := range findings {
+		if !finding.Fixable() {
+			continue
+		}
+		log.Info("Fixing: "+finding.Message, "resource", finding.Resource)
+		if err = finding.fix(fs); err != nil {
+			return nil, fmt.Errorf("failed to fix %q: %w", finding.Message, err)
+		}
+		fixed++
+	}
+	if fixed == 0 {
+		return findings, nil
+	}
+
+	// Check again, as some findings can only be addressed manually, e.g. if the scaffold markers were removed
+	return check(fs.FS, cfg)
+}
+
+// HasErrors returns true if any of the findings has the Error severity
+func HasErrors(findings []Finding) bool {
+	for _, finding := range findings {
+		if finding.Severity == Error {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package doctor
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+const projectConfig = `domain: example.com
+layout:
+- go.kubebuilder.io/v4
+projectName: test-project
+repo: github.com/example/test-project
+resources:
+- api:
+    crdVersion: v1
+    namespaced: true
+  controller: true
+  domain: example.com
+  group: crew
+  kind: Captain
+  path: github.com/example/test-project/api/v1
+  version: v1
+  webhooks:
+    defaulting: true
+    webhookVersion: v1
+version: "3"
+`
+
+const (
+	schemeImport     = `crewv1 "github.com/example/test-project/api/v1"` + "\n"
+	controllerImport = `"github.com/example/test-project/internal/controller"` + "\n"
+	webhookImport    = `webhookv1 "github.com/example/test-project/internal/webhook/v1"` + "\n"
+	schemeSetup      = `utilruntime.Must(crewv1.AddToScheme(scheme))` + "\n"
+	controllerSetup  = `if err := (&controller.CaptainReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "Failed to create controller", "controller", "captain")
+		os.Exit(1)
+	}
+`
+	webhookSetup = `// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1.SetupCaptainWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "Failed to create webhook", "webhook", "Captain")
+			os.Exit(1)
+		}
+	}
+`
+	crdEntry = "- bases/crew.example.com_captains.yaml\n"
+)
+
+const mainTemplate = `package main
+
+import (
+	"os"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	%s// +kubebuilder:scaffold:imports
+)
+
+var (
+	scheme   = runtime.NewScheme()
+	setupLog = ctrl.Log.WithName("setup")
+)
+
+func init() {
+	%s// +kubebuilder:scaffold:scheme
+}
+
+func main() {
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{Scheme: scheme})
+	if err != nil {
+		os.Exit(1)
+	}
+
+	%s// +kubebuilder:scaffold:builder
+}
+`
+
+const crdKustomizationTemplate = `resources:
+%s# +kubebuilder:scaffold:crdkustomizeresource
+`
+
+var _ = Describe("Doctor", func() {
+	var (
+		opts       Doctor
+		projectDir string
+	)
+
+	writeFile := func(path, content string) {
+		path = filepath.Join(projectDir, path)
+		Expect(os.MkdirAll(filepath.Dir(path), 0o755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0o644)).To(Succeed())
+	}
+
+	readFile := func(path string) string {
+		content, err := os.ReadFile(filepath.Join(projectDir, path))
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	writeMain := func(imports, scheme, builder string) {
+		writeFile(mainPath, fmt.Sprintf(mainTemplate, imports, scheme, builder))
+	}
+
+	messages := func(findings []Finding) []string {
+		result := make([]string, 0, len(findings))
+		for _, finding := range findings {
+			result = append(result, finding.Message)
+		}
+		return result
+	}
+
+	BeforeEach(func() {
+		projectDir = GinkgoT().TempDir()
+		opts = Doctor{InputDir: projectDir}
+
+		writeFile("PROJECT", projectConfig)
+		writeFile("api/v1/captain_types.go", "package v1\n")
+		writeFile("internal/controller/captain_controller.go", "package controller\n")
+		writeFile("internal/webhook/v1/captain_webhook.go", "package v1\n")
+		writeFile(crdKustomizationPath, fmt.Sprintf(crdKustomizationTemplate, crdEntry))
+		writeMain(schemeImport+controllerImport+webhookImport, schemeSetup, controllerSetup+webhookSetup)
+	})
+
+	It("should not report findings for a project that matches its PROJECT file", func() {
+		findings, err := opts.Diagnose()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findings).To(BeEmpty())
+	})
+
+	It("should report the missing files, which cannot be fixed", func() {
+		Expect(os.Remove(filepath.Join(projectDir, "api/v1/captain_types.go"))).To(Succeed())
+		Expect(os.Remove(filepath.Join(projectDir, "internal/controller/captain_controller.go"))).To(Succeed())
+		Expect(os.Remove(filepath.Join(projectDir, "internal/webhook/v1/captain_webhook.go"))).To(Succeed())
+		opts.Fix = true
+
+		findings, err := opts.Diagnose()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(messages(findings)).To(Equal([]string{
+			"api/v1/captain_types.go not found",
+			"internal/controller/captain_controller.go not found",
+			"internal/webhook/v1/captain_webhook.go not found",
+		}))
+		for _, finding := range findings {
+			Expect(finding.Severity).To(Equal(Error))
+			Expect(finding.Resource).To(Equal("crew.example.com/v1, Kind=Captain"))
+			Expect(finding.Fixable()).To(BeFalse())
+		}
+		Expect(HasErrors(findings)).To(BeTrue())
+	})
+
+	When("the resource is not wired into the project", func() {
+		BeforeEach(func() {
+			writeFile(crdKustomizationPath, fmt.Sprintf(crdKustomizationTemplate, ""))
+			writeMain("", "", "")
+		})
+
+		It("should report the missing code as fixable", func() {
+			findings, err := opts.Diagnose()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages(findings)).To(Equal([]string{
+				"the CRD is not listed in config/crd/kustomization.yaml",
+				"the types are not registered in the scheme of cmd/main.go",
+				`the controller "captain" is not set up in cmd/main.go`,
+				"the webhooks are not set up in cmd/main.go",
+			}))
+			for _, finding := range findings {
+				Expect(finding.Fixable()).To(BeTrue())
+			}
+		})
+
+		It("should add the missing code with --fix", func() {
+			opts.Fix = true
+
+			findings, err := opts.Diagnose()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findings).To(BeEmpty())
+
+			Expect(readFile(crdKustomizationPath)).To(ContainSubstring(crdEntry))
+			mainContent := readFile(mainPath)
+			for _, code := range []string{
+				schemeImport, controllerImport, webhookImport, schemeSetup,
+				"&controller.CaptainReconciler{", "webhookv1.SetupCaptainWebhookWithManager(mgr)",
+			} {
+				Expect(mainContent).To(ContainSubstring(strings.TrimSpace(code)))
+			}
+		})
+
+		It("should not fix the files without the scaffold markers", func() {
+			writeFile(mainPath, strings.Replace(readFile(mainPath), "// +kubebuilder:scaffold:builder", "", 1))
+			opts.Fix = true
+
+			findings, err := opts.Diagnose()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(messages(findings)).To(Equal([]string{
+				`cmd/main.go does not contain the "// +kubebuilder:scaffold:builder" scaffold marker`,
+				`the controller "captain" is not set up in cmd/main.go`,
+				"the webhooks are not set up in cmd/main.go",
+			}))
+			Expect(findings[0].Severity).To(Equal(Warning))
+			Expect(findings[1].Fixable()).To(BeFalse())
+			Expect(findings[2].Fixable()).To(BeFalse())
+		})
+	})
+
+	It("should check the webhooks scaffolded under the API with the legacy layout", func() {
+		Expect(os.Rename(
+			filepath.Join(projectDir, "internal/webhook/v1/captain_webhook.go"),
+			filepath.Join(projectDir, "api/v1/captain_webhook.go"),
+		)).To(Succeed())
+
+		findings, err := opts.Diagnose()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(messages(findings)).To(Equal([]string{"the webhooks are not set up in cmd/main.go"}))
+		Expect(findings[0].Hint).To(ContainSubstring("(&crewv1.Captain{}).SetupWebhookWithManager(mgr)"))
+	})
+
+	It("should report external types tracked with an API or without their import path", func() {
+		writeFile("PROJECT", strings.TrimSuffix(projectConfig, "version: \"3\"\n")+`- api:
+    crdVersion: v1
+  domain: io
+  external: true
+  group: cert-manager
+  kind: Certificate
+  version: v1
+version: "3"
+`)
+
+		findings, err := opts.Diagnose()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(messages(findings)).To(Equal([]string{
+			"external and core types cannot have an API scaffolded",
+			"the Go package of the types is unknown",
+		}))
+		Expect(findings[0].Resource).To(Equal("cert-manager.io/v1, Kind=Certificate"))
+	})
+
+	It("should fail if the PROJECT file cannot be loaded", func() {
+		writeFile("PROJECT", "version: [")
+
+		_, err := opts.Diagnose()
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Finding", func() {
+	It("should print the hint of the findings that need to be fixed manually", func() {
+		finding := Finding{
+			Severity: Error,
+			Resource: "crew.example.com/v1, Kind=Captain",
+			Message:  "api/v1/captain_types.go not found",
+			Hint:     "restore the file",
+		}
+		Expect(finding.String()).To(Equal("[error] crew.example.com/v1, Kind=Captain: " +
+			"api/v1/captain_types.go not found\n    hint: restore the file"))
+	})
+})
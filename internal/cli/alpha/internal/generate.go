@@ -22,6 +22,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/common"
@@ -248,15 +249,37 @@ func kubebuilderInit(s store.Store, opts *Generate, tempLicenseFile string) erro
 }
 
 // Creates APIs and Webhooks for the project.
+// Resources with recorded scaffold subcommands are re-scaffolded by running them again, while the
+// subcommands of the other ones are reconstructed from the information stored in the PROJECT file.
+// Resources scaffolded before their subcommands were recorded, e.g. migrated from a former project
+// version, are reconstructed from the PROJECT file except for the parts scaffolded by the records,
+// which are run again on top of them.
 func kubebuilderCreate(s store.Store) error {
 	resources, err := s.Config().GetResources()
 	if err != nil {
 		return fmt.Errorf("failed to get resources: %w", err)
 	}
 
+	records := make(map[resource.GVK][]config.ScaffoldRecord, len(resources))
+	unrecorded := make(map[resource.GVK]resource.Resource, len(resources))
+	for _, r := range resources {
+		if records[r.GVK], err = getScaffoldRecords(s, r.GVK); err != nil {
+			return fmt.Errorf("failed to get scaffold records for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
+		}
+		if !slices.ContainsFunc(records[r.GVK], isCreateResourceRecord) {
+			unrecorded[r.GVK], records[r.GVK] = splitScaffoldRecords(r, records[r.GVK])
+		}
+	}
+
 	// Scaffold APIs first, as controllers and webhooks depend on them
+	// The controllers of the recorded resources are scaffolded along with their API
 	for _, r := range resources {
-		if err = createAPI(r); err != nil {
+		if u, isUnrecorded := unrecorded[r.GVK]; isUnrecorded {
+			err = createAPI(u)
+		} else {
+			err = replayScaffold(r, records[r.GVK], isCreateAPIRecord)
+		}
+		if err != nil {
 			return fmt.Errorf("failed to create API for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
 		}
 	}
@@ -264,7 +287,14 @@ func kubebuilderCreate(s store.Store) error {
 	// Scaffold controllers on top of APIs
 	// Multiple controllers can be scaffolded for the same API
 	for _, r := range resources {
-		if err = createControllers(r); err != nil {
+		u, isUnrecorded := unrecorded[r.GVK]
+		if !isUnrecorded {
+			continue
+		}
+		if err = createControllers(u); err != nil {
+			return fmt.Errorf("failed to create controllers for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
+		}
+		if err = replayScaffold(r, records[r.GVK], isCreateAPIRecord); err != nil {
 			return fmt.Errorf("failed to create controllers for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
 		}
 	}
@@ -272,7 +302,14 @@ func kubebuilderCreate(s store.Store) error {
 	// Scaffold webhooks on top of APIs
 	// Webhooks require the API to exist
 	for _, r := range resources {
-		if err = createWebhook(r); err != nil {
+		if u, isUnrecorded := unrecorded[r.GVK]; isUnrecorded {
+			if err = createWebhook(u); err != nil {
+				return fmt.Errorf("failed to create webhook for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
+			}
+		}
+		if err = replayScaffold(r, records[r.GVK], func(record config.ScaffoldRecord) bool {
+			return !isCreateAPIRecord(record)
+		}); err != nil {
 			return fmt.Errorf("failed to create webhook for %s/%s/%s: %w", r.Group, r.Version, r.Kind, err)
 		}
 	}
@@ -280,6 +317,141 @@ func kubebuilderCreate(s store.Store) error {
 	return nil
 }
 
+// webhookTypeFlags are the flags of the webhook subcommands selecting the webhook types
+var webhookTypeFlags = []string{"defaulting", "programmatic-validation", "conversion"}
+
+// Splits a resource scaffolded before its subcommands were recorded into the resource without the
+// controllers and webhooks scaffolded by the records, and the records to run again on top of it.
+// The webhook types deleted by the records that were scaffolded before them are already missing from
+// the resource, so they are left out of the records.
+func splitScaffoldRecords(
+	res resource.Resource,
+	records []config.ScaffoldRecord,
+) (resource.Resource, []config.ScaffoldRecord) {
+	unrecorded := res.Copy()
+	replayed := make([]config.ScaffoldRecord, 0, len(records))
+	created := make(map[string]bool, len(webhookTypeFlags))
+
+	for _, record := range records {
+		switch record.Command {
+		case "create api":
+			name, _ := getRecordedFlag(record, "controller-name")
+			if name == "" {
+				unrecorded.Controller = false
+			}
+			if unrecorded.Controllers != nil {
+				*unrecorded.Controllers = slices.DeleteFunc(*unrecorded.Controllers, func(c resource.Controller) bool {
+					return c.Name == name
+				})
+			}
+		case "create webhook":
+			for _, flag := range webhookTypeFlags {
+				if value, _ := getRecordedFlag(record, flag); value == "true" {
+					created[flag] = true
+					removeWebhookType(&unrecorded, flag)
+				}
+			}
+		case "delete webhook":
+			record.Flags = slices.DeleteFunc(slices.Clone(record.Flags), func(f string) bool {
+				name, value, _ := strings.Cut(strings.TrimPrefix(f, "--"), "=")
+				return slices.Contains(webhookTypeFlags, name) && value == "true" && !created[name]
+			})
+			deletes := false
+			for _, flag := range webhookTypeFlags {
+				if value, _ := getRecordedFlag(record, flag); value == "true" {
+					created[flag] = false
+					deletes = true
+				}
+			}
+			if !deletes {
+				continue
+			}
+		}
+		replayed = append(replayed, record)
+	}
+
+	return unrecorded, replayed
+}
+
+// Removes a webhook type, identified by the flag selecting it, from the resource.
+func removeWebhookType(res *resource.Resource, flag string) {
+	if res.Webhooks == nil {
+		return
+	}
+
+	switch flag {
+	case "defaulting":
+		res.Webhooks.Defaulting = false
+		res.Webhooks.DefaultingPath = ""
+	case "programmatic-validation":
+		res.Webhooks.Validation = false
+		res.Webhooks.ValidatingAdmissionPolicy = false
+		res.Webhooks.ValidationPath = ""
+	case "conversion":
+		res.Webhooks.Conversion = false
+		res.Webhooks.Spoke = nil
+	}
+	if !res.Webhooks.Defaulting && !res.Webhooks.Validation &&
+		!res.Webhooks.ValidatingAdmissionPolicy && !res.Webhooks.Conversion {
+		res.Webhooks = nil
+	}
+}
+
+// Gets the value of a flag of a recorded subcommand.
+func getRecordedFlag(record config.ScaffoldRecord, name string) (string, bool) {
+	for _, flag := range record.Flags {
+		if value, found := strings.CutPrefix(flag, "--"+name+"="); found {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+// Gets the subcommands recorded as having scaffolded a resource, if the project version records them.
+func getScaffoldRecords(s store.Store, gvk resource.GVK) ([]config.ScaffoldRecord, error) {
+	tracker, tracksProvenance := s.Config().(config.ProvenanceTracker)
+	if !tracksProvenance {
+		return nil, nil
+	}
+
+	records, err := tracker.GetScaffoldRecords(gvk)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get scaffold records: %w", err)
+	}
+	return records, nil
+}
+
+// Checks if a recorded subcommand scaffolded an API or a controller.
+func isCreateAPIRecord(record config.ScaffoldRecord) bool {
+	return record.Command == "create api"
+}
+
+// Checks if a recorded subcommand created the API of the resource, and not only a controller for it.
+func isCreateResourceRecord(record config.ScaffoldRecord) bool {
+	value, _ := getRecordedFlag(record, "resource")
+	return isCreateAPIRecord(record) && value != "false"
+}
+
+// Runs again the recorded subcommands of a resource that match the filter, in the order they were run.
+func replayScaffold(
+	res resource.Resource,
+	records []config.ScaffoldRecord,
+	filter func(config.ScaffoldRecord) bool,
+) error {
+	for _, record := range records {
+		if !filter(record) {
+			continue
+		}
+
+		args := append(record.Args(), getResourceFlags(res)...)
+		if err := util.RunCmd("kubebuilder "+record.Command, "kubebuilder", args...); err != nil {
+			return fmt.Errorf("failed to run kubebuilder %s command: %w", record.Command, err)
+		}
+	}
+
+	return nil
+}
+
 // Migrates the Grafana plugin.
 func migrateGrafanaPlugin(s store.Store, src, des string) error {
 	var grafanaPlugin struct{}
@@ -375,6 +547,9 @@ func migrateAutoUpdatePlugin(s store.Store) error {
 	if autoUpdatePlugin.UseGHModels {
 		args = append(args, "--use-gh-models")
 	}
+	if autoUpdatePlugin.CI != "" {
+		args = append(args, "--ci", autoUpdatePlugin.CI)
+	}
 	if err = util.RunCmd("kubebuilder edit", "kubebuilder", args...); err != nil {
 		return fmt.Errorf("failed to run edit subcommand for Auto plugin: %w", err)
 	}
@@ -423,6 +598,16 @@ func migrateDeployImagePlugin(s store.Store) error {
 	}
 
 	for _, r := range deployImagePlugin.Resources {
+		// Resources with a recorded create api subcommand were already created with the deploy-image plugin
+		gvk := resource.GVK{Group: r.Group, Domain: r.Domain, Version: r.Version, Kind: r.Kind}
+		records, recordsErr := getScaffoldRecords(s, gvk)
+		if recordsErr != nil && !errors.As(recordsErr, &config.ResourceNotFoundError{}) {
+			return fmt.Errorf("failed to get scaffold records for %s/%s/%s: %w", r.Group, r.Version, r.Kind, recordsErr)
+		}
+		if slices.ContainsFunc(records, isCreateResourceRecord) {
+			continue
+		}
+
 		if err := createAPIWithDeployImage(r); err != nil {
 			return fmt.Errorf("failed to create API with deploy-image: %w", err)
 		}
@@ -475,6 +660,10 @@ func getInitArgs(s store.Store, opts *Generate, tempLicenseFile string) []string
 	if len(plugins) > 0 {
 		args = append(args, "--plugins", strings.Join(plugins, ","))
 	}
+	// Keep the project version, as recording the scaffold subcommands depends on it
+	if version := s.Config().GetVersion(); version.Validate() == nil {
+		args = append(args, "--project-version", version.String())
+	}
 	if domain := s.Config().GetDomain(); domain != "" {
 		args = append(args, "--domain", domain)
 	}
@@ -518,6 +707,21 @@ func getGVKFlags(res resource.Resource) []string {
 	return args
 }
 
+// Gets the flags identifying a resource, which are common to all the subcommands that scaffold it.
+func getResourceFlags(res resource.Resource) []string {
+	var args []string
+	if res.Group != "" {
+		args = append(args, "--group", res.Group)
+	}
+	if res.Version != "" {
+		args = append(args, "--version", res.Version)
+	}
+	if res.Kind != "" {
+		args = append(args, "--kind", res.Kind)
+	}
+	return args
+}
+
 // Gets the GVK flags for a Deploy Image resource.
 func getGVKFlagsFromDeployImage(resourceData deployimagev1alpha1.ResourceData) []string {
 	var args []string
@@ -582,11 +786,11 @@ func createControllers(res resource.Resource) error {
 		if !res.Controller {
 			return nil
 		}
-		return createControllerWithName(res, "")
+		return createController(res, resource.Controller{})
 	}
 
 	for _, controller := range *res.Controllers {
-		if err := createControllerWithName(res, controller.Name); err != nil {
+		if err := createController(res, controller); err != nil {
 			return fmt.Errorf("failed to create controller %q: %w", controller.Name, err)
 		}
 	}
@@ -594,8 +798,8 @@ func createControllers(res resource.Resource) error {
 	return nil
 }
 
-// Creates a single controller for a resource with a specific name.
-func createControllerWithName(res resource.Resource, controllerName string) error {
+// Creates a single controller for a resource, named unless it is the legacy controller.
+func createController(res resource.Resource, controller resource.Controller) error {
 	args := append([]string{"create", "api"}, getGVKFlags(res)...)
 
 	// Always set --resource=false since we're only creating the controller
@@ -603,9 +807,10 @@ func createControllerWithName(res resource.Resource, controllerName string) erro
 	args = append(args, "--controller=true")
 
 	// Add controller name if specified
-	if controllerName != "" {
-		args = append(args, "--controller-name", controllerName)
+	if controller.Name != "" {
+		args = append(args, "--controller-name", controller.Name)
 	}
+	args = append(args, getSecondaryResourceFlags(controller)...)
 
 	// Add the external API flags if the resource is external
 	if res.IsExternal() {
@@ -624,6 +829,27 @@ func createControllerWithName(res resource.Resource, controllerName string) erro
 	return nil
 }
 
+// Gets the flags for the resources owned and watched by a controller.
+func getSecondaryResourceFlags(controller resource.Controller) []string {
+	var args []string
+	if len(controller.Owns) != 0 {
+		args = append(args, "--owns", formatGVKs(controller.Owns))
+	}
+	if len(controller.Watches) != 0 {
+		args = append(args, "--watches", formatGVKs(controller.Watches))
+	}
+	return args
+}
+
+// Formats resources as <group>/<version>/<Kind> separated by commas.
+func formatGVKs(gvks []resource.GVK) string {
+	values := make([]string, 0, len(gvks))
+	for _, gvk := range gvks {
+		values = append(values, fmt.Sprintf("%s/%s/%s", gvk.Group, gvk.Version, gvk.Kind))
+	}
+	return strings.Join(values, ",")
+}
+
 // Gets flags for API resource creation.
 func getAPIResourceFlags(res resource.Resource) []string {
 	var args []string
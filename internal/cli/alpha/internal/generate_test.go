@@ -18,6 +18,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -33,6 +34,7 @@ import (
 
 type fakeConfig struct {
 	config.Config
+	version     config.Version
 	pluginChain []string
 	domain      string
 	repo        string
@@ -45,12 +47,13 @@ type fakeConfig struct {
 	plugins     map[string]any
 }
 
-func (f *fakeConfig) GetPluginChain() []string { return f.pluginChain }
-func (f *fakeConfig) GetDomain() string        { return f.domain }
-func (f *fakeConfig) GetRepository() string    { return f.repo }
-func (f *fakeConfig) GetProjectName() string   { return f.projectName }
-func (f *fakeConfig) IsMultiGroup() bool       { return f.multigroup }
-func (f *fakeConfig) IsNamespaced() bool       { return f.namespaced }
+func (f *fakeConfig) GetVersion() config.Version { return f.version }
+func (f *fakeConfig) GetPluginChain() []string   { return f.pluginChain }
+func (f *fakeConfig) GetDomain() string          { return f.domain }
+func (f *fakeConfig) GetRepository() string      { return f.repo }
+func (f *fakeConfig) GetProjectName() string     { return f.projectName }
+func (f *fakeConfig) IsMultiGroup() bool         { return f.multigroup }
+func (f *fakeConfig) IsNamespaced() bool         { return f.namespaced }
 func (f *fakeConfig) GetResources() ([]resource.Resource, error) {
 	if f.getResErr != nil {
 		return nil, f.getResErr
@@ -87,9 +90,24 @@ func (f *fakeConfig) DecodePluginConfig(key string, dst any) error {
 	return nil
 }
 
+// fakeTrackingConfig is a fakeConfig that records how its resources were scaffolded
+type fakeTrackingConfig struct {
+	*fakeConfig
+	records map[resource.GVK][]config.ScaffoldRecord
+}
+
+func (f *fakeTrackingConfig) GetScaffoldRecords(gvk resource.GVK) ([]config.ScaffoldRecord, error) {
+	return f.records[gvk], nil
+}
+
+func (f *fakeTrackingConfig) RecordScaffold(gvk resource.GVK, record config.ScaffoldRecord) error {
+	f.records[gvk] = append(f.records[gvk], record)
+	return nil
+}
+
 type fakeStore struct {
 	store.Store
-	cfg *fakeConfig
+	cfg config.Config
 }
 
 func (f *fakeStore) Config() config.Config { return f.cfg }
@@ -447,6 +465,15 @@ var _ = Describe("generate: get-args-helpers", func() {
 			})
 		})
 
+		Context("when the project version is set", func() {
+			It("keeps the project version", func() {
+				cfg := &fakeConfig{version: config.Version{Number: 3}, pluginChain: []string{"go.kubebuilder.io/v4"}}
+				store := &fakeStore{cfg: cfg}
+				args := getInitArgs(store, &Generate{}, "")
+				Expect(args).To(ContainElements("--project-version", "3"))
+			})
+		})
+
 		Context("when skipGoVersionCheck is false", func() {
 			It("does not include --skip-go-version-check", func() {
 				cfg := &fakeConfig{pluginChain: []string{"go.kubebuilder.io/v4"}, domain: "foo.com", repo: "bar"}
@@ -523,6 +550,27 @@ var _ = Describe("generate: get-args-helpers", func() {
 		})
 	})
 
+	// getSecondaryResourceFlags
+	Context("getSecondaryResourceFlags", func() {
+		It("returns no flags for a controller without secondary resources", func() {
+			Expect(getSecondaryResourceFlags(resource.Controller{Name: "captain"})).To(BeEmpty())
+		})
+		It("returns the owned and watched resources", func() {
+			controller := resource.Controller{
+				Name: "captain",
+				Owns: []resource.GVK{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+					{Group: "networking", Domain: "k8s.io", Version: "v1", Kind: "Ingress"},
+				},
+				Watches: []resource.GVK{{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Sailor"}},
+			}
+			Expect(getSecondaryResourceFlags(controller)).To(Equal([]string{
+				"--owns", "apps/v1/Deployment,networking/v1/Ingress",
+				"--watches", "crew/v1/Sailor",
+			}))
+		})
+	})
+
 	// getWebhookResourceFlags
 	Context("getWebhookResourceFlags", func() {
 		It("returns correct flags for specified resources", func() {
@@ -797,6 +845,77 @@ var _ = Describe("generate: kubebuilder", func() {
 			// Run kubebuilderCreate and verify no errors
 			Expect(kubebuilderCreate(store)).To(Succeed())
 		})
+
+		It("runs again the recorded subcommands of the resources", func() {
+			foo := resource.Resource{Plural: "foos", GVK: resource.GVK{Group: "crew", Version: "v1", Kind: "Foo"}}
+			bar := resource.Resource{
+				Plural:   "bars",
+				GVK:      resource.GVK{Group: "crew", Version: "v1", Kind: "Bar"},
+				API:      &resource.API{CRDVersion: "v1"},
+				Webhooks: &resource.Webhooks{WebhookVersion: "v1", Defaulting: true},
+			}
+			cfg := &fakeTrackingConfig{
+				fakeConfig: &fakeConfig{resources: []resource.Resource{foo, bar}},
+				records: map[resource.GVK][]config.ScaffoldRecord{
+					foo.GVK: {
+						{Command: "create webhook", Plugins: []string{"go.kubebuilder.io/v4"},
+							Flags: []string{"--defaulting-path=/default", "--defaulting=true"}},
+						{Command: "create api", Plugins: []string{"go.kubebuilder.io/v4"},
+							Flags: []string{"--controller-name=foo-backup", "--controller=true", "--resource=true"}},
+					},
+				},
+			}
+			store := &fakeStore{cfg: cfg}
+			Expect(kubebuilderCreate(store)).To(Succeed())
+
+			content, err := os.ReadFile(filepath.Join(kbc.Dir, "kubebuilder.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(Equal([]string{
+				"create api --plugins=go.kubebuilder.io/v4 --controller-name=foo-backup --controller=true " +
+					"--resource=true --group crew --version v1 --kind Foo",
+				"create api --plural bars --group crew --version v1 --kind Bar --resource --namespaced=false " +
+					"--controller=false",
+				"create webhook --plugins=go.kubebuilder.io/v4 --defaulting-path=/default --defaulting=true " +
+					"--group crew --version v1 --kind Foo",
+				"create webhook --plural bars --group crew --version v1 --kind Bar --defaulting",
+			}))
+		})
+
+		It("reconstructs the migrated resources and runs again the subcommands recorded since", func() {
+			baz := resource.Resource{
+				Plural:     "bazs",
+				GVK:        resource.GVK{Group: "crew", Version: "v1", Kind: "Baz"},
+				API:        &resource.API{CRDVersion: "v1", Namespaced: true},
+				Controller: true,
+				Webhooks: &resource.Webhooks{
+					WebhookVersion: "v1", Defaulting: true, Conversion: true, Spoke: []string{"v2"},
+				},
+			}
+			cfg := &fakeTrackingConfig{
+				fakeConfig: &fakeConfig{resources: []resource.Resource{baz}},
+				records: map[resource.GVK][]config.ScaffoldRecord{
+					baz.GVK: {
+						// The validating webhook was scaffolded before the project was migrated
+						{Command: "delete webhook", Flags: []string{"--programmatic-validation=true"}},
+						{Command: "create webhook", Plugins: []string{"go.kubebuilder.io/v4"},
+							Flags: []string{"--conversion=true", "--spoke=v2"}},
+					},
+				},
+			}
+			store := &fakeStore{cfg: cfg}
+			Expect(kubebuilderCreate(store)).To(Succeed())
+
+			content, err := os.ReadFile(filepath.Join(kbc.Dir, "kubebuilder.log"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Split(strings.TrimSpace(string(content)), "\n")).To(Equal([]string{
+				"create api --plural bazs --group crew --version v1 --kind Baz --resource --namespaced " +
+					"--controller=false",
+				"create api --plural bazs --group crew --version v1 --kind Baz --resource=false --controller=true",
+				"create webhook --plural bazs --group crew --version v1 --kind Baz --defaulting",
+				"create webhook --plugins=go.kubebuilder.io/v4 --conversion=true --spoke=v2 " +
+					"--group crew --version v1 --kind Baz",
+			}))
+		})
 	})
 
 	Context("kubebuilderGrafanaEdit", func() {
@@ -1013,6 +1132,16 @@ var _ = Describe("generate: migrate-plugins", func() {
 			store := &fakeStore{cfg: cfg}
 			Expect(migrateAutoUpdatePlugin(store)).To(Succeed())
 		})
+
+		It("migrates Auto Update plugin successfully with another CI system", func() {
+			cfg := &fakeConfig{
+				plugins: map[string]any{
+					"autoupdate.kubebuilder.io/v1-alpha": autoupdatev1alpha.PluginConfig{CI: "gitlab"},
+				},
+			}
+			store := &fakeStore{cfg: cfg}
+			Expect(migrateAutoUpdatePlugin(store)).To(Succeed())
+		})
 	})
 
 	Context("migrateDeployImagePlugin", func() {
@@ -1053,6 +1182,26 @@ var _ = Describe("generate: migrate-plugins", func() {
 			}
 			Expect(migrateDeployImagePlugin(store)).To(Succeed())
 		})
+
+		It("skips the resources with recorded scaffold subcommands", func() {
+			gvk := resource.GVK{Group: "crew", Domain: "example.com", Version: "v1", Kind: "Memcached"}
+			cfg := &fakeTrackingConfig{
+				fakeConfig: &fakeConfig{plugins: map[string]any{
+					"deploy-image.go.kubebuilder.io/v1-alpha": deployimagev1alpha1.PluginConfig{
+						Resources: []deployimagev1alpha1.ResourceData{
+							{Group: gvk.Group, Domain: gvk.Domain, Version: gvk.Version, Kind: gvk.Kind},
+						},
+					},
+				}},
+				records: map[resource.GVK][]config.ScaffoldRecord{
+					gvk: {{Command: "create api", Plugins: []string{"deploy-image.go.kubebuilder.io/v1-alpha"}}},
+				},
+			}
+			Expect(migrateDeployImagePlugin(&fakeStore{cfg: cfg})).To(Succeed())
+
+			_, err := os.Stat(filepath.Join(kbc.Dir, "kubebuilder.log"))
+			Expect(os.IsNotExist(err)).To(BeTrue())
+		})
 	})
 })
 
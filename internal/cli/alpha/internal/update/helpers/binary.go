@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	log "log/slog"
+	"os"
+	"path/filepath"
+)
+
+// CachedBinaryPath returns the path of the binary of the released version in the cache directory,
+// i.e. <cacheDir>/<version>/kubebuilder.
+func CachedBinaryPath(cacheDir, version string) string {
+	return filepath.Join(cacheDir, version, "kubebuilder")
+}
+
+// ValidateLocalBinary checks that the binary at path exists and is a regular file.
+func ValidateLocalBinary(path string) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to find the binary %q: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%q is not a regular file", path)
+	}
+	return nil
+}
+
+// PrepareLocalBinary copies the binary at path to a temporary directory, named kubebuilder and with
+// executable permissions, so that it is used as a downloaded release binary.
+// Returns the temporary directory path containing the binary.
+func PrepareLocalBinary(path, version string) (string, error) {
+	if err := ValidateLocalBinary(path); err != nil {
+		return "", err
+	}
+
+	tempDir, err := os.MkdirTemp("", "kubebuilder"+version+"-")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	if err := copyBinary(path, filepath.Join(tempDir, "kubebuilder")); err != nil {
+		if rmErr := os.RemoveAll(tempDir); rmErr != nil {
+			log.Error("failed to remove temporary directory", "dir", tempDir, "error", rmErr)
+		}
+		return "", err
+	}
+
+	return tempDir, nil
+}
+
+// CacheBinary stores the kubebuilder binary found in dir, e.g. the one downloaded with
+// DownloadReleaseVersionWith, in the cache directory for the released version.
+func CacheBinary(dir, cacheDir, version string) error {
+	dst := CachedBinaryPath(cacheDir, version)
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return fmt.Errorf("failed to create the cache directory: %w", err)
+	}
+	return copyBinary(filepath.Join(dir, "kubebuilder"), dst)
+}
+
+// copyBinary copies the file at src to dst with executable permissions.
+func copyBinary(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return fmt.Errorf("failed to open the binary %q: %w", src, err)
+	}
+	defer func() {
+		if closeErr := in.Close(); closeErr != nil {
+			log.Error("failed to close the binary file", "error", closeErr)
+		}
+	}()
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o755)
+	if err != nil {
+		return fmt.Errorf("failed to create the binary file %q: %w", dst, err)
+	}
+	defer func() {
+		if closeErr := out.Close(); closeErr != nil {
+			err = errors.Join(err, fmt.Errorf("failed to close the binary file %q: %w", dst, closeErr))
+		}
+	}()
+
+	if _, err = io.Copy(out, in); err != nil {
+		return fmt.Errorf("failed to copy the binary to %q: %w", dst, err)
+	}
+
+	if err = os.Chmod(dst, 0o755); err != nil {
+		return fmt.Errorf("failed to make binary executable: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local binaries", func() {
+	const (
+		version = "v4.6.0"
+		content = "#!/bin/sh\necho kubebuilder\n"
+	)
+
+	var (
+		tmpDir string
+		binary string
+	)
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+		binary = filepath.Join(tmpDir, "kubebuilder-"+version)
+		Expect(os.WriteFile(binary, []byte(content), 0o644)).To(Succeed())
+	})
+
+	Context("CachedBinaryPath", func() {
+		It("keys the binaries by version", func() {
+			Expect(CachedBinaryPath("cache", version)).To(Equal(filepath.Join("cache", version, "kubebuilder")))
+		})
+	})
+
+	Context("ValidateLocalBinary", func() {
+		It("accepts regular files", func() {
+			Expect(ValidateLocalBinary(binary)).To(Succeed())
+		})
+
+		It("rejects missing files", func() {
+			Expect(ValidateLocalBinary(filepath.Join(tmpDir, "missing"))).To(MatchError(os.ErrNotExist))
+		})
+
+		It("rejects directories", func() {
+			Expect(ValidateLocalBinary(tmpDir)).To(MatchError(ContainSubstring("is not a regular file")))
+		})
+	})
+
+	Context("PrepareLocalBinary", func() {
+		It("copies the binary as an executable kubebuilder", func() {
+			dir, err := PrepareLocalBinary(binary, version)
+			Expect(err).NotTo(HaveOccurred())
+			DeferCleanup(os.RemoveAll, dir)
+
+			bin := filepath.Join(dir, "kubebuilder")
+			Expect(os.ReadFile(bin)).To(BeEquivalentTo(content))
+			st, err := os.Stat(bin)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(st.Mode() & 0o111).NotTo(BeZero())
+		})
+
+		It("fails for missing binaries", func() {
+			_, err := PrepareLocalBinary(filepath.Join(tmpDir, "missing"), version)
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
+	})
+
+	Context("CacheBinary", func() {
+		It("stores the binary by version", func() {
+			dir := filepath.Join(tmpDir, "download")
+			Expect(os.MkdirAll(dir, 0o755)).To(Succeed())
+			Expect(os.Rename(binary, filepath.Join(dir, "kubebuilder"))).To(Succeed())
+
+			cacheDir := filepath.Join(tmpDir, "cache")
+			Expect(CacheBinary(dir, cacheDir, version)).To(Succeed())
+
+			cached := CachedBinaryPath(cacheDir, version)
+			Expect(os.ReadFile(cached)).To(BeEquivalentTo(content))
+			Expect(ValidateLocalBinary(cached)).To(Succeed())
+		})
+	})
+})
@@ -22,10 +22,11 @@ import (
 	"io/fs"
 	log "log/slog"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"slices"
 	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
 type ConflictSummary struct {
@@ -48,19 +49,21 @@ func isGeneratedKB(path string) bool {
 		strings.HasPrefix(path, "config/crd/bases/") ||
 		strings.HasPrefix(path, "config/rbac/") ||
 		path == "dist/install.yaml" ||
+		path == machinery.DefaultManifestPath ||
 		// Generated deepcopy files
 		strings.HasSuffix(path, "_deepcopy.go")
 }
 
-// FindConflictFiles performs unified conflict detection for both conflict handling and GitHub issue generation
-func FindConflictFiles() ConflictResult {
+// FindConflictFiles performs unified conflict detection for both conflict handling and GitHub issue generation.
+// The git commands run with the gitConfig overrides.
+func FindConflictFiles(gitConfig []string) ConflictResult {
 	result := ConflictResult{
 		SourceFiles:    []string{},
 		GeneratedFiles: []string{},
 	}
 
 	// Use git index for fast conflict detection first
-	gitConflicts := getGitIndexConflicts()
+	gitConflicts := getGitIndexConflicts(gitConfig)
 
 	// Filesystem scan for conflict markers
 	fsConflicts := scanFilesystemForConflicts()
@@ -97,13 +100,13 @@ func FindConflictFiles() ConflictResult {
 }
 
 // DetectConflicts maintains backward compatibility
-func DetectConflicts() ConflictSummary {
-	return FindConflictFiles().Summary
+func DetectConflicts(gitConfig []string) ConflictSummary {
+	return FindConflictFiles(gitConfig).Summary
 }
 
 // getGitIndexConflicts uses git ls-files to quickly find unmerged entries
-func getGitIndexConflicts() []string {
-	out, err := exec.Command("git", "ls-files", "-u").Output()
+func getGitIndexConflicts(gitConfig []string) []string {
+	out, err := GitCmd(gitConfig, "ls-files", "-u").Output()
 	if err != nil {
 		return nil
 	}
@@ -43,7 +43,7 @@ var _ = Describe("Conflict Detection", func() {
 
 	Describe("FindConflictFiles", func() {
 		It("should return a valid ConflictResult structure", func() {
-			result := FindConflictFiles()
+			result := FindConflictFiles(nil)
 
 			// Should have the expected structure
 			Expect(result.SourceFiles).NotTo(BeNil())
@@ -58,7 +58,7 @@ var _ = Describe("Conflict Detection", func() {
 
 	Describe("DetectConflicts", func() {
 		It("should maintain backward compatibility", func() {
-			summary := DetectConflicts()
+			summary := DetectConflicts(nil)
 
 			// Should return a valid ConflictSummary
 			Expect(summary.Makefile).To(BeFalse()) // No conflicts in test environment
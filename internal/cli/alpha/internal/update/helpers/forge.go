@@ -0,0 +1,275 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// Supported forges where the issue tracking the update can be opened
+const (
+	ForgeGitHub   = "github"
+	ForgeGitLab   = "gitlab"
+	ForgeGitea    = "gitea"
+	ForgeMarkdown = "markdown"
+)
+
+// Environment variables holding the tokens used to call the APIs of the forges
+const (
+	GitLabTokenEnv = "GITLAB_TOKEN"
+	GiteaTokenEnv  = "GITEA_TOKEN"
+)
+
+// DefaultIssueFile is the file the markdown forge writes the issue to when no other is provided
+const DefaultIssueFile = "kubebuilder-update-issue.md"
+
+// Forges lists the supported forges
+var Forges = []string{ForgeGitHub, ForgeGitLab, ForgeGitea, ForgeMarkdown}
+
+// Forge is a service hosting the repository, where an issue is opened to track the update
+// and the changes are proposed with a pull (or merge) request.
+type Forge interface {
+	// Name returns a human-readable name of the forge, used in the logs
+	Name() string
+	// CompareURL returns the URL to review the changes of head against base and open a pull request
+	CompareURL(base, head string) string
+	// FindIssue returns the URL of the open issue with the title, or an empty string if there is none
+	FindIssue(title string) (string, error)
+	// CreateIssue opens an issue and returns its URL
+	CreateIssue(title, body string) (string, error)
+	// CommentIssue adds a comment to the issue at the URL returned by CreateIssue
+	CommentIssue(issueURL, body string) error
+}
+
+// ForgeOptions configure the forge returned by NewForge
+type ForgeOptions struct {
+	// Kind is one of Forges. Defaults to ForgeGitHub.
+	Kind string
+	// URL is the base URL of the GitLab or Gitea instance, e.g. https://gitlab.example.com.
+	// Defaults to the host of the origin remote.
+	URL string
+	// Repository is the path of the repository in the forge, e.g. group/project.
+	// Defaults to the path of the origin remote.
+	Repository string
+	// Token authenticates the calls to the API of GitLab or Gitea.
+	// Defaults to the value of GitLabTokenEnv or GiteaTokenEnv.
+	Token string
+	// IssueFile is the file written by the markdown forge. Defaults to DefaultIssueFile.
+	IssueFile string
+}
+
+// NewForge returns the forge described by the options
+func NewForge(opts ForgeOptions) (Forge, error) {
+	switch opts.Kind {
+	case "", ForgeGitHub:
+		return newGitHubForge()
+	case ForgeGitLab:
+		api, repo, err := newForgeAPI(opts, GitLabTokenEnv, "PRIVATE-TOKEN", "")
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure GitLab: %w", err)
+		}
+		return &gitLabForge{api: api, project: repo}, nil
+	case ForgeGitea:
+		api, repo, err := newForgeAPI(opts, GiteaTokenEnv, "Authorization", "token ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to configure Gitea: %w", err)
+		}
+		return &giteaForge{api: api, repo: repo}, nil
+	case ForgeMarkdown:
+		path := opts.IssueFile
+		if path == "" {
+			path = DefaultIssueFile
+		}
+		return &markdownForge{path: path}, nil
+	default:
+		return nil, fmt.Errorf("unsupported forge %q, must be one of %s", opts.Kind, strings.Join(Forges, ", "))
+	}
+}
+
+// forgeAPI is a minimal client of the JSON REST APIs of the forges
+type forgeAPI struct {
+	baseURL string
+	// header and token authenticate the requests
+	header string
+	token  string
+	client *http.Client
+}
+
+// newForgeAPI returns the API client and the repository path of the forge, resolving the defaults
+// of the options from the environment and the origin remote.
+func newForgeAPI(opts ForgeOptions, tokenEnv, header, tokenPrefix string) (*forgeAPI, string, error) {
+	token := opts.Token
+	if token == "" {
+		token = os.Getenv(tokenEnv)
+	}
+	if token == "" {
+		return nil, "", fmt.Errorf("no token provided, set the %s environment variable", tokenEnv)
+	}
+
+	baseURL, repo := strings.TrimSuffix(opts.URL, "/"), opts.Repository
+	if baseURL == "" || repo == "" {
+		out, err := exec.Command("git", "remote", "get-url", "origin").Output()
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to get the URL of the origin remote: %w", err)
+		}
+		remoteURL, remoteRepo, err := parseRemote(strings.TrimSpace(string(out)))
+		if err != nil {
+			return nil, "", err
+		}
+		if baseURL == "" {
+			baseURL = remoteURL
+		}
+		if repo == "" {
+			repo = remoteRepo
+			// Instances served under a path have it in the remote too, e.g. https://example.com/gitlab/group/project
+			if u, err := url.Parse(baseURL); err == nil && u.Path != "" {
+				repo = strings.TrimPrefix(strings.TrimPrefix(repo, strings.Trim(u.Path, "/")), "/")
+			}
+		}
+	}
+
+	return &forgeAPI{
+		baseURL: baseURL,
+		header:  header,
+		token:   tokenPrefix + token,
+		client:  &http.Client{Timeout: 30 * time.Second},
+	}, repo, nil
+}
+
+// parseRemote returns the base URL of the forge and the path of the repository of a Git remote URL,
+// e.g. git@gitlab.com:group/project.git or https://gitlab.com/group/project.git
+func parseRemote(remote string) (baseURL, repo string, err error) {
+	remote = strings.TrimSuffix(strings.TrimSuffix(remote, "/"), ".git")
+	if strings.Contains(remote, "://") {
+		u, err := url.Parse(remote)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to parse the remote URL %q: %w", remote, err)
+		}
+		baseURL = "https://" + u.Hostname()
+		if u.Scheme == "http" || u.Scheme == "https" {
+			baseURL = u.Scheme + "://" + u.Host
+		}
+		repo = strings.TrimPrefix(u.Path, "/")
+	} else if host, path, found := strings.Cut(remote, ":"); found {
+		// scp-like syntax: [user@]host:path
+		if i := strings.LastIndex(host, "@"); i >= 0 {
+			host = host[i+1:]
+		}
+		baseURL, repo = "https://"+host, strings.TrimPrefix(path, "/")
+	}
+
+	if baseURL == "" || repo == "" {
+		return "", "", fmt.Errorf("unable to determine the forge and repository of the remote %q", remote)
+	}
+	return baseURL, repo, nil
+}
+
+// do sends a request to the API with the JSON encoding of in, if not nil,
+// and decodes the JSON response into out, if not nil.
+func (a *forgeAPI) do(method, path string, query url.Values, in, out any) error {
+	endpoint := a.baseURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+
+	var body io.Reader
+	if in != nil {
+		content, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("failed to encode the request: %w", err)
+		}
+		body = bytes.NewReader(content)
+	}
+
+	req, err := http.NewRequest(method, endpoint, body)
+	if err != nil {
+		return fmt.Errorf("failed to build the request: %w", err)
+	}
+	req.Header.Set(a.header, a.token)
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call %s %s: %w", method, path, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<10))
+		return fmt.Errorf("%s %s returned HTTP %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	if out != nil {
+		if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+			return fmt.Errorf("failed to decode the response of %s %s: %w", method, path, err)
+		}
+	}
+	return nil
+}
+
+// markdownForge writes the issue to a markdown file, for forges that are not supported
+// or when the issue is opened by other means, e.g. a CI job.
+type markdownForge struct {
+	path string
+}
+
+func (f *markdownForge) Name() string {
+	return "markdown file"
+}
+
+// CompareURL returns the range of the branches to compare, as there is no forge to link to
+func (f *markdownForge) CompareURL(base, head string) string {
+	return base + "..." + head
+}
+
+func (f *markdownForge) FindIssue(string) (string, error) {
+	return "", nil
+}
+
+func (f *markdownForge) CreateIssue(title, body string) (string, error) {
+	if err := os.WriteFile(f.path, []byte("# "+title+"\n\n"+body), 0o644); err != nil {
+		return "", fmt.Errorf("failed to write the issue to %q: %w", f.path, err)
+	}
+	return f.path, nil
+}
+
+func (f *markdownForge) CommentIssue(_, body string) error {
+	file, err := os.OpenFile(f.path, os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return fmt.Errorf("failed to open %q: %w", f.path, err)
+	}
+	if _, err = file.WriteString("\n---\n\n" + body + "\n"); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to append the comment to %q: %w", f.path, err)
+	}
+	if err = file.Close(); err != nil {
+		return fmt.Errorf("failed to close %q: %w", f.path, err)
+	}
+	return nil
+}
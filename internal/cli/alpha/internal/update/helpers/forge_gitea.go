@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// giteaForge opens the issues with the REST API (v1) of Gitea, which Forgejo also serves
+type giteaForge struct {
+	api *forgeAPI
+	// repo is the "owner/name" of the repository
+	repo string
+}
+
+// giteaIssue is the subset of the Gitea issue fields used
+type giteaIssue struct {
+	Number  int    `json:"number"`
+	Title   string `json:"title"`
+	HTMLURL string `json:"html_url"`
+}
+
+func (f *giteaForge) Name() string {
+	return "Gitea"
+}
+
+func (f *giteaForge) CompareURL(base, head string) string {
+	return fmt.Sprintf("%s/%s/compare/%s...%s", f.api.baseURL, f.repo, base, head)
+}
+
+func (f *giteaForge) FindIssue(title string) (string, error) {
+	var issues []giteaIssue
+	query := url.Values{"state": {"open"}, "type": {"issues"}, "q": {title}}
+	if err := f.api.do(http.MethodGet, f.issuesPath(), query, nil, &issues); err != nil {
+		return "", fmt.Errorf("failed to list Gitea issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.HTMLURL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *giteaForge) CreateIssue(title, body string) (string, error) {
+	var issue giteaIssue
+	in := map[string]string{"title": title, "body": body}
+	if err := f.api.do(http.MethodPost, f.issuesPath(), nil, in, &issue); err != nil {
+		return "", fmt.Errorf("failed to create Gitea issue: %w", err)
+	}
+	return issue.HTMLURL, nil
+}
+
+func (f *giteaForge) CommentIssue(issueURL, body string) error {
+	number := IssueNumberFromURL(issueURL)
+	if number == "" {
+		return fmt.Errorf("unable to determine the Gitea issue of %q", issueURL)
+	}
+	in := map[string]string{"body": body}
+	if err := f.api.do(http.MethodPost, f.issuesPath()+"/"+number+"/comments", nil, in, nil); err != nil {
+		return fmt.Errorf("failed to comment the Gitea issue: %w", err)
+	}
+	return nil
+}
+
+// issuesPath returns the API path of the issues of the repository
+func (f *giteaForge) issuesPath() string {
+	return "/api/v1/repos/" + f.repo + "/issues"
+}
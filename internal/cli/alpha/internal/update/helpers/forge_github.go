@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	log "log/slog"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// gitHubForge opens the issues with the GitHub CLI (`gh`), which has to be installed and authenticated
+type gitHubForge struct {
+	// repo is the "owner/name" of the repository
+	repo string
+}
+
+func newGitHubForge() (*gitHubForge, error) {
+	repoCmd := exec.Command("gh", "repo", "view", "--json", "nameWithOwner", "--jq", ".nameWithOwner")
+	repoBytes, err := repoCmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect GitHub repository via `gh repo view`: %s", err)
+	}
+	return &gitHubForge{repo: strings.TrimSpace(string(repoBytes))}, nil
+}
+
+func (f *gitHubForge) Name() string {
+	return "GitHub"
+}
+
+func (f *gitHubForge) CompareURL(base, head string) string {
+	return fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", f.repo, base, head)
+}
+
+func (f *gitHubForge) FindIssue(title string) (string, error) {
+	out, err := exec.Command("gh", "issue", "list",
+		"--repo", f.repo,
+		"--state", "open",
+		"--search", fmt.Sprintf("in:title \"%s\"", title),
+		"--json", "title,url").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to list GitHub issues: %w", err)
+	}
+	if len(strings.TrimSpace(string(out))) == 0 {
+		return "", nil
+	}
+
+	var issues []struct {
+		Title string `json:"title"`
+		URL   string `json:"url"`
+	}
+	if err := json.Unmarshal(out, &issues); err != nil {
+		return "", fmt.Errorf("failed to parse GitHub issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.URL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *gitHubForge) CreateIssue(title, body string) (string, error) {
+	createCmd := exec.Command("gh", "issue", "create",
+		"--repo", f.repo,
+		"--title", title,
+		"--body", body,
+	)
+	createOut, createErr := createCmd.CombinedOutput()
+	if createErr != nil {
+		return "", fmt.Errorf("failed to create GitHub issue: %v\n%s", createErr, string(createOut))
+	}
+	outStr := string(createOut)
+
+	// Try to extract the issue URL from stdout
+	issueURL := FirstURL(outStr)
+
+	// Fallback: query the just-created issue by title
+	if issueURL == "" {
+		viewCmd := exec.Command("gh", "issue", "list",
+			"--repo", f.repo,
+			"--state", "open",
+			"--search", fmt.Sprintf("in:title \"%s\"", title),
+			"--json", "url",
+			"--jq", ".[0].url",
+		)
+		urlBytes, vErr := viewCmd.Output()
+		if vErr != nil {
+			log.Warn("could not determine issue URL from gh output", "stdout", outStr, "error", vErr)
+		}
+		issueURL = strings.TrimSpace(string(urlBytes))
+	}
+	return issueURL, nil
+}
+
+func (f *gitHubForge) CommentIssue(issueURL, body string) error {
+	target := issueURL
+	if num := IssueNumberFromURL(issueURL); num != "" {
+		target = num
+	}
+	commentCmd := exec.Command("gh", "issue", "comment", "--repo", f.repo, target, "--body", body)
+	commentCmd.Stdout = os.Stdout
+	commentCmd.Stderr = os.Stderr
+	if err := commentCmd.Run(); err != nil {
+		return fmt.Errorf("failed to comment the GitHub issue: %w", err)
+	}
+	return nil
+}
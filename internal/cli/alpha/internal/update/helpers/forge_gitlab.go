@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// gitLabForge opens the issues with the REST API (v4) of GitLab
+type gitLabForge struct {
+	api *forgeAPI
+	// project is the full path of the project, e.g. group/subgroup/project
+	project string
+}
+
+// gitLabIssue is the subset of the GitLab issue fields used
+type gitLabIssue struct {
+	IID    int    `json:"iid"`
+	Title  string `json:"title"`
+	WebURL string `json:"web_url"`
+}
+
+func (f *gitLabForge) Name() string {
+	return "GitLab"
+}
+
+// CompareURL returns the URL to open a merge request of head into base
+func (f *gitLabForge) CompareURL(base, head string) string {
+	query := url.Values{}
+	query.Set("merge_request[source_branch]", head)
+	query.Set("merge_request[target_branch]", base)
+	return fmt.Sprintf("%s/%s/-/merge_requests/new?%s", f.api.baseURL, f.project, query.Encode())
+}
+
+func (f *gitLabForge) FindIssue(title string) (string, error) {
+	var issues []gitLabIssue
+	query := url.Values{"state": {"opened"}, "in": {"title"}, "search": {title}}
+	if err := f.api.do(http.MethodGet, f.issuesPath(), query, nil, &issues); err != nil {
+		return "", fmt.Errorf("failed to list GitLab issues: %w", err)
+	}
+	for _, issue := range issues {
+		if issue.Title == title {
+			return issue.WebURL, nil
+		}
+	}
+	return "", nil
+}
+
+func (f *gitLabForge) CreateIssue(title, body string) (string, error) {
+	var issue gitLabIssue
+	in := map[string]string{"title": title, "description": body}
+	if err := f.api.do(http.MethodPost, f.issuesPath(), nil, in, &issue); err != nil {
+		return "", fmt.Errorf("failed to create GitLab issue: %w", err)
+	}
+	return issue.WebURL, nil
+}
+
+func (f *gitLabForge) CommentIssue(issueURL, body string) error {
+	iid := IssueNumberFromURL(issueURL)
+	if iid == "" {
+		return fmt.Errorf("unable to determine the GitLab issue of %q", issueURL)
+	}
+	in := map[string]string{"body": body}
+	if err := f.api.do(http.MethodPost, f.issuesPath()+"/"+iid+"/notes", nil, in, nil); err != nil {
+		return fmt.Errorf("failed to comment the GitLab issue: %w", err)
+	}
+	return nil
+}
+
+// issuesPath returns the API path of the issues of the project, which is identified by its URL-encoded path
+func (f *gitLabForge) issuesPath() string {
+	return "/api/v4/projects/" + url.PathEscape(f.project) + "/issues"
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// fakeForge serves the subset of the issue APIs of GitLab and Gitea used by the forges
+type fakeForge struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	issues   []map[string]any
+	comments map[string][]string
+	// urlKey and numberKey are the fields holding the web URL and the number of the issues
+	urlKey, numberKey string
+	// authHeader and authValue are expected in every request
+	authHeader, authValue string
+}
+
+func newFakeForge(issuesPath, urlKey, numberKey, authHeader, authValue string) *fakeForge {
+	f := &fakeForge{
+		comments: map[string][]string{}, urlKey: urlKey, numberKey: numberKey,
+		authHeader: authHeader, authValue: authValue,
+	}
+	f.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if r.Header.Get(f.authHeader) != f.authValue {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		path := r.URL.EscapedPath()
+		var in map[string]string
+		if r.Method == http.MethodPost {
+			Expect(json.NewDecoder(r.Body).Decode(&in)).To(Succeed())
+		}
+		switch {
+		case path == issuesPath && r.Method == http.MethodGet:
+			Expect(json.NewEncoder(w).Encode(f.issues)).To(Succeed())
+		case path == issuesPath && r.Method == http.MethodPost:
+			number := len(f.issues) + 1
+			issue := map[string]any{
+				"title": in["title"], f.numberKey: number,
+				f.urlKey: fmt.Sprintf("%s/issues/%d", f.URL, number),
+			}
+			f.issues = append(f.issues, issue)
+			w.WriteHeader(http.StatusCreated)
+			Expect(json.NewEncoder(w).Encode(issue)).To(Succeed())
+		case strings.HasPrefix(path, issuesPath+"/") && r.Method == http.MethodPost:
+			number := strings.Split(strings.TrimPrefix(path, issuesPath+"/"), "/")[0]
+			f.comments[number] = append(f.comments[number], in["body"])
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte("{}"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return f
+}
+
+var _ = Describe("Forges", func() {
+	const title = "[Action Required] Upgrade the Scaffold: v4.5.0 -> v4.6.0"
+
+	// exercise opens an issue, finds it and comments it, as done when opening the issue of an update
+	exercise := func(forge Forge, server *fakeForge, body, commentKey string) {
+		existing, err := forge.FindIssue(title)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing).To(BeEmpty())
+
+		issueURL, err := forge.CreateIssue(title, "checklist")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(issueURL).To(Equal(server.URL + "/issues/1"))
+		Expect(server.issues).To(HaveLen(1))
+		Expect(server.issues[0]).To(HaveKeyWithValue("title", title))
+
+		existing, err = forge.FindIssue(title)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(existing).To(Equal(issueURL))
+
+		Expect(forge.CommentIssue(issueURL, body)).To(Succeed())
+		Expect(server.comments).To(HaveKeyWithValue(commentKey, []string{body}))
+	}
+
+	Context("GitLab", func() {
+		var server *fakeForge
+
+		BeforeEach(func() {
+			server = newFakeForge("/api/v4/projects/group%2Fproject/issues", "web_url", "iid", "PRIVATE-TOKEN", "secret")
+			DeferCleanup(server.Close)
+		})
+
+		It("opens and comments issues through the API", func() {
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitLab, URL: server.URL, Repository: "group/project",
+				Token: "secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forge.Name()).To(Equal("GitLab"))
+
+			exercise(forge, server, "summary", "1")
+		})
+
+		It("links to a new merge request", func() {
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitLab, URL: server.URL, Repository: "group/project",
+				Token: "secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forge.CompareURL("main", "kubebuilder-update")).To(Equal(server.URL +
+				"/group/project/-/merge_requests/new?merge_request%5Bsource_branch%5D=kubebuilder-update" +
+				"&merge_request%5Btarget_branch%5D=main"))
+		})
+
+		It("reads the token from the environment", func() {
+			GinkgoT().Setenv(GitLabTokenEnv, "secret")
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitLab, URL: server.URL, Repository: "group/project"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = forge.CreateIssue(title, "checklist")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("fails without a token", func() {
+			GinkgoT().Setenv(GitLabTokenEnv, "")
+			_, err := NewForge(ForgeOptions{Kind: ForgeGitLab, URL: server.URL, Repository: "group/project"})
+			Expect(err).To(MatchError(ContainSubstring(GitLabTokenEnv)))
+		})
+
+		It("reports the errors of the API", func() {
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitLab, URL: server.URL, Repository: "group/project",
+				Token: "wrong"})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = forge.CreateIssue(title, "checklist")
+			Expect(err).To(MatchError(ContainSubstring("failed to create GitLab issue")))
+			Expect(err).To(MatchError(ContainSubstring("HTTP 401")))
+		})
+	})
+
+	Context("Gitea", func() {
+		var server *fakeForge
+
+		BeforeEach(func() {
+			server = newFakeForge("/api/v1/repos/owner/repo/issues", "html_url", "number", "Authorization", "token secret")
+			DeferCleanup(server.Close)
+		})
+
+		It("opens and comments issues through the API", func() {
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitea, URL: server.URL, Repository: "owner/repo",
+				Token: "secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forge.Name()).To(Equal("Gitea"))
+
+			exercise(forge, server, "summary", "1")
+		})
+
+		It("links to the comparison of the branches", func() {
+			forge, err := NewForge(ForgeOptions{Kind: ForgeGitea, URL: server.URL, Repository: "owner/repo",
+				Token: "secret"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forge.CompareURL("main", "kubebuilder-update")).To(Equal(server.URL +
+				"/owner/repo/compare/main...kubebuilder-update"))
+		})
+	})
+
+	Context("Markdown", func() {
+		It("writes the issue and its comments to the file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "issue.md")
+			forge, err := NewForge(ForgeOptions{Kind: ForgeMarkdown, IssueFile: path})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(forge.CompareURL("main", "kubebuilder-update")).To(Equal("main...kubebuilder-update"))
+
+			existing, err := forge.FindIssue(title)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(BeEmpty())
+
+			issueURL, err := forge.CreateIssue(title, "checklist\n")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(issueURL).To(Equal(path))
+			Expect(forge.CommentIssue(issueURL, "summary")).To(Succeed())
+
+			content, err := os.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("# " + title + "\n\nchecklist\n\n---\n\nsummary\n"))
+		})
+	})
+
+	It("rejects unsupported forges", func() {
+		_, err := NewForge(ForgeOptions{Kind: "bitbucket"})
+		Expect(err).To(MatchError(ContainSubstring(`unsupported forge "bitbucket"`)))
+	})
+
+	DescribeTable("parseRemote",
+		func(remote, expectedURL, expectedRepo string) {
+			baseURL, repo, err := parseRemote(remote)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(baseURL).To(Equal(expectedURL))
+			Expect(repo).To(Equal(expectedRepo))
+		},
+		Entry("scp-like", "git@gitlab.com:group/sub/project.git", "https://gitlab.com", "group/sub/project"),
+		Entry("ssh", "ssh://git@gitea.example.com:2222/owner/repo.git", "https://gitea.example.com", "owner/repo"),
+		Entry("https", "https://gitlab.example.com/group/project.git", "https://gitlab.example.com", "group/project"),
+		Entry("http with port", "http://localhost:3000/owner/repo", "http://localhost:3000", "owner/repo"),
+	)
+})
@@ -0,0 +1,284 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"errors"
+	"fmt"
+	log "log/slog"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrMergeConflict is returned by the merge strategies when both sides changed the same part of a file
+var ErrMergeConflict = errors.New("conflicting changes")
+
+// MergeStrategy is a three-way merge specialised for a file format, able to merge changes that
+// a line-based merge reports as conflicts, e.g. different variables of a Makefile changed on each side.
+type MergeStrategy interface {
+	// Name identifies the strategy in the logs
+	Name() string
+	// Matches returns true if the strategy can merge the file at path
+	Matches(path string) bool
+	// Merge returns the result of applying the changes from base to ours and from base to theirs,
+	// or an error wrapping ErrMergeConflict if they cannot be merged. Base is empty if the file
+	// was added on both sides.
+	Merge(base, ours, theirs []byte) ([]byte, error)
+}
+
+// DefaultMergeStrategies returns the merge strategies for the files that conflict the most during
+// the updates: go.mod, Makefile and kustomization.yaml.
+func DefaultMergeStrategies() []MergeStrategy {
+	return []MergeStrategy{GoModMergeStrategy{}, MakefileMergeStrategy{}, KustomizationMergeStrategy{}}
+}
+
+// ResolveConflicts merges the conflicted files of an ongoing merge with the first matching strategy,
+// using the versions of the index: the merge base, ours and theirs. The files merged cleanly are
+// written and staged, while the others keep the conflict markers written by git.
+// The git commands run with the gitConfig overrides.
+// Returns the paths of the resolved files and of the ones still conflicted.
+func ResolveConflicts(gitConfig []string, strategies []MergeStrategy) (resolved, remaining []string, err error) {
+	conflicted, err := unmergedFiles(gitConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, path := range conflicted {
+		idx := slices.IndexFunc(strategies, func(s MergeStrategy) bool { return s.Matches(path) })
+		if idx < 0 {
+			remaining = append(remaining, path)
+			continue
+		}
+		strategy := strategies[idx]
+
+		if mergeErr := mergeFile(gitConfig, strategy, path); mergeErr != nil {
+			log.Info("Unable to merge "+path+" with the "+strategy.Name()+" strategy", "reason", mergeErr)
+			remaining = append(remaining, path)
+			continue
+		}
+		resolved = append(resolved, path)
+	}
+
+	return resolved, remaining, nil
+}
+
+// mergeFile merges the conflicted file at path with the strategy, then writes and stages the result
+func mergeFile(gitConfig []string, strategy MergeStrategy, path string) error {
+	// Files removed on one side have no version to merge in the index
+	ours, err := indexStage(gitConfig, 2, path)
+	if err != nil {
+		return err
+	}
+	theirs, err := indexStage(gitConfig, 3, path)
+	if err != nil {
+		return err
+	}
+	base, err := indexStage(gitConfig, 1, path)
+	if err != nil {
+		base = nil
+	}
+
+	merged, err := strategy.Merge(base, ours, theirs)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", path, err)
+	}
+	if err := os.WriteFile(path, merged, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := GitCmd(gitConfig, "add", "--", path).Run(); err != nil {
+		return fmt.Errorf("failed to stage %s: %w", path, err)
+	}
+	return nil
+}
+
+// unmergedFiles returns the paths of the files with conflicts in the index
+func unmergedFiles(gitConfig []string) ([]string, error) {
+	out, err := GitCmd(gitConfig, "diff", "--name-only", "--diff-filter=U").Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the conflicted files: %w", err)
+	}
+
+	var files []string
+	for line := range strings.SplitSeq(string(out), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// indexStage returns the content of the file at path in a stage of the index
+func indexStage(gitConfig []string, stage int, path string) ([]byte, error) {
+	out, err := GitCmd(gitConfig, "show", ":"+strconv.Itoa(stage)+":"+path).Output()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stage %d of %s: %w", stage, path, err)
+	}
+	return out, nil
+}
+
+// chunk is a keyed part of a file, e.g. a Makefile target, including its leading comments
+type chunk struct {
+	key  string
+	text string
+}
+
+// chunkResolver merges the text of a chunk changed on both sides, returning false if it cannot
+type chunkResolver func(key, base, ours, theirs string) (string, bool)
+
+// mergeChunks merges the changes from base to ours and from base to theirs of the keyed chunks of a file.
+// The result follows the order of ours, with the chunks only added to theirs placed after the ones
+// preceding them in theirs. The chunks changed on both sides are merged with resolve, if not nil.
+// Returns an error wrapping ErrMergeConflict with the key of the first chunk that cannot be merged.
+func mergeChunks(base, ours, theirs []chunk, resolve chunkResolver) ([]chunk, error) {
+	baseByKey, oursByKey, theirsByKey := chunksByKey(base), chunksByKey(ours), chunksByKey(theirs)
+
+	merge := func(key string) (text string, keep bool, err error) {
+		b, inBase := baseByKey[key]
+		o, inOurs := oursByKey[key]
+		t, inTheirs := theirsByKey[key]
+		switch {
+		case inOurs && inTheirs:
+			switch {
+			case o == t:
+				return o, true, nil
+			case inBase && o == b:
+				return t, true, nil
+			case inBase && t == b:
+				return o, true, nil
+			}
+			if resolve != nil {
+				if text, ok := resolve(key, b, o, t); ok {
+					return text, true, nil
+				}
+			}
+		case inOurs:
+			// Added to ours, or removed from theirs if unchanged in ours
+			if !inBase {
+				return o, true, nil
+			}
+			if o == b {
+				return "", false, nil
+			}
+		case inTheirs:
+			if !inBase {
+				return t, true, nil
+			}
+			if t == b {
+				return "", false, nil
+			}
+		default:
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("%w in %q", ErrMergeConflict, key)
+	}
+
+	merged := make([]chunk, 0, len(ours))
+	for _, c := range ours {
+		text, keep, err := merge(c.key)
+		if err != nil {
+			return nil, err
+		}
+		if keep {
+			merged = append(merged, chunk{key: c.key, text: text})
+		}
+	}
+
+	for i, c := range theirs {
+		if _, inOurs := oursByKey[c.key]; inOurs {
+			continue
+		}
+		text, keep, err := merge(c.key)
+		if err != nil {
+			return nil, err
+		}
+		if !keep {
+			continue
+		}
+
+		pos := 0
+		for j := i - 1; j >= 0; j-- {
+			if k := slices.IndexFunc(merged, func(m chunk) bool { return m.key == theirs[j].key }); k >= 0 {
+				pos = k + 1
+				break
+			}
+		}
+		merged = slices.Insert(merged, pos, chunk{key: c.key, text: text})
+	}
+
+	return merged, nil
+}
+
+// chunksByKey indexes the text of the chunks by their key
+func chunksByKey(chunks []chunk) map[string]string {
+	byKey := make(map[string]string, len(chunks))
+	for _, c := range chunks {
+		byKey[c.key] = c.text
+	}
+	return byKey
+}
+
+// joinChunks returns the content of a file made of the chunks
+func joinChunks(chunks []chunk) []byte {
+	var b strings.Builder
+	for _, c := range chunks {
+		b.WriteString(c.text)
+	}
+	return []byte(b.String())
+}
+
+// chunker splits a file into chunks, making their keys unique by numbering the repeated ones
+type chunker struct {
+	chunks []chunk
+	seen   map[string]int
+	// leading holds the lines preceding the next chunk, e.g. its comments
+	leading strings.Builder
+}
+
+// add appends a chunk with the leading lines and the lines
+func (c *chunker) add(key string, lines ...string) {
+	if c.seen == nil {
+		c.seen = make(map[string]int)
+	}
+	c.seen[key]++
+	if n := c.seen[key]; n > 1 {
+		key = key + "#" + strconv.Itoa(n)
+	}
+
+	text := c.leading.String() + strings.Join(lines, "")
+	c.leading.Reset()
+	c.chunks = append(c.chunks, chunk{key: key, text: text})
+}
+
+// done returns the chunks, with the remaining leading lines in a final chunk
+func (c *chunker) done() []chunk {
+	if c.leading.Len() > 0 {
+		c.add("<end>")
+	}
+	return c.chunks
+}
+
+// splitLines splits content into lines keeping their line endings
+func splitLines(content []byte) []string {
+	return strings.SplitAfter(string(content), "\n")
+}
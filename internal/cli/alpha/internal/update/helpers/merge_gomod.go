@@ -0,0 +1,285 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"fmt"
+	"go/version"
+	"path/filepath"
+	"slices"
+
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/semver"
+)
+
+// GoModMergeStrategy merges go.mod files by directive: the go and toolchain versions and the versions of
+// the required modules are the highest of both sides, while the requirements, replacements, exclusions
+// and tools added or removed on either side are added or removed. The module path and the retract directives
+// are taken from the side that changed them.
+type GoModMergeStrategy struct{}
+
+// Name returns the name of the strategy
+func (GoModMergeStrategy) Name() string {
+	return "go.mod"
+}
+
+// Matches returns true for the go.mod files
+func (GoModMergeStrategy) Matches(path string) bool {
+	return filepath.Base(path) == "go.mod"
+}
+
+// Merge merges the changes from base to ours and theirs to the go.mod file
+func (GoModMergeStrategy) Merge(base, ours, theirs []byte) ([]byte, error) {
+	b, err := modfile.Parse("base/go.mod", base, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the base go.mod: %w", err)
+	}
+	o, err := modfile.Parse("ours/go.mod", ours, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse our go.mod: %w", err)
+	}
+	t, err := modfile.Parse("theirs/go.mod", theirs, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse their go.mod: %w", err)
+	}
+
+	if err := mergeGoModDirectives(b, o, t); err != nil {
+		return nil, err
+	}
+	o.SetRequireSeparateIndirect(mergeGoModRequires(b.Require, o.Require, t.Require))
+	if err := mergeGoModReplaces(b, o, t); err != nil {
+		return nil, err
+	}
+	if err := mergeGoModSets(b, o, t); err != nil {
+		return nil, err
+	}
+	if err := mergeGoModRetracts(b, o, t); err != nil {
+		return nil, err
+	}
+
+	o.Cleanup()
+	merged, err := o.Format()
+	if err != nil {
+		return nil, fmt.Errorf("failed to format the merged go.mod: %w", err)
+	}
+	return merged, nil
+}
+
+// mergeGoModDirectives merges the module path and the highest go and toolchain versions into o
+func mergeGoModDirectives(b, o, t *modfile.File) error {
+	modulePath := func(f *modfile.File) string {
+		if f.Module == nil {
+			return ""
+		}
+		return f.Module.Mod.Path
+	}
+	if bp, op, tp := modulePath(b), modulePath(o), modulePath(t); op != tp {
+		switch {
+		case op == bp:
+			if err := o.AddModuleStmt(tp); err != nil {
+				return fmt.Errorf("failed to set the module path: %w", err)
+			}
+		case tp != bp:
+			return fmt.Errorf("%w in the module path", ErrMergeConflict)
+		}
+	}
+
+	if t.Go != nil && (o.Go == nil || version.Compare("go"+t.Go.Version, "go"+o.Go.Version) > 0) {
+		if err := o.AddGoStmt(t.Go.Version); err != nil {
+			return fmt.Errorf("failed to set the go version: %w", err)
+		}
+	}
+	if t.Toolchain != nil && (o.Toolchain == nil || version.Compare(t.Toolchain.Name, o.Toolchain.Name) > 0) {
+		if err := o.AddToolchainStmt(t.Toolchain.Name); err != nil {
+			return fmt.Errorf("failed to set the toolchain: %w", err)
+		}
+	}
+	return nil
+}
+
+// mergeGoModRequires returns the requirements of both sides with the highest versions, without the ones
+// removed on a side and unchanged on the other. A requirement is indirect if it is indirect on both sides
+// or if only one side changed it to indirect.
+func mergeGoModRequires(base, ours, theirs []*modfile.Require) []*modfile.Require {
+	byPath := func(reqs []*modfile.Require) map[string]*modfile.Require {
+		m := make(map[string]*modfile.Require, len(reqs))
+		for _, r := range reqs {
+			m[r.Mod.Path] = r
+		}
+		return m
+	}
+	baseByPath, oursByPath, theirsByPath := byPath(base), byPath(ours), byPath(theirs)
+
+	var paths []string
+	for _, r := range slices.Concat(ours, theirs) {
+		if !slices.Contains(paths, r.Mod.Path) {
+			paths = append(paths, r.Mod.Path)
+		}
+	}
+
+	merged := make([]*modfile.Require, 0, len(paths))
+	for _, path := range paths {
+		b, o, t := baseByPath[path], oursByPath[path], theirsByPath[path]
+		switch {
+		case o != nil && t != nil:
+			r := &modfile.Require{Mod: o.Mod, Indirect: o.Indirect && t.Indirect}
+			if semver.Compare(t.Mod.Version, o.Mod.Version) > 0 {
+				r.Mod = t.Mod
+			}
+			if b != nil && o.Indirect != t.Indirect {
+				r.Indirect = o.Indirect != b.Indirect && o.Indirect || t.Indirect != b.Indirect && t.Indirect
+			}
+			merged = append(merged, r)
+		case o != nil && (b == nil || o.Mod != b.Mod):
+			// Kept when changed on the side that did not remove it, as go mod tidy can drop it later
+			merged = append(merged, &modfile.Require{Mod: o.Mod, Indirect: o.Indirect})
+		case t != nil && (b == nil || t.Mod != b.Mod):
+			merged = append(merged, &modfile.Require{Mod: t.Mod, Indirect: t.Indirect})
+		}
+	}
+	return merged
+}
+
+// mergeGoModReplaces merges the replace directives added, changed or removed on either side into o
+func mergeGoModReplaces(b, o, t *modfile.File) error {
+	type replaceKey struct{ path, version string }
+	byOld := func(f *modfile.File) map[replaceKey]string {
+		m := make(map[replaceKey]string, len(f.Replace))
+		for _, r := range f.Replace {
+			m[replaceKey{r.Old.Path, r.Old.Version}] = r.New.Path + "@" + r.New.Version
+		}
+		return m
+	}
+	baseByOld, oursByOld := byOld(b), byOld(o)
+
+	for _, r := range t.Replace {
+		key := replaceKey{r.Old.Path, r.Old.Version}
+		bv, inBase := baseByOld[key]
+		ov, inOurs := oursByOld[key]
+		tv := r.New.Path + "@" + r.New.Version
+		if tv == ov || inBase && tv == bv {
+			continue
+		}
+		if inOurs && (!inBase || ov != bv) || !inOurs && inBase {
+			return fmt.Errorf("%w in the replacement of %s", ErrMergeConflict, r.Old.Path)
+		}
+		if err := o.AddReplace(r.Old.Path, r.Old.Version, r.New.Path, r.New.Version); err != nil {
+			return fmt.Errorf("failed to replace %s: %w", r.Old.Path, err)
+		}
+	}
+
+	theirsByOld := byOld(t)
+	for key, bv := range baseByOld {
+		if _, inTheirs := theirsByOld[key]; inTheirs {
+			continue
+		}
+		ov, inOurs := oursByOld[key]
+		if !inOurs {
+			continue
+		}
+		if ov != bv {
+			return fmt.Errorf("%w in the replacement of %s", ErrMergeConflict, key.path)
+		}
+		if err := o.DropReplace(key.path, key.version); err != nil {
+			return fmt.Errorf("failed to drop the replacement of %s: %w", key.path, err)
+		}
+	}
+	return nil
+}
+
+// mergeGoModSets merges the exclude and tool directives added or removed on either side into o
+func mergeGoModSets(b, o, t *modfile.File) error {
+	excludes := func(f *modfile.File) []string {
+		var s []string
+		for _, e := range f.Exclude {
+			s = append(s, e.Mod.Path+"@"+e.Mod.Version)
+		}
+		return s
+	}
+	tools := func(f *modfile.File) []string {
+		var s []string
+		for _, tool := range f.Tool {
+			s = append(s, tool.Path)
+		}
+		return s
+	}
+
+	for _, e := range t.Exclude {
+		key := e.Mod.Path + "@" + e.Mod.Version
+		if !slices.Contains(excludes(b), key) && !slices.Contains(excludes(o), key) {
+			if err := o.AddExclude(e.Mod.Path, e.Mod.Version); err != nil {
+				return fmt.Errorf("failed to exclude %s: %w", key, err)
+			}
+		}
+	}
+	for _, e := range b.Exclude {
+		key := e.Mod.Path + "@" + e.Mod.Version
+		if !slices.Contains(excludes(t), key) {
+			if err := o.DropExclude(e.Mod.Path, e.Mod.Version); err != nil {
+				return fmt.Errorf("failed to drop the exclusion of %s: %w", key, err)
+			}
+		}
+	}
+
+	for _, tool := range t.Tool {
+		if !slices.Contains(tools(b), tool.Path) && !slices.Contains(tools(o), tool.Path) {
+			if err := o.AddTool(tool.Path); err != nil {
+				return fmt.Errorf("failed to add the tool %s: %w", tool.Path, err)
+			}
+		}
+	}
+	for _, tool := range b.Tool {
+		if !slices.Contains(tools(t), tool.Path) {
+			if err := o.DropTool(tool.Path); err != nil {
+				return fmt.Errorf("failed to drop the tool %s: %w", tool.Path, err)
+			}
+		}
+	}
+	return nil
+}
+
+// mergeGoModRetracts merges the retract directives into o, taking the ones of theirs if only theirs changed them
+func mergeGoModRetracts(b, o, t *modfile.File) error {
+	br, or, tr := goModRetracts(b), goModRetracts(o), goModRetracts(t)
+	switch {
+	case or == tr || tr == br:
+		return nil
+	case or != br:
+		return fmt.Errorf("%w in the retract directives", ErrMergeConflict)
+	}
+
+	for _, r := range slices.Clone(o.Retract) {
+		if err := o.DropRetract(r.VersionInterval); err != nil {
+			return fmt.Errorf("failed to drop the retraction of %s: %w", r.Low, err)
+		}
+	}
+	for _, r := range t.Retract {
+		if err := o.AddRetract(r.VersionInterval, r.Rationale); err != nil {
+			return fmt.Errorf("failed to add the retraction of %s: %w", r.Low, err)
+		}
+	}
+	return nil
+}
+
+// goModRetracts returns the retract directives of f in a comparable form
+func goModRetracts(f *modfile.File) string {
+	var s string
+	for _, r := range f.Retract {
+		s += r.Low + "-" + r.High + "\n"
+	}
+	return s
+}
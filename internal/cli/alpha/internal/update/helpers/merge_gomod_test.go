@@ -0,0 +1,128 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GoModMergeStrategy", func() {
+	const base = `module example.com/project
+
+go 1.24.0
+
+require (
+	github.com/onsi/ginkgo/v2 v2.22.0
+	github.com/onsi/gomega v1.36.1
+	k8s.io/apimachinery v0.33.0
+	sigs.k8s.io/controller-runtime v0.21.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	golang.org/x/net v0.38.0 // indirect
+)
+`
+
+	var strategy GoModMergeStrategy
+
+	It("should match the go.mod files", func() {
+		Expect(strategy.Matches("go.mod")).To(BeTrue())
+		Expect(strategy.Matches("tools/go.mod")).To(BeTrue())
+		Expect(strategy.Matches("go.sum")).To(BeFalse())
+	})
+
+	It("should take the highest versions of both sides", func() {
+		// Ours is the new scaffold, theirs the project with the dependencies bumped by the user
+		ours := replaceAll(base,
+			"go 1.24.0", "go 1.25.0",
+			"sigs.k8s.io/controller-runtime v0.21.0", "sigs.k8s.io/controller-runtime v0.22.1",
+			"k8s.io/apimachinery v0.33.0", "k8s.io/apimachinery v0.34.0",
+			"golang.org/x/net v0.38.0", "golang.org/x/net v0.40.0")
+		theirs := replaceAll(base,
+			"github.com/onsi/gomega v1.36.1", "github.com/onsi/gomega v1.38.0",
+			"k8s.io/apimachinery v0.33.0", "k8s.io/apimachinery v0.34.1",
+			"golang.org/x/net v0.38.0", "golang.org/x/net v0.39.0")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(base,
+			"go 1.24.0", "go 1.25.0",
+			"sigs.k8s.io/controller-runtime v0.21.0", "sigs.k8s.io/controller-runtime v0.22.1",
+			"github.com/onsi/gomega v1.36.1", "github.com/onsi/gomega v1.38.0",
+			"k8s.io/apimachinery v0.33.0", "k8s.io/apimachinery v0.34.1",
+			"golang.org/x/net v0.38.0", "golang.org/x/net v0.40.0")))
+	})
+
+	It("should keep the requirements and replacements added on either side", func() {
+		ours := replaceAll(base, "\tgithub.com/go-logr/logr v1.4.2 // indirect\n",
+			"\tgithub.com/go-logr/logr v1.4.2 // indirect\n\tgithub.com/google/uuid v1.6.0 // indirect\n")
+		theirs := replaceAll(base, "\tk8s.io/apimachinery v0.33.0\n",
+			"\tgithub.com/prometheus/client_golang v1.22.0\n\tk8s.io/apimachinery v0.33.0\n") +
+			"\nreplace example.com/fork => ../fork\n"
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(ContainSubstring("\tgithub.com/google/uuid v1.6.0 // indirect\n"))
+		Expect(string(merged)).To(ContainSubstring("\tgithub.com/prometheus/client_golang v1.22.0\n"))
+		Expect(string(merged)).To(ContainSubstring("replace example.com/fork => ../fork\n"))
+	})
+
+	It("should drop the requirements removed on a side and unchanged on the other", func() {
+		ours := replaceAll(base, "\tgithub.com/go-logr/logr v1.4.2 // indirect\n", "")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(base))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).NotTo(ContainSubstring("github.com/go-logr/logr"))
+	})
+
+	It("should fail when both sides change the module path", func() {
+		ours := replaceAll(base, "module example.com/project", "module example.com/ours")
+		theirs := replaceAll(base, "module example.com/project", "module example.com/theirs")
+
+		_, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).To(MatchError(ErrMergeConflict))
+	})
+
+	It("should take the retract directives changed on a single side", func() {
+		theirs := base + "\n// Published by mistake.\nretract v1.0.0\n"
+
+		merged, err := strategy.Merge([]byte(base), []byte(base), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(theirs))
+
+		// Our retract directives are kept when theirs are unchanged
+		merged, err = strategy.Merge([]byte(base), []byte(theirs), []byte(base))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(theirs))
+	})
+
+	It("should fail when both sides change the retract directives differently", func() {
+		ours := base + "\nretract v1.0.0\n"
+		theirs := base + "\nretract v1.1.0\n"
+
+		_, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).To(MatchError(ErrMergeConflict))
+	})
+
+	It("should fail to merge invalid files", func() {
+		_, err := strategy.Merge([]byte(base), []byte(base), []byte("<<<<<<< HEAD\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err).NotTo(MatchError(ErrMergeConflict))
+	})
+})
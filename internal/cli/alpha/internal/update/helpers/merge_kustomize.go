@@ -0,0 +1,216 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+var yamlTopLevelKeyRe = regexp.MustCompile(`^([A-Za-z_][A-Za-z0-9_]*):`)
+
+// kustomizationListFields are the fields of a kustomization merged by item
+var kustomizationListFields = []string{
+	"resources", "patches", "components", "crds", "configurations", "bases", "images", "replacements",
+	"generators", "transformers", "patchesStrategicMerge", "patchesJson6902",
+}
+
+// KustomizationMergeStrategy merges kustomization files by top-level field and, for the lists such as
+// resources and patches, by item, keeping the items commented out by the scaffold as distinct items.
+type KustomizationMergeStrategy struct{}
+
+// Name returns the name of the strategy
+func (KustomizationMergeStrategy) Name() string {
+	return "kustomization"
+}
+
+// Matches returns true for the kustomization files
+func (KustomizationMergeStrategy) Matches(path string) bool {
+	name := filepath.Base(path)
+	return name == "kustomization.yaml" || name == "kustomization.yml"
+}
+
+// Merge merges the changes from base to ours and theirs to the kustomization file
+func (KustomizationMergeStrategy) Merge(base, ours, theirs []byte) ([]byte, error) {
+	merged, err := mergeChunks(parseYAMLFields(base), parseYAMLFields(ours), parseYAMLFields(theirs),
+		mergeKustomizationList)
+	if err != nil {
+		return nil, err
+	}
+	return joinChunks(merged), nil
+}
+
+// mergeKustomizationList merges a list field of a kustomization changed on both sides by item
+func mergeKustomizationList(key, base, ours, theirs string) (string, bool) {
+	if !slices.Contains(kustomizationListFields, key) {
+		return "", false
+	}
+
+	baseHead, baseItems := splitYAMLField(base)
+	oursHead, oursItems := splitYAMLField(ours)
+	theirsHead, theirsItems := splitYAMLField(theirs)
+
+	head, err := mergeChunks(
+		[]chunk{{key: key, text: baseHead}}, []chunk{{key: key, text: oursHead}},
+		[]chunk{{key: key, text: theirsHead}}, nil)
+	if err != nil {
+		return "", false
+	}
+	items, err := mergeChunks(parseYAMLListItems(baseItems), parseYAMLListItems(oursItems),
+		parseYAMLListItems(theirsItems), nil)
+	if err != nil {
+		return "", false
+	}
+
+	return string(joinChunks(head)) + string(joinChunks(items)), true
+}
+
+// parseYAMLFields splits a YAML document into chunks keyed by top-level field. The comments directly
+// preceding a field are part of it, while the ones separated from it by a blank line, such as commented
+// out list items, are part of the previous field.
+func parseYAMLFields(content []byte) []chunk {
+	var c chunker
+	var key string
+	var body, tail []string
+
+	flush := func() {
+		if key != "" {
+			c.add(key, body...)
+		}
+		body = nil
+	}
+
+	for _, line := range splitLines(content) {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case yamlTopLevelKeyRe.MatchString(line):
+			// The trailing comments after the last blank line belong to the new field
+			split := 0
+			for i, l := range tail {
+				if strings.TrimSpace(l) == "" {
+					split = i + 1
+				}
+			}
+			if key == "" {
+				split = 0
+			}
+			body = append(body, tail[:split]...)
+			flush()
+			for _, l := range tail[split:] {
+				c.leading.WriteString(l)
+			}
+			tail = nil
+			key = yamlTopLevelKeyRe.FindStringSubmatch(line)[1]
+			body = []string{line}
+		case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+			tail = append(tail, line)
+		case key == "":
+			c.leading.WriteString(line)
+		default:
+			body = append(body, tail...)
+			body = append(body, line)
+			tail = nil
+		}
+	}
+	if key == "" {
+		for _, l := range tail {
+			c.leading.WriteString(l)
+		}
+	} else {
+		body = append(body, tail...)
+	}
+	flush()
+
+	return c.done()
+}
+
+// splitYAMLField splits the text of a top-level field into its key line, with the preceding comments,
+// and the following lines
+func splitYAMLField(text string) (head string, rest []byte) {
+	lines := splitLines([]byte(text))
+	for i, line := range lines {
+		if yamlTopLevelKeyRe.MatchString(line) {
+			return strings.Join(lines[:i+1], ""), []byte(strings.Join(lines[i+1:], ""))
+		}
+	}
+	return text, nil
+}
+
+// parseYAMLListItems splits the lines of a YAML list into chunks keyed by the first line of the items.
+// Commented out items, as scaffolded for the optional resources, are items too.
+func parseYAMLListItems(content []byte) []chunk {
+	var c chunker
+	var item []string
+	var itemIndent int
+	var itemCommented bool
+
+	flush := func() {
+		if len(item) > 0 {
+			key := strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(uncommentYAMLLine(item[0])), "-"))
+			c.add(key, item...)
+		}
+		item = nil
+	}
+
+	for _, line := range splitLines(content) {
+		uncommented := uncommentYAMLLine(line)
+		trimmed := strings.TrimSpace(uncommented)
+		indent := yamlIndent(uncommented)
+		commented := isYAMLComment(line)
+
+		switch {
+		case strings.TrimSpace(line) == "":
+			flush()
+			c.leading.WriteString(line)
+		case trimmed == "-" || strings.HasPrefix(trimmed, "- "):
+			flush()
+			item = []string{line}
+			itemIndent = indent
+			itemCommented = commented
+		case len(item) > 0 && indent >= itemIndent+2 &&
+			(commented == itemCommented || !itemCommented && yamlIndent(line) >= itemIndent+2):
+			item = append(item, line)
+		default:
+			flush()
+			c.leading.WriteString(line)
+		}
+	}
+	flush()
+
+	return c.done()
+}
+
+// isYAMLComment returns true if the line is a comment
+func isYAMLComment(line string) bool {
+	return strings.HasPrefix(strings.TrimSpace(line), "#")
+}
+
+// uncommentYAMLLine removes the first comment sign of a commented line, keeping its indentation
+func uncommentYAMLLine(line string) string {
+	if !isYAMLComment(line) {
+		return line
+	}
+	i := strings.Index(line, "#")
+	return line[:i] + line[i+1:]
+}
+
+// yamlIndent returns the number of spaces indenting the line
+func yamlIndent(line string) int {
+	return len(line) - len(strings.TrimLeft(line, " "))
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("KustomizationMergeStrategy", func() {
+	const base = `# Adds namespace to all resources.
+namespace: project-system
+
+namePrefix: project-
+
+resources:
+- ../crd
+- ../rbac
+- ../manager
+# [WEBHOOK] To enable webhook, uncomment all the sections with [WEBHOOK] prefix including the one in
+# crd/kustomization.yaml
+#- ../webhook
+# [PROMETHEUS] To enable prometheus monitor, uncomment all sections with 'PROMETHEUS'.
+#- ../prometheus
+
+# Uncomment the patches line if you enable Metrics
+patches:
+- path: manager_metrics_patch.yaml
+  target:
+    kind: Deployment
+
+#- path: manager_webhook_patch.yaml
+#  target:
+#    kind: Deployment
+`
+
+	var strategy KustomizationMergeStrategy
+
+	It("should match the kustomization files", func() {
+		Expect(strategy.Matches("config/default/kustomization.yaml")).To(BeTrue())
+		Expect(strategy.Matches("config/crd/kustomization.yml")).To(BeTrue())
+		Expect(strategy.Matches("config/default/manager_metrics_patch.yaml")).To(BeFalse())
+	})
+
+	It("should merge the items added and uncommented on each side", func() {
+		ours := replaceAll(base, "- ../manager\n", "- ../manager\n- ../network-policy\n")
+		theirs := replaceAll(base,
+			"#- ../webhook", "- ../webhook",
+			"#- path: manager_webhook_patch.yaml\n#  target:\n#    kind: Deployment",
+			"- path: manager_webhook_patch.yaml\n  target:\n    kind: Deployment")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(theirs, "- ../manager\n", "- ../manager\n- ../network-policy\n")))
+	})
+
+	It("should merge changes to different fields", func() {
+		ours := replaceAll(base, "namePrefix: project-", "namePrefix: operator-")
+		theirs := replaceAll(base, "namespace: project-system", "namespace: operators")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(base,
+			"namePrefix: project-", "namePrefix: operator-",
+			"namespace: project-system", "namespace: operators")))
+	})
+
+	It("should fail when both sides change the same item", func() {
+		ours := replaceAll(base, "    kind: Deployment\n\n", "    kind: StatefulSet\n\n")
+		theirs := replaceAll(base, "    kind: Deployment\n\n", "    kind: DaemonSet\n\n")
+
+		_, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).To(MatchError(ErrMergeConflict))
+	})
+
+	It("should fail when both sides change a scalar field", func() {
+		ours := replaceAll(base, "namePrefix: project-", "namePrefix: ours-")
+		theirs := replaceAll(base, "namePrefix: project-", "namePrefix: theirs-")
+
+		_, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).To(MatchError(ErrMergeConflict))
+	})
+})
@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var (
+	makeVariableRe    = regexp.MustCompile(`^(?:export\s+|override\s+)?([A-Za-z0-9_.\-]+)\s*(\?=|::=|:=|\+=|!=|=)`)
+	makeDefineRe      = regexp.MustCompile(`^define\s+(\S+)`)
+	makeConditionalRe = regexp.MustCompile(`^(ifeq|ifneq|ifdef|ifndef)\b`)
+	makeRuleRe        = regexp.MustCompile(`^([^\t#:=][^:=]*?)\s*::?(?:[^=]|$)`)
+)
+
+// MakefileMergeStrategy merges Makefiles by variable, target, define and conditional block, so that
+// the changes to different targets or variables are merged even when they are close in the file.
+type MakefileMergeStrategy struct{}
+
+// Name returns the name of the strategy
+func (MakefileMergeStrategy) Name() string {
+	return "Makefile"
+}
+
+// Matches returns true for the Makefiles
+func (MakefileMergeStrategy) Matches(path string) bool {
+	return filepath.Base(path) == "Makefile"
+}
+
+// Merge merges the changes from base to ours and theirs to the Makefile
+func (MakefileMergeStrategy) Merge(base, ours, theirs []byte) ([]byte, error) {
+	merged, err := mergeChunks(parseMakefile(base), parseMakefile(ours), parseMakefile(theirs), nil)
+	if err != nil {
+		return nil, err
+	}
+	return joinChunks(merged), nil
+}
+
+// parseMakefile splits a Makefile into chunks keyed by variable, target, define or conditional block.
+// Comments, blank lines and .PHONY declarations are part of the chunk that follows them.
+func parseMakefile(content []byte) []chunk {
+	lines := splitLines(content)
+	var c chunker
+
+	for i := 0; i < len(lines); i++ {
+		line := lines[i]
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(line, ".PHONY"):
+			c.leading.WriteString(line)
+		case makeConditionalRe.MatchString(line):
+			end := makeBlockEnd(lines, i, makeConditionalRe, "endif")
+			c.add("if:"+trimmed, lines[i:end+1]...)
+			i = end
+		case makeDefineRe.MatchString(line):
+			name := makeDefineRe.FindStringSubmatch(line)[1]
+			end := makeBlockEnd(lines, i, makeDefineRe, "endef")
+			c.add("define:"+name, lines[i:end+1]...)
+			i = end
+		case makeVariableRe.MatchString(line):
+			name := makeVariableRe.FindStringSubmatch(line)[1]
+			end := makeContinuationEnd(lines, i)
+			c.add("var:"+name, lines[i:end+1]...)
+			i = end
+		case makeRuleRe.MatchString(line):
+			target := makeRuleRe.FindStringSubmatch(line)[1]
+			end := makeContinuationEnd(lines, i)
+			// The recipe is made of the lines indented with a tab, which can be separated by comments
+			for j := end + 1; j < len(lines); j++ {
+				if strings.HasPrefix(lines[j], "\t") {
+					end = j
+				} else if strings.TrimSpace(lines[j]) != "" && !strings.HasPrefix(lines[j], "#") {
+					break
+				}
+			}
+			c.add("rule:"+target, lines[i:end+1]...)
+			i = end
+		default:
+			c.add("line:"+trimmed, line)
+		}
+	}
+
+	return c.done()
+}
+
+// makeContinuationEnd returns the index of the last line of the logical line starting at start,
+// which continues while the lines end with a backslash
+func makeContinuationEnd(lines []string, start int) int {
+	end := start
+	for end+1 < len(lines) && strings.HasSuffix(strings.TrimRight(lines[end], "\r\n"), "\\") {
+		end++
+	}
+	return end
+}
+
+// makeBlockEnd returns the index of the line closing the block starting at start, taking the nested
+// blocks into account, or the last line if it is not closed
+func makeBlockEnd(lines []string, start int, open *regexp.Regexp, closing string) int {
+	depth := 0
+	for i := start; i < len(lines); i++ {
+		trimmed := strings.TrimSpace(lines[i])
+		if open.MatchString(trimmed) {
+			depth++
+		} else if trimmed == closing || strings.HasPrefix(trimmed, closing+" ") {
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(lines) - 1
+}
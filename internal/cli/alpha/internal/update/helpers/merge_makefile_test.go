@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MakefileMergeStrategy", func() {
+	const base = `# Image URL to use all building/pushing image targets
+IMG ?= controller:latest
+
+.PHONY: all
+all: build
+
+##@ Build
+
+.PHONY: build
+build: manifests generate fmt vet ## Build manager binary.
+	go build -o bin/manager cmd/main.go
+
+.PHONY: run
+run: manifests generate fmt vet ## Run a controller from your host.
+	go run ./cmd/main.go
+
+## Tool Versions
+KUSTOMIZE_VERSION ?= v5.6.0
+CONTROLLER_TOOLS_VERSION ?= v0.18.0
+`
+
+	var strategy MakefileMergeStrategy
+
+	It("should match the Makefiles", func() {
+		Expect(strategy.Matches("Makefile")).To(BeTrue())
+		Expect(strategy.Matches("hack/Makefile")).To(BeTrue())
+		Expect(strategy.Matches("Makefile.bak")).To(BeFalse())
+	})
+
+	It("should merge changes to adjacent variables and targets", func() {
+		ours := replaceAll(base,
+			"KUSTOMIZE_VERSION ?= v5.6.0", "KUSTOMIZE_VERSION ?= v5.7.1",
+			"\tgo build -o bin/manager cmd/main.go", "\tgo build -trimpath -o bin/manager cmd/main.go")
+		theirs := replaceAll(base,
+			"CONTROLLER_TOOLS_VERSION ?= v0.18.0", "CONTROLLER_TOOLS_VERSION ?= v0.19.0",
+			"\tgo run ./cmd/main.go", "\tgo run ./cmd/main.go --leader-elect=false")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(base,
+			"KUSTOMIZE_VERSION ?= v5.6.0", "KUSTOMIZE_VERSION ?= v5.7.1",
+			"\tgo build -o bin/manager cmd/main.go", "\tgo build -trimpath -o bin/manager cmd/main.go",
+			"CONTROLLER_TOOLS_VERSION ?= v0.18.0", "CONTROLLER_TOOLS_VERSION ?= v0.19.0",
+			"\tgo run ./cmd/main.go", "\tgo run ./cmd/main.go --leader-elect=false")))
+	})
+
+	It("should keep the targets added on both sides", func() {
+		ours := base + `
+.PHONY: lint
+lint: ## Run golangci-lint linter
+	$(GOLANGCI_LINT) run
+`
+		theirs := replaceAll(base, "\n##@ Build\n", `
+.PHONY: deploy-local
+deploy-local: ## Deploy to the local cluster.
+	kind load docker-image ${IMG}
+
+##@ Build
+`)
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(ours, "\n##@ Build\n", `
+.PHONY: deploy-local
+deploy-local: ## Deploy to the local cluster.
+	kind load docker-image ${IMG}
+
+##@ Build
+`)))
+	})
+
+	It("should merge multi-line variables and conditional blocks", func() {
+		base := `ifeq (,$(shell go env GOBIN))
+GOBIN=$(shell go env GOPATH)/bin
+else
+GOBIN=$(shell go env GOBIN)
+endif
+
+SHELL = /usr/bin/env bash -o pipefail
+ARGS = --a \
+	--b
+`
+		ours := replaceAll(base, "SHELL = /usr/bin/env bash -o pipefail", "SHELL = /usr/bin/env bash -eo pipefail")
+		theirs := replaceAll(base, "\t--b", "\t--b \\\n\t--c")
+
+		merged, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(replaceAll(ours, "\t--b", "\t--b \\\n\t--c")))
+	})
+
+	It("should drop the targets removed on a side and unchanged on the other", func() {
+		theirs := replaceAll(base, `.PHONY: run
+run: manifests generate fmt vet ## Run a controller from your host.
+	go run ./cmd/main.go
+
+`, "")
+
+		merged, err := strategy.Merge([]byte(base), []byte(base), []byte(theirs))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(merged)).To(Equal(theirs))
+	})
+
+	It("should fail when both sides change the same target", func() {
+		ours := replaceAll(base, "\tgo build -o bin/manager cmd/main.go", "\tgo build -o bin/ours cmd/main.go")
+		theirs := replaceAll(base, "\tgo build -o bin/manager cmd/main.go", "\tgo build -o bin/theirs cmd/main.go")
+
+		_, err := strategy.Merge([]byte(base), []byte(ours), []byte(theirs))
+		Expect(err).To(MatchError(ErrMergeConflict))
+		Expect(err.Error()).To(ContainSubstring("rule:build"))
+	})
+})
@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helpers
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Merge", func() {
+	Describe("mergeChunks", func() {
+		base := []chunk{{key: "a", text: "a\n"}, {key: "b", text: "b\n"}, {key: "c", text: "c\n"}}
+
+		It("should apply the changes of both sides", func() {
+			ours := []chunk{{key: "a", text: "A\n"}, {key: "b", text: "b\n"}, {key: "c", text: "c\n"}}
+			theirs := []chunk{{key: "a", text: "a\n"}, {key: "c", text: "C\n"}}
+
+			merged, err := mergeChunks(base, ours, theirs, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal([]chunk{{key: "a", text: "A\n"}, {key: "c", text: "C\n"}}))
+		})
+
+		It("should place the chunks added to theirs after their predecessor", func() {
+			theirs := []chunk{{key: "a", text: "a\n"}, {key: "x", text: "x\n"}, {key: "b", text: "b\n"}, {key: "c", text: "c\n"}}
+
+			merged, err := mergeChunks(base, base, theirs, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal(theirs))
+		})
+
+		It("should fail when a chunk is changed on a side and removed on the other", func() {
+			ours := []chunk{{key: "a", text: "A\n"}, {key: "b", text: "b\n"}, {key: "c", text: "c\n"}}
+			theirs := []chunk{{key: "b", text: "b\n"}, {key: "c", text: "c\n"}}
+
+			_, err := mergeChunks(base, ours, theirs, nil)
+			Expect(err).To(MatchError(ErrMergeConflict))
+		})
+
+		It("should resolve the chunks changed on both sides with the resolver", func() {
+			ours := []chunk{{key: "a", text: "A\n"}}
+			theirs := []chunk{{key: "a", text: "a!\n"}}
+
+			merged, err := mergeChunks(base[:1], ours, theirs, func(_, _, o, t string) (string, bool) {
+				return strings.TrimSpace(o) + strings.TrimSpace(t) + "\n", true
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(merged).To(Equal([]chunk{{key: "a", text: "Aa!\n"}}))
+		})
+	})
+
+	Describe("ResolveConflicts", func() {
+		git := func(args ...string) {
+			cmd := exec.Command("git", args...)
+			out, err := cmd.CombinedOutput()
+			Expect(err).NotTo(HaveOccurred(), string(out))
+		}
+
+		BeforeEach(func() {
+			dir := GinkgoT().TempDir()
+			wd, err := os.Getwd()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(os.Chdir(dir)).To(Succeed())
+			DeferCleanup(os.Chdir, wd)
+
+			git("init", "-q", "-b", "main")
+			git("config", "user.email", "test@example.com")
+			git("config", "user.name", "test")
+			Expect(os.WriteFile("Makefile", []byte("A ?= 1\nB ?= 1\n"), 0o644)).To(Succeed())
+			Expect(os.WriteFile("main.go", []byte("package main\n"), 0o644)).To(Succeed())
+			git("add", "-A")
+			git("commit", "-q", "-m", "base")
+
+			git("checkout", "-q", "-b", "theirs")
+			Expect(os.WriteFile("Makefile", []byte("A ?= 2\nB ?= 1\n"), 0o644)).To(Succeed())
+			Expect(os.WriteFile("main.go", []byte("package theirs\n"), 0o644)).To(Succeed())
+			git("commit", "-q", "-am", "theirs")
+
+			git("checkout", "-q", "main")
+			Expect(os.WriteFile("Makefile", []byte("A ?= 1\nB ?= 2\n"), 0o644)).To(Succeed())
+			Expect(os.WriteFile("main.go", []byte("package ours\n"), 0o644)).To(Succeed())
+			git("commit", "-q", "-am", "ours")
+
+			err = exec.Command("git", "merge", "--no-edit", "--no-commit", "theirs").Run()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should merge and stage the files handled by a strategy", func() {
+			resolved, remaining, err := ResolveConflicts(nil, DefaultMergeStrategies())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved).To(Equal([]string{"Makefile"}))
+			Expect(remaining).To(Equal([]string{"main.go"}))
+
+			content, err := os.ReadFile("Makefile")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("A ?= 2\nB ?= 2\n"))
+
+			unmerged, err := unmergedFiles(nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unmerged).To(Equal([]string{"main.go"}))
+		})
+
+		It("should leave the conflicts when no strategy is enabled", func() {
+			resolved, remaining, err := ResolveConflicts(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resolved).To(BeEmpty())
+			Expect(remaining).To(ConsistOf("Makefile", "main.go"))
+		})
+	})
+})
+
+// replaceAll returns s with each pair of old and new strings replaced
+func replaceAll(s string, oldNew ...string) string {
+	return strings.NewReplacer(oldNew...).Replace(s)
+}
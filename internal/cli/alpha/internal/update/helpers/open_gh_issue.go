@@ -175,8 +175,8 @@ List each conflicted file with a brief suggestion. For GENERATED files:
 - dist/install.yaml: "Fix conflicts; then run: make build-installer"`
 
 // listConflictFiles uses the unified conflict detection from conflict.go
-func listConflictFiles() (src []string, gen []string) {
-	conflicts := FindConflictFiles()
+func listConflictFiles(gitConfig []string) (src []string, gen []string) {
+	conflicts := FindConflictFiles(gitConfig)
 	return conflicts.SourceFiles, conflicts.GeneratedFiles
 }
 
@@ -233,10 +233,11 @@ func listChangedFiles(base, head string) (src []string, gen []string) {
 // BuildFullPrompet builds the AI context and writes it to a temp file.
 // It returns the absolute filepath to pass via --input-file/--file.
 func BuildFullPrompet(
+	gitConfig []string,
 	fromVersion, toVersion, baseBranch, outBranch, compareURL, releaseURL string,
 ) string {
 	changedSrc, changedGen := listChangedFiles(baseBranch, outBranch)
-	conflictSrc, conflictGen := listConflictFiles()
+	conflictSrc, conflictGen := listConflictFiles(gitConfig)
 
 	var ctx strings.Builder
 
@@ -54,6 +54,10 @@ func (opts *Update) Prepare() error {
 	if err != nil {
 		return fmt.Errorf("failed to determine the version to use for the upgrade from: %w", err)
 	}
+	if len(opts.ToVersion) == 0 && len(opts.ToBinary) != 0 {
+		return fmt.Errorf("the --to-version flag is required with --to-binary, " +
+			"to tell the version of the provided binary")
+	}
 	opts.ToVersion = opts.defineToVersion()
 	return nil
 }
@@ -121,6 +121,16 @@ exit 0`
 			Entry("options", &Update{}),
 		)
 
+		It("should require --to-version with --to-binary", func() {
+			const version = `version: "3"`
+			Expect(os.WriteFile(projectFile, []byte(version), 0o644)).To(Succeed())
+
+			options := &Update{FromVersion: "v1.0.0", ToBinary: filepath.Join(tmpDir, "kubebuilder")}
+			err = options.Prepare()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--to-version flag is required with --to-binary"))
+		})
+
 		DescribeTable("Should fail to prepare if project path is undetermined",
 			func(options *Update) {
 				err = options.Prepare()
@@ -209,7 +219,7 @@ exit 0`
 			opts.FromVersion = "v4.5.1"
 			opts.ToVersion = "v4.8.0"
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).ToNot(HaveOccurred())
 
 			logs, readErr := os.ReadFile(logFile)
@@ -231,7 +241,7 @@ exit 0`
 			opts.FromVersion = "v4.5.2"
 			opts.ToVersion = "v4.10.0"
 
-			err = opts.openGitHubIssue(true)
+			err = opts.openIssue(true)
 			Expect(err).ToNot(HaveOccurred())
 
 			logs, _ := os.ReadFile(logFile)
@@ -240,6 +250,29 @@ exit 0`
 			Expect(s).To(ContainSubstring("make manifests generate fmt vet lint-fix"))
 		})
 
+		It("writes the issue to a file with the markdown forge", func() {
+			opts.FromBranch = defaultBranch
+			opts.FromVersion = testFromVersion
+			opts.ToVersion = testToVersion
+			opts.Forge = "markdown"
+			opts.IssueFile = filepath.Join(tmpDir, "issue.md")
+			DeferCleanup(func() { opts.Forge, opts.IssueFile = "", "" })
+
+			err = opts.openIssue(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(opts.Report.IssueURL).To(Equal(opts.IssueFile))
+
+			content, readErr := os.ReadFile(opts.IssueFile)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(fmt.Sprintf("Upgrade the Scaffold: %s -> %s",
+				testFromVersion, testToVersion)))
+			Expect(string(content)).To(ContainSubstring(defaultBranch + "..." + opts.getOutputBranchName()))
+			Expect(string(content)).To(ContainSubstring("Resolve conflicts"))
+
+			_, statErr := os.Stat(logFile)
+			Expect(os.IsNotExist(statErr)).To(BeTrue(), "gh must not be called")
+		})
+
 		It("fails when repo detection fails", func() {
 			failRepo := `#!/bin/bash
 echo "$@" >> "` + logFile + `"
@@ -249,7 +282,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(failRepo, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
@@ -271,7 +304,7 @@ exit 0`
 			opts.FromVersion = testFromVersion
 			opts.ToVersion = testToVersion
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to create GitHub issue: exit status 1"))
 		})
@@ -331,7 +364,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(noGh, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
@@ -346,7 +379,7 @@ fi
 exit 0`
 			Expect(mockBinResponse(authFailGh, mockGh)).To(Succeed())
 
-			err = opts.openGitHubIssue(false)
+			err = opts.openIssue(false)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("failed to detect GitHub repository"))
 		})
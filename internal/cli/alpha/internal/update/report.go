@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
+)
+
+const (
+	// OutputText reports the result of the update with log lines only
+	OutputText = "text"
+	// OutputJSON also writes a JSON report of the update to stdout
+	OutputJSON = "json"
+)
+
+// RunReport summarizes an update run so that automation can act on its result without scraping logs.
+type RunReport struct {
+	// FromVersion is the release version the project was updated from
+	FromVersion string `json:"fromVersion"`
+	// ToVersion is the release version the project was updated to
+	ToVersion string `json:"toVersion"`
+	// FromBranch is the branch with the current state of the project
+	FromBranch string `json:"fromBranch"`
+	// OutputBranch is the branch that receives the result of the update
+	OutputBranch string `json:"outputBranch"`
+	// Branches are the temporary branches used for the 3-way merge
+	Branches ReportBranches `json:"branches"`
+	// HasConflicts indicates if merging the project into the upgraded scaffold had conflicts
+	HasConflicts bool `json:"hasConflicts"`
+	// Conflicts lists the conflicted files, if any
+	Conflicts *ReportConflicts `json:"conflicts,omitempty"`
+	// ResolvedFiles are the conflicted files resolved by the format-aware merges
+	ResolvedFiles []string `json:"resolvedFiles,omitempty"`
+	// MakeTargets are the make targets run on the merge result and their outcome
+	MakeTargets []MakeTargetResult `json:"makeTargets"`
+	// Pushed indicates if the output branch was pushed to the remote repository
+	Pushed bool `json:"pushed"`
+	// IssueURL is the URL of the issue opened to track the update, or the path of the file written
+	// with the markdown forge, if any
+	IssueURL string `json:"issueURL,omitempty"`
+	// Success indicates if the update completed
+	Success bool `json:"success"`
+	// Error describes why the update failed, if it did
+	Error string `json:"error,omitempty"`
+}
+
+// ReportBranches are the temporary branches used for the 3-way merge
+type ReportBranches struct {
+	Ancestor string `json:"ancestor"`
+	Original string `json:"original"`
+	Upgrade  string `json:"upgrade"`
+	Merge    string `json:"merge"`
+}
+
+// ReportConflicts lists the conflicted files of the merge
+type ReportConflicts struct {
+	// Makefile indicates if the Makefile has conflicts
+	Makefile bool `json:"makefile"`
+	// API indicates if any file under api/ or apis/ has conflicts
+	API bool `json:"api"`
+	// AnyGo indicates if any Go file has conflicts
+	AnyGo bool `json:"anyGo"`
+	// SourceFiles are the conflicted files that need to be resolved manually
+	SourceFiles []string `json:"sourceFiles"`
+	// GeneratedFiles are the conflicted files that can be generated again, e.g. with make manifests
+	GeneratedFiles []string `json:"generatedFiles"`
+}
+
+// MakeTargetResult is the outcome of running a make target
+type MakeTargetResult struct {
+	Target    string `json:"target"`
+	Succeeded bool   `json:"succeeded"`
+	Error     string `json:"error,omitempty"`
+}
+
+// newReportConflicts returns the conflicts of the report from the detected ones
+func newReportConflicts(result helpers.ConflictResult) *ReportConflicts {
+	return &ReportConflicts{
+		Makefile:       result.Summary.Makefile,
+		API:            result.Summary.API,
+		AnyGo:          result.Summary.AnyGo,
+		SourceFiles:    result.SourceFiles,
+		GeneratedFiles: result.GeneratedFiles,
+	}
+}
+
+// WriteReport writes the JSON report of the update run to w, recording err as the reason of the failure
+func (opts *Update) WriteReport(w io.Writer, err error) error {
+	report := opts.Report
+	report.FromVersion = opts.FromVersion
+	report.ToVersion = opts.ToVersion
+	report.FromBranch = opts.FromBranch
+	report.OutputBranch = opts.getOutputBranchName()
+	report.Branches = ReportBranches{
+		Ancestor: opts.AncestorBranch,
+		Original: opts.OriginalBranch,
+		Upgrade:  opts.UpgradeBranch,
+		Merge:    opts.MergeBranch,
+	}
+	if report.MakeTargets == nil {
+		report.MakeTargets = []MakeTargetResult{}
+	}
+	report.Success = err == nil
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to encode the update report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package update
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WriteReport", func() {
+	var opts Update
+
+	BeforeEach(func() {
+		opts = Update{
+			FromVersion:    "v4.5.0",
+			ToVersion:      "v4.6.0",
+			FromBranch:     "main",
+			AncestorBranch: "tmp-ancestor-X",
+			OriginalBranch: "tmp-original-X",
+			UpgradeBranch:  "tmp-upgrade-X",
+			MergeBranch:    "tmp-merge-X",
+		}
+	})
+
+	It("writes the result of a successful update", func() {
+		opts.Report.HasConflicts = true
+		opts.Report.Conflicts = &ReportConflicts{
+			AnyGo:          true,
+			SourceFiles:    []string{"internal/controller/captain_controller.go"},
+			GeneratedFiles: []string{},
+		}
+		opts.Report.MakeTargets = []MakeTargetResult{
+			{Target: "manifests", Succeeded: true},
+			{Target: "generate", Succeeded: false, Error: "exit status 2"},
+		}
+
+		var out bytes.Buffer
+		Expect(opts.WriteReport(&out, nil)).To(Succeed())
+		Expect(out.String()).To(MatchJSON(`{
+			"fromVersion": "v4.5.0",
+			"toVersion": "v4.6.0",
+			"fromBranch": "main",
+			"outputBranch": "kubebuilder-update-from-v4.5.0-to-v4.6.0",
+			"branches": {
+				"ancestor": "tmp-ancestor-X",
+				"original": "tmp-original-X",
+				"upgrade": "tmp-upgrade-X",
+				"merge": "tmp-merge-X"
+			},
+			"hasConflicts": true,
+			"conflicts": {
+				"makefile": false,
+				"api": false,
+				"anyGo": true,
+				"sourceFiles": ["internal/controller/captain_controller.go"],
+				"generatedFiles": []
+			},
+			"makeTargets": [
+				{"target": "manifests", "succeeded": true},
+				{"target": "generate", "succeeded": false, "error": "exit status 2"}
+			],
+			"pushed": false,
+			"success": true
+		}`))
+	})
+
+	It("writes the reason of a failed update", func() {
+		opts.OutputBranch = "my-update"
+
+		var out bytes.Buffer
+		Expect(opts.WriteReport(&out, errors.New("merge stopped due to conflicts"))).To(Succeed())
+
+		var report RunReport
+		Expect(json.Unmarshal(out.Bytes(), &report)).To(Succeed())
+		Expect(report.Success).To(BeFalse())
+		Expect(report.Error).To(Equal("merge stopped due to conflicts"))
+		Expect(report.OutputBranch).To(Equal("my-update"))
+		Expect(report.MakeTargets).To(BeEmpty())
+		Expect(out.String()).To(ContainSubstring(`"makeTargets": []`))
+	})
+})
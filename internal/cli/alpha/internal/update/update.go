@@ -30,6 +30,7 @@ import (
 	"time"
 
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 )
 
@@ -86,12 +87,24 @@ type Update struct {
 	// update scaffold <from> -> <to>".
 	CommitMessageConflict string
 
-	// OpenGhIssue, when true, automatically creates a GitHub issue after the update
+	// OpenGhIssue, when true, automatically creates an issue on the Forge after the update
 	// completes. The issue includes a pre-filled checklist and a compare link from
-	// the base branch (--from-branch) to the output branch. This requires the GitHub
+	// the base branch (--from-branch) to the output branch. On GitHub, this requires the GitHub
 	// CLI (`gh`) to be installed and authenticated in the local environment.
 	OpenGhIssue bool
 
+	// Forge is where the issue is opened: "github" (default), "gitlab", "gitea", or "markdown"
+	// to write it to IssueFile instead. GitLab and Gitea are called through their REST APIs,
+	// authenticated with the GITLAB_TOKEN or GITEA_TOKEN environment variable.
+	Forge string
+
+	// ForgeURL is the base URL of the GitLab or Gitea instance, e.g. https://gitlab.example.com.
+	// If empty, it is derived from the origin remote.
+	ForgeURL string
+
+	// IssueFile is the markdown file the issue is written to with the markdown forge.
+	IssueFile string
+
 	UseGhModels bool
 
 	// GitConfig holds per-invocation Git settings applied to every `git` command via
@@ -113,6 +126,10 @@ type Update struct {
 	//       --git-config disable --git-config rerere.enabled=true
 	GitConfig []string
 
+	// FormatAwareMerge, when true, resolves the conflicts of go.mod, Makefile and kustomization.yaml files
+	// with merges aware of their format, e.g. by Makefile target, leaving fewer conflict markers.
+	FormatAwareMerge bool
+
 	// Temporary branches created during the update process. These are internal to the run
 	// and are surfaced for transparency/debugging:
 	//   - AncestorBranch: clean scaffold generated from FromVersion
@@ -123,6 +140,26 @@ type Update struct {
 	OriginalBranch string
 	UpgradeBranch  string
 	MergeBranch    string
+
+	// FromBinary is the path of a local kubebuilder binary of FromVersion, used instead of
+	// downloading the release to regenerate the ancestor scaffold.
+	FromBinary string
+
+	// ToBinary is the path of a local kubebuilder binary of ToVersion, used instead of
+	// downloading the release to regenerate the upgrade scaffold.
+	ToBinary string
+
+	// BinaryCacheDir is a directory of kubebuilder binaries keyed by version, laid out as
+	// <BinaryCacheDir>/<version>/kubebuilder. Cached binaries are used instead of downloading
+	// the releases, and the downloaded releases are stored in it for the next runs.
+	BinaryCacheDir string
+
+	// Output is the format used to report the result of the update: "text" (default) only logs it,
+	// while "json" also writes a RunReport to stdout for automation.
+	Output string
+
+	// Report collects the result of the update run, e.g. the conflicts and the make targets run.
+	Report RunReport
 }
 
 // Update a project using a default three-way Git merge.
@@ -212,6 +249,7 @@ func (opts *Update) Update() error {
 			if err := helpers.GitCmd(opts.GitConfig, "push", "-u", "origin", out).Run(); err != nil {
 				return fmt.Errorf("failed to push %s: %w", out, err)
 			}
+			opts.Report.Pushed = true
 		}
 	}
 
@@ -219,37 +257,32 @@ func (opts *Update) Update() error {
 	log.Info("Update completed successfully")
 
 	if opts.OpenGhIssue {
-		if err := opts.openGitHubIssue(hasConflicts); err != nil {
-			return fmt.Errorf("failed to open GitHub issue: %w", err)
+		if err := opts.openIssue(hasConflicts); err != nil {
+			return fmt.Errorf("failed to open the issue: %w", err)
 		}
 	}
 
 	return nil
 }
 
-func (opts *Update) openGitHubIssue(hasConflicts bool) error {
-	log.Info("Creating GitHub Issue to track the need to update the project")
-	out := opts.getOutputBranchName()
-
-	// Detect repo "owner/name"
-	repoCmd := exec.Command("gh", "repo", "view", "--json", "nameWithOwner", "--jq", ".nameWithOwner")
-	repoBytes, err := repoCmd.Output()
+func (opts *Update) openIssue(hasConflicts bool) error {
+	forge, err := helpers.NewForge(opts.forgeOptions())
 	if err != nil {
-		return fmt.Errorf("failed to detect GitHub repository via `gh repo view`: %s", err)
+		return fmt.Errorf("failed to set up the forge: %w", err)
 	}
-	repo := strings.TrimSpace(string(repoBytes))
+	log.Info("Creating " + forge.Name() + " issue to track the need to update the project")
+	out := opts.getOutputBranchName()
 
-	createPRURL := fmt.Sprintf("https://github.com/%s/compare/%s...%s?expand=1", repo, opts.FromBranch, out)
+	createPRURL := forge.CompareURL(opts.FromBranch, out)
 	title := fmt.Sprintf(helpers.IssueTitleTmpl, opts.ToVersion, opts.FromVersion)
 
 	// Skip if an open issue with same title already exists
-	checkCmd := exec.Command("gh", "issue", "list",
-		"--repo", repo,
-		"--state", "open",
-		"--search", fmt.Sprintf("in:title \"%s\"", title),
-		"--json", "title")
-	if checkOut, checkErr := checkCmd.Output(); checkErr == nil && strings.Contains(string(checkOut), title) {
-		log.Info("GitHub Issue already exists, skipping creation", "title", title)
+	existingURL, err := forge.FindIssue(title)
+	if err != nil {
+		log.Warn("Could not check for an existing issue", "error", err)
+	} else if existingURL != "" {
+		log.Info("Issue already exists, skipping creation", "title", title)
+		opts.Report.IssueURL = existingURL
 		return nil
 	}
 
@@ -261,37 +294,12 @@ func (opts *Update) openGitHubIssue(hasConflicts bool) error {
 		body = fmt.Sprintf(helpers.IssueBodyTmpl, opts.ToVersion, createPRURL, opts.FromVersion, out)
 	}
 
-	log.Info("Creating GitHub Issue")
-	createCmd := exec.Command("gh", "issue", "create",
-		"--repo", repo,
-		"--title", title,
-		"--body", body,
-	)
-	createOut, createErr := createCmd.CombinedOutput()
-	if createErr != nil {
-		return fmt.Errorf("failed to create GitHub issue: %v\n%s", createErr, string(createOut))
-	}
-	outStr := string(createOut)
-
-	// Try to extract the issue URL from stdout
-	issueURL := helpers.FirstURL(outStr)
-
-	// Fallback: query the just-created issue by title
-	if issueURL == "" {
-		viewCmd := exec.Command("gh", "issue", "list",
-			"--repo", repo,
-			"--state", "open",
-			"--search", fmt.Sprintf("in:title \"%s\"", title),
-			"--json", "url",
-			"--jq", ".[0].url",
-		)
-		urlBytes, vErr := viewCmd.Output()
-		if vErr != nil {
-			log.Warn("could not determine issue URL from gh output", "stdout", outStr, "error", vErr)
-		}
-		issueURL = strings.TrimSpace(string(urlBytes))
+	issueURL, err := forge.CreateIssue(title, body)
+	if err != nil {
+		return fmt.Errorf("failed to create the issue: %w", err)
 	}
-	log.Info("GitHub Issue created to track the update", "url", issueURL, "compare", createPRURL)
+	log.Info("Issue created to track the update", "url", issueURL, "compare", createPRURL)
+	opts.Report.IssueURL = issueURL
 
 	if opts.UseGhModels {
 		log.Info("Generating AI summary with gh models")
@@ -304,7 +312,7 @@ func (opts *Update) openGitHubIssue(hasConflicts bool) error {
 			opts.ToVersion)
 
 		ctx := helpers.BuildFullPrompet(
-			opts.FromVersion, opts.ToVersion, opts.FromBranch, out,
+			opts.GitConfig, opts.FromVersion, opts.ToVersion, opts.FromBranch, out,
 			createPRURL, releaseURL)
 
 		var outBuf, errBuf bytes.Buffer
@@ -321,22 +329,8 @@ func (opts *Update) openGitHubIssue(hasConflicts bool) error {
 
 		summary := strings.TrimSpace(outBuf.String())
 		if summary != "" {
-			num := helpers.IssueNumberFromURL(issueURL)
-			target := issueURL
-			args := make([]string, 4, 7)
-			args[0] = "issue"
-			args[1] = "comment"
-			args[2] = "--repo"
-			args[3] = repo
-			if num != "" {
-				target = num
-			}
-			args = append(args, target, "--body", summary)
-			commentCmd := exec.Command("gh", args...)
-			commentCmd.Stdout = os.Stdout
-			commentCmd.Stderr = os.Stderr
-			if err := commentCmd.Run(); err != nil {
-				return fmt.Errorf("failed to add AI summary comment: %s", err)
+			if err := forge.CommentIssue(issueURL, summary); err != nil {
+				return fmt.Errorf("failed to add AI summary comment: %w", err)
 			}
 			log.Info("AI summary comment added to the issue")
 		} else {
@@ -346,6 +340,15 @@ func (opts *Update) openGitHubIssue(hasConflicts bool) error {
 	return nil
 }
 
+// forgeOptions returns the options of the forge where the issue is opened
+func (opts *Update) forgeOptions() helpers.ForgeOptions {
+	return helpers.ForgeOptions{
+		Kind:      opts.Forge,
+		URL:       opts.ForgeURL,
+		IssueFile: opts.IssueFile,
+	}
+}
+
 func (opts *Update) cleanupTempBranches() {
 	_ = helpers.GitCmd(opts.GitConfig, "checkout", opts.getOutputBranchName()).Run()
 
@@ -427,12 +430,25 @@ func (opts *Update) squashToOutputBranch(hasConflicts bool) error {
 	return nil
 }
 
-// regenerateProjectWithVersion downloads the release binary for the specified version,
-// and runs the `alpha generate` command to re-scaffold the project
-func regenerateProjectWithVersion(version string) error {
-	tempDir, err := helpers.DownloadReleaseVersionWith(version)
-	if err != nil {
-		return fmt.Errorf("failed to download release %s binary: %w", version, err)
+// regenerateProjectWithVersion gets the binary for the specified version, using the local one
+// if provided or downloading the release otherwise, and runs the `alpha generate` command to re-scaffold the project
+func (opts *Update) regenerateProjectWithVersion(version string) error {
+	var tempDir string
+	var err error
+	if binary := opts.localBinaryFor(version); binary != "" {
+		log.Info("Using local binary", "path", binary)
+		if tempDir, err = helpers.PrepareLocalBinary(binary, version); err != nil {
+			return fmt.Errorf("failed to prepare local %s binary: %w", version, err)
+		}
+	} else {
+		if tempDir, err = helpers.DownloadReleaseVersionWith(version); err != nil {
+			return fmt.Errorf("failed to download release %s binary: %w", version, err)
+		}
+		if opts.BinaryCacheDir != "" {
+			if cacheErr := helpers.CacheBinary(tempDir, opts.BinaryCacheDir, version); cacheErr != nil {
+				log.Warn("Failed to cache the downloaded binary", "error", cacheErr)
+			}
+		}
 	}
 	if err := runAlphaGenerate(tempDir, version); err != nil {
 		return fmt.Errorf("failed to run alpha generate on ancestor branch: %w", err)
@@ -440,6 +456,25 @@ func regenerateProjectWithVersion(version string) error {
 	return nil
 }
 
+// localBinaryFor returns the path of the local binary to use for the version: the one provided
+// with FromBinary or ToBinary, or the one found in BinaryCacheDir.
+// Returns an empty string if the release binary has to be downloaded.
+func (opts *Update) localBinaryFor(version string) string {
+	if version == opts.FromVersion && opts.FromBinary != "" {
+		return opts.FromBinary
+	}
+	if version == opts.ToVersion && opts.ToBinary != "" {
+		return opts.ToBinary
+	}
+	if opts.BinaryCacheDir != "" {
+		path := helpers.CachedBinaryPath(opts.BinaryCacheDir, version)
+		if helpers.ValidateLocalBinary(path) == nil {
+			return path
+		}
+	}
+	return ""
+}
+
 // prepareAncestorBranch prepares the ancestor branch by checking it out,
 // cleaning up the project files, and regenerating the project with the specified version.
 func (opts *Update) prepareAncestorBranch() error {
@@ -449,7 +484,7 @@ func (opts *Update) prepareAncestorBranch() error {
 	if err := cleanupBranch(); err != nil {
 		return fmt.Errorf("failed to cleanup the %s : %w", opts.AncestorBranch, err)
 	}
-	if err := regenerateProjectWithVersion(opts.FromVersion); err != nil {
+	if err := opts.regenerateProjectWithVersion(opts.FromVersion); err != nil {
 		return fmt.Errorf("failed to regenerate project with fromVersion %s: %w", opts.FromVersion, err)
 	}
 	gitCmd := helpers.GitCmd(opts.GitConfig, "add", "--all")
@@ -480,24 +515,21 @@ func cleanupBranch() error {
 
 // runMakeTargets runs the make targets needed to keep the tree consistent.
 // If skipConflicts is true, it avoids running targets that are guaranteed
-// to fail noisily when there are unresolved conflicts.
-func runMakeTargets(skipConflicts bool) {
+// to fail noisily when there are unresolved conflicts, detected with the
+// gitConfig overrides.
+// It returns the outcome of each target run.
+func runMakeTargets(gitConfig []string, skipConflicts bool) []MakeTargetResult {
 	if !skipConflicts {
-		for _, t := range []string{"manifests", "generate", "fmt", "vet", "lint-fix"} {
-			if err := util.RunCmd(fmt.Sprintf("Running make %s", t), "make", t); err != nil {
-				log.Warn("make target failed", "target", t, "error", err)
-			}
-		}
-		return
+		return runMake([]string{"manifests", "generate", "fmt", "vet", "lint-fix"})
 	}
 
 	// Conflict-aware path: decide what to run based on repo state.
-	cs := helpers.DetectConflicts()
+	cs := helpers.DetectConflicts(gitConfig)
 	targets := helpers.DecideMakeTargets(cs)
 
 	if cs.Makefile {
 		log.Warn("Skipping all make targets because Makefile has merge conflicts")
-		return
+		return nil
 	}
 	if cs.API {
 		log.Warn("API conflicts detected; skipping make targets: manifests, generate")
@@ -508,14 +540,25 @@ func runMakeTargets(skipConflicts bool) {
 
 	if len(targets) == 0 {
 		log.Warn("No make targets will be run due to conflicts")
-		return
+		return nil
 	}
 
+	return runMake(targets)
+}
+
+// runMake runs each of the make targets, logging a warning for the ones that fail
+func runMake(targets []string) []MakeTargetResult {
+	results := make([]MakeTargetResult, 0, len(targets))
 	for _, t := range targets {
+		result := MakeTargetResult{Target: t, Succeeded: true}
 		if err := util.RunCmd(fmt.Sprintf("Running make %s", t), "make", t); err != nil {
 			log.Warn("make target failed", "target", t, "error", err)
+			result.Succeeded = false
+			result.Error = err.Error()
 		}
+		results = append(results, result)
 	}
+	return results
 }
 
 // runAlphaGenerate executes the old Kubebuilder version's 'alpha generate' command
@@ -551,7 +594,7 @@ func runAlphaGenerate(tempDir, version string) error {
 	}
 
 	log.Info("Project scaffold generation complete", "version", version)
-	runMakeTargets(false)
+	runMakeTargets(nil, false)
 	return nil
 }
 
@@ -657,7 +700,7 @@ func (opts *Update) prepareUpgradeBranch() error {
 	if err := cleanupBranch(); err != nil {
 		return fmt.Errorf("failed to cleanup the %s branch: %w", opts.UpgradeBranch, err)
 	}
-	if err := regenerateProjectWithVersion(opts.ToVersion); err != nil {
+	if err := opts.regenerateProjectWithVersion(opts.ToVersion); err != nil {
 		return fmt.Errorf("failed to regenerate project with version %s: %w", opts.ToVersion, err)
 	}
 	gitCmd = helpers.GitCmd(opts.GitConfig, "add", "--all")
@@ -689,27 +732,36 @@ func (opts *Update) mergeOriginalToUpgrade() (bool, error) {
 	if err != nil {
 		var exitErr *exec.ExitError
 		// If the merge has an error that is not a conflict, return an error 2
-		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
-			hasConflicts = true
-			if !opts.Force {
-				log.Warn("Merge stopped due to conflicts. Manual resolution is required.")
-				log.Warn("After resolving the conflicts, run the following command:")
-				log.Warn("    make manifests generate fmt vet lint-fix")
-				log.Warn("This ensures manifests and generated files are up to date, and the project layout remains consistent.")
-				return hasConflicts, fmt.Errorf("merge stopped due to conflicts")
-			}
-			log.Warn("Merge completed with conflicts. Conflict markers will be committed.")
-		} else {
+		if !errors.As(err, &exitErr) || exitErr.ExitCode() != 1 {
 			return hasConflicts, fmt.Errorf("merge failed unexpectedly: %w", err)
 		}
+		hasConflicts = true
+	}
+
+	if err = opts.restoreScaffoldManifest(); err != nil {
+		return hasConflicts, err
+	}
+	if hasConflicts {
+		hasConflicts = opts.resolveConflicts()
 	}
 
-	if !hasConflicts {
+	if hasConflicts {
+		opts.Report.HasConflicts = true
+		opts.Report.Conflicts = newReportConflicts(helpers.FindConflictFiles(opts.GitConfig))
+		if !opts.Force {
+			log.Warn("Merge stopped due to conflicts. Manual resolution is required.")
+			log.Warn("After resolving the conflicts, run the following command:")
+			log.Warn("    make manifests generate fmt vet lint-fix")
+			log.Warn("This ensures manifests and generated files are up to date, and the project layout remains consistent.")
+			return hasConflicts, fmt.Errorf("merge stopped due to conflicts")
+		}
+		log.Warn("Merge completed with conflicts. Conflict markers will be committed.")
+	} else {
 		log.Info("Merge happened without conflicts.")
 	}
 
 	// Best effort to run make targets to ensure the project is in a good state
-	runMakeTargets(true)
+	opts.Report.MakeTargets = runMakeTargets(opts.GitConfig, true)
 
 	// Step 4: Stage and commit
 	if err := helpers.GitCmd(opts.GitConfig, "add", "--all").Run(); err != nil {
@@ -723,6 +775,41 @@ func (opts *Update) mergeOriginalToUpgrade() (bool, error) {
 	return hasConflicts, nil
 }
 
+// restoreScaffoldManifest takes the scaffold manifest of the upgrade branch instead of merging it. Its checksums
+// describe the files as scaffolded by the new version, which the merged files only match when left unmodified.
+func (opts *Update) restoreScaffoldManifest() error {
+	path := machinery.DefaultManifestPath
+	if err := helpers.GitCmd(opts.GitConfig, "cat-file", "-e", opts.UpgradeBranch+":"+path).Run(); err != nil {
+		// The new version does not scaffold the manifest
+		return nil
+	}
+
+	if err := helpers.GitCmd(opts.GitConfig, "checkout", opts.UpgradeBranch, "--", path).Run(); err != nil {
+		return fmt.Errorf("failed to restore %s from %s: %w", path, opts.UpgradeBranch, err)
+	}
+	return nil
+}
+
+// resolveConflicts resolves the conflicts of the merge with the format-aware merge strategies, if enabled.
+// Returns true if conflicts remain.
+func (opts *Update) resolveConflicts() bool {
+	var strategies []helpers.MergeStrategy
+	if opts.FormatAwareMerge {
+		strategies = helpers.DefaultMergeStrategies()
+	}
+
+	resolved, remaining, err := helpers.ResolveConflicts(opts.GitConfig, strategies)
+	if err != nil {
+		log.Warn("Failed to list the conflicts of the merge", "error", err)
+		return true
+	}
+	for _, path := range resolved {
+		log.Info("Resolved conflicts with a format-aware merge", "file", path)
+	}
+	opts.Report.ResolvedFiles = resolved
+	return len(remaining) > 0
+}
+
 func (opts *Update) getMergeMessage(hasConflicts bool) string {
 	if hasConflicts {
 		// Use custom conflict message if provided
@@ -171,7 +171,7 @@ exit 1`
 
 	Context("RegenerateProjectWithVersion", func() {
 		It("succeeds downloading binary and running `alpha generate`", func() {
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -181,13 +181,40 @@ exit 1`
 				Get("/kubernetes-sigs/kubebuilder/releases/download").
 				Times(2).Reply(401).Body(strings.NewReader(""))
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
 				fmt.Sprintf("failed to download release %s binary", opts.FromVersion),
 			))
 		})
 
+		It("succeeds using a local binary without downloading it", func() {
+			gock.Off()
+			gock.New("https://github.com").
+				Get("/kubernetes-sigs/kubebuilder/releases/download").
+				Times(2).Reply(401).Body(strings.NewReader(""))
+
+			opts.FromBinary = filepath.Join(tmpDir, "kubebuilder-local")
+			Expect(mockBinResponse(`#!/bin/bash
+echo "local $@" >> "`+logFile+`"
+exit 0`, opts.FromBinary)).To(Succeed())
+
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, readErr := os.ReadFile(logFile)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(logs)).To(ContainSubstring("local alpha generate"))
+		})
+
+		It("stores the downloaded binary in the cache directory", func() {
+			opts.BinaryCacheDir = filepath.Join(tmpDir, "cache")
+
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filepath.Join(opts.BinaryCacheDir, opts.FromVersion, "kubebuilder")).To(BeARegularFile())
+		})
+
 		It("fails running alpha generate", func() {
 			fail := `#!/bin/bash
 echo "$@" >> "` + logFile + `"
@@ -197,7 +224,7 @@ exit 1`
 				Get("/kubernetes-sigs/kubebuilder/releases/download").
 				Times(2).Reply(200).Body(strings.NewReader(fail))
 
-			err = regenerateProjectWithVersion(opts.FromVersion)
+			err = opts.regenerateProjectWithVersion(opts.FromVersion)
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring(
 				"failed to run alpha generate on ancestor branch",
@@ -293,7 +320,23 @@ exit 1`
 			Expect(mockBinResponse(fail, mockMake)).To(Succeed())
 
 			// Should not panic even if make fails; just logs a warning.
-			runMakeTargets(false)
+			runMakeTargets(nil, false)
+		})
+
+		It("returns the outcome of each target", func() {
+			fail := `#!/bin/bash
+echo "$@" >> "` + logFile + `"
+if [[ "$1" == "vet" ]]; then exit 1; fi
+exit 0`
+			Expect(mockBinResponse(fail, mockMake)).To(Succeed())
+
+			results := runMakeTargets(nil, false)
+			Expect(results).To(HaveLen(5))
+			for _, result := range results {
+				Expect(result.Succeeded).To(Equal(result.Target != "vet"), result.Target)
+			}
+			Expect(results[3].Target).To(Equal("vet"))
+			Expect(results[3].Error).NotTo(BeEmpty())
 		})
 	})
 
@@ -388,6 +431,30 @@ exit 1`
 			Expect(s).To(ContainSubstring(helpers.MergeCommitMessage(opts.FromVersion, opts.ToVersion)))
 		})
 
+		It("takes the scaffold manifest of the upgrade branch instead of merging it", func() {
+			_, err = opts.mergeOriginalToUpgrade()
+			Expect(err).ToNot(HaveOccurred())
+
+			logs, readErr := os.ReadFile(logFile)
+			Expect(readErr).ToNot(HaveOccurred())
+			Expect(string(logs)).To(ContainSubstring(
+				fmt.Sprintf("checkout %s -- .kubebuilder/manifest.yaml", opts.UpgradeBranch),
+			))
+		})
+
+		It("does not stop when only the scaffold manifest conflicted", func() {
+			failOnMerge := `#!/bin/bash
+echo "$@" >> "` + logFile + `"
+if [[ "$1" == "merge" ]]; then exit 1; fi
+exit 0`
+			Expect(mockBinResponse(failOnMerge, mockGit)).To(Succeed())
+
+			opts.Force = false
+			_, err = opts.mergeOriginalToUpgrade()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(opts.Report.HasConflicts).To(BeFalse())
+		})
+
 		It("fails when branch creation fails", func() {
 			fail := `#!/bin/bash
 echo "$@" >> "` + logFile + `"
@@ -406,6 +473,7 @@ exit 1`
 			failOnMerge := `#!/bin/bash
 echo "$@" >> "` + logFile + `"
 if [[ "$1" == "merge" ]]; then exit 1; fi
+if [[ "$1" == "diff" ]]; then echo "Makefile"; fi
 exit 0`
 			Expect(mockBinResponse(failOnMerge, mockGit)).To(Succeed())
 
@@ -421,6 +489,7 @@ exit 0`
 			failOnMerge := `#!/bin/bash
 echo "$@" >> "` + logFile + `"
 if [[ "$1" == "merge" ]]; then exit 1; fi
+if [[ "$1" == "diff" ]]; then echo "Makefile"; fi
 exit 0`
 			Expect(mockBinResponse(failOnMerge, mockGit)).To(Succeed())
 
@@ -432,6 +501,42 @@ exit 0`
 			Expect(string(s)).To(ContainSubstring(
 				helpers.ConflictCommitMessage(opts.FromVersion, opts.ToVersion),
 			))
+
+			Expect(opts.Report.HasConflicts).To(BeTrue())
+			Expect(opts.Report.Conflicts).NotTo(BeNil())
+		})
+
+		It("commits with normal message when the format-aware merges resolve the conflicts", func() {
+			failOnMerge := `#!/bin/bash
+echo "$@" >> "` + logFile + `"
+if [[ "$1" == "merge" ]]; then exit 1; fi
+exit 0`
+			Expect(mockBinResponse(failOnMerge, mockGit)).To(Succeed())
+
+			opts.Force = false
+			opts.FormatAwareMerge = true
+			_, err = opts.mergeOriginalToUpgrade()
+			Expect(err).ToNot(HaveOccurred())
+
+			s, _ := os.ReadFile(logFile)
+			Expect(string(s)).To(ContainSubstring("diff --name-only --diff-filter=U"))
+			Expect(string(s)).To(ContainSubstring(helpers.MergeCommitMessage(opts.FromVersion, opts.ToVersion)))
+			Expect(opts.Report.HasConflicts).To(BeFalse())
+		})
+
+		It("records the make targets run on the merge result in the report", func() {
+			_, err = opts.mergeOriginalToUpgrade()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(opts.Report.HasConflicts).To(BeFalse())
+			Expect(opts.Report.Conflicts).To(BeNil())
+			Expect(opts.Report.MakeTargets).To(Equal([]MakeTargetResult{
+				{Target: "manifests", Succeeded: true},
+				{Target: "generate", Succeeded: true},
+				{Target: "fmt", Succeeded: true},
+				{Target: "vet", Succeeded: true},
+				{Target: "lint-fix", Succeeded: true},
+			}))
 		})
 	})
 
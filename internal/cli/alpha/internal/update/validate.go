@@ -43,17 +43,23 @@ func (opts *Update) Validate() error {
 	if err := opts.validateSemanticVersions(); err != nil {
 		return fmt.Errorf("failed to validate the versions: %w", err)
 	}
-	if err := validateReleaseAvailability(opts.FromVersion); err != nil {
-		return fmt.Errorf("unable to find release %s: %w", opts.FromVersion, err)
-	}
-	if err := validateReleaseAvailability(opts.ToVersion); err != nil {
-		return fmt.Errorf("unable to find release %s: %w", opts.ToVersion, err)
+	if err := opts.validateLocalBinaries(); err != nil {
+		return fmt.Errorf("failed to validate the local binaries: %w", err)
+	}
+	for _, version := range []string{opts.FromVersion, opts.ToVersion} {
+		// Releases with a local binary are not downloaded, so they do not need to be available
+		if binary := opts.localBinaryFor(version); binary != "" {
+			log.Info("Using local binary for version "+version, "path", binary)
+			continue
+		}
+		if err := validateReleaseAvailability(version); err != nil {
+			return fmt.Errorf("unable to find release %s: %w", version, err)
+		}
 	}
 
 	if opts.OpenGhIssue {
-		if err := exec.Command("gh", "--version").Run(); err != nil {
-			return fmt.Errorf("`gh` CLI not found or not authenticated. "+
-				"You must have gh instaled to use the --open-gh-issue option: %s", err)
+		if err := opts.validateForge(); err != nil {
+			return fmt.Errorf("failed to validate --forge: %w", err)
 		}
 	}
 
@@ -65,6 +71,29 @@ func (opts *Update) Validate() error {
 	return nil
 }
 
+// validateForge checks that the issue can be opened on the forge
+func (opts *Update) validateForge() error {
+	switch opts.Forge {
+	case "", helpers.ForgeGitHub:
+		if err := exec.Command("gh", "--version").Run(); err != nil {
+			return fmt.Errorf("`gh` CLI not found or not authenticated. "+
+				"You must have gh instaled to use the --open-gh-issue option: %s", err)
+		}
+	case helpers.ForgeGitLab:
+		if os.Getenv(helpers.GitLabTokenEnv) == "" {
+			return fmt.Errorf("the %s environment variable must be set to open GitLab issues", helpers.GitLabTokenEnv)
+		}
+	case helpers.ForgeGitea:
+		if os.Getenv(helpers.GiteaTokenEnv) == "" {
+			return fmt.Errorf("the %s environment variable must be set to open Gitea issues", helpers.GiteaTokenEnv)
+		}
+	case helpers.ForgeMarkdown:
+	default:
+		return fmt.Errorf("unsupported forge %q, must be one of %s", opts.Forge, strings.Join(helpers.Forges, ", "))
+	}
+	return nil
+}
+
 // isGhModelsExtensionInstalled checks if the gh-models extension is installed
 func isGhModelsExtensionInstalled() bool {
 	cmd := exec.Command("gh", "extension", "list")
@@ -120,6 +149,21 @@ func (opts *Update) validateSemanticVersions() error {
 	return nil
 }
 
+// validateLocalBinaries checks that the binaries provided via --from-binary and --to-binary exist
+func (opts *Update) validateLocalBinaries() error {
+	if opts.FromBinary != "" {
+		if err := helpers.ValidateLocalBinary(opts.FromBinary); err != nil {
+			return fmt.Errorf("invalid --from-binary: %w", err)
+		}
+	}
+	if opts.ToBinary != "" {
+		if err := helpers.ValidateLocalBinary(opts.ToBinary); err != nil {
+			return fmt.Errorf("invalid --to-binary: %w", err)
+		}
+	}
+	return nil
+}
+
 // validateReleaseAvailability will verify if the binary to scaffold from-version flag is available
 func validateReleaseAvailability(version string) error {
 	url := helpers.BuildReleaseURL(version)
@@ -150,6 +194,12 @@ func validateReleaseAvailability(version string) error {
 // If they are equal, logs an appropriate message and exits successfully.
 func (opts *Update) validateEqualVersions() error {
 	if opts.FromVersion == opts.ToVersion {
+		// Local binaries allow updating without network access, so skip the check of the latest release
+		if opts.usesLocalBinaries() {
+			log.Info("Your project already uses the specified version. No action taken.", "version", opts.FromVersion)
+			os.Exit(0)
+		}
+
 		// Check if this is the latest version to provide appropriate message
 		latestVersion, err := fetchLatestRelease()
 		if err != nil {
@@ -165,3 +215,8 @@ func (opts *Update) validateEqualVersions() error {
 	}
 	return nil
 }
+
+// usesLocalBinaries returns true if local binaries were provided, either directly or through a cache directory
+func (opts *Update) usesLocalBinaries() bool {
+	return opts.FromBinary != "" || opts.ToBinary != "" || opts.BinaryCacheDir != ""
+}
@@ -97,6 +97,52 @@ var _ = Describe("Prepare for internal update", func() {
 		})
 	})
 
+	Context("Validate with local binaries", func() {
+		BeforeEach(func() {
+			// No release is available, so validation must not check for it
+			gock.Off()
+			gock.New("https://github.com").
+				Head("/kubernetes-sigs/kubebuilder/releases/download").
+				Times(2).
+				Reply(404)
+
+			opts.FromBinary = filepath.Join(tmpDir, "kubebuilder-from")
+			Expect(os.WriteFile(opts.FromBinary, []byte("#!/bin/sh\n"), 0o755)).To(Succeed())
+		})
+
+		It("Should skip the release availability check of the local binaries", func() {
+			opts.ToBinary = filepath.Join(tmpDir, "kubebuilder-to")
+			Expect(os.WriteFile(opts.ToBinary, []byte("#!/bin/sh\n"), 0o755)).To(Succeed())
+
+			err = opts.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should use the binaries of the cache directory", func() {
+			opts.BinaryCacheDir = filepath.Join(tmpDir, "cache")
+			cached := filepath.Join(opts.BinaryCacheDir, opts.ToVersion, "kubebuilder")
+			Expect(os.MkdirAll(filepath.Dir(cached), 0o755)).To(Succeed())
+			Expect(os.WriteFile(cached, []byte("#!/bin/sh\n"), 0o755)).To(Succeed())
+
+			err = opts.Validate()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("Should check the release availability of the versions without a local binary", func() {
+			err = opts.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unable to find release " + opts.ToVersion))
+		})
+
+		It("Should fail if a local binary does not exist", func() {
+			opts.ToBinary = filepath.Join(tmpDir, "missing")
+
+			err = opts.Validate()
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid --to-binary"))
+		})
+	})
+
 	Context("ValidateGitRepo", func() {
 		It("Should scucceed", func() {
 			err = opts.validateGitRepo()
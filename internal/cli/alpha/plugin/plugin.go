@@ -0,0 +1,118 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/plugintest"
+)
+
+// NewPluginCommand returns a new plugin command, grouping the helpers to develop plugins
+// such as `kubebuilder alpha plugin test`.
+func NewPluginCommand() *cobra.Command {
+	pluginCmd := &cobra.Command{
+		Use:   "plugin",
+		Short: "Helpers to develop Kubebuilder plugins",
+	}
+	pluginCmd.AddCommand(newPluginTestCommand())
+
+	return pluginCmd
+}
+
+func newPluginTestCommand() *cobra.Command {
+	var update bool
+
+	testCmd := &cobra.Command{
+		Use:   "test <test-dir>...",
+		Short: "Run golden tests of plugins",
+		Long: fmt.Sprintf(`The 'test' command runs golden tests of plugins, written in Go or external ones.
+
+Each test directory contains:
+  • %[1]s: the plugin chain and the sequence of init, create api, create webhook and edit
+    invocations to run, e.g.:
+
+      plugins: ["go/v4", "sampleexternalplugin/v1"]
+      steps:
+      - args: ["init", "--domain", "example.com", "--repo", "example.com/sample"]
+      - args: ["create", "api", "--group", "crew", "--version", "v1", "--kind", "Captain"]
+      ignore: ["bin"]
+
+  • %[2]s/: the expected files of the project.
+
+The steps are run by this binary in a temporary directory, which is then compared to the golden
+directory. With --update, the golden directory is replaced with the result instead.
+
+The command exits with a non-zero code if any test fails, so it can be used in CI.`,
+			plugintest.ScriptFileName, plugintest.GoldenDirName),
+		Example: `
+  # Run the tests in ./test/golden/basic and ./test/golden/webhooks
+  kubebuilder alpha plugin test ./test/golden/basic ./test/golden/webhooks
+
+  # Refresh the golden directory of ./test/golden/basic
+  kubebuilder alpha plugin test ./test/golden/basic --update
+`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, testDirs []string) {
+			execPath, err := os.Executable()
+			if err != nil {
+				slog.Error("kubebuilder executable not found", "error", err)
+				os.Exit(1)
+			}
+			if !runPluginTests(cmd.OutOrStdout(), plugintest.NewExecRunner(execPath), testDirs, update) {
+				os.Exit(1)
+			}
+		},
+	}
+
+	testCmd.Flags().BoolVar(&update, "update", false,
+		"Replace the golden directories with the results of the tests instead of comparing them.")
+
+	return testCmd
+}
+
+// runPluginTests runs the test directories with the runner, writing their results to w.
+// It returns true if all of them passed.
+func runPluginTests(w io.Writer, runner plugintest.Runner, testDirs []string, update bool) bool {
+	passed := true
+	for _, testDir := range testDirs {
+		changes, err := plugintest.RunTest(runner, testDir, update)
+		switch {
+		case err != nil:
+			passed = false
+			_, _ = fmt.Fprintf(w, "FAIL %s: %v\n", testDir, err)
+		case len(changes) > 0:
+			passed = false
+			_, _ = fmt.Fprintf(w, "FAIL %s: %d file(s) differ from the golden directory\n", testDir, len(changes))
+			for _, change := range changes {
+				_, _ = io.WriteString(w, change.UnifiedDiff())
+			}
+		case update:
+			_, _ = fmt.Fprintf(w, "updated %s\n", filepath.Join(testDir, plugintest.GoldenDirName))
+		default:
+			_, _ = fmt.Fprintf(w, "ok   %s\n", testDir)
+		}
+	}
+
+	return passed
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/plugintest"
+)
+
+// fakeRunner writes the arguments of each invocation to a file named after its first argument
+type fakeRunner struct{}
+
+func (fakeRunner) Run(args []string) error {
+	return os.WriteFile(args[0]+".txt", []byte(strings.Join(args, " ")+"\n"), 0o644)
+}
+
+var _ = Describe("NewPluginCommand", func() {
+	It("should create the plugin command with the test subcommand", func() {
+		cmd := NewPluginCommand()
+		Expect(cmd.Use).To(Equal("plugin"))
+
+		testCmd, _, err := cmd.Find([]string{"test"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(testCmd.Use).To(HavePrefix("test"))
+		Expect(testCmd.Example).To(ContainSubstring("kubebuilder alpha plugin test"))
+		Expect(testCmd.Flags().Lookup("update")).NotTo(BeNil())
+		Expect(testCmd.Args(testCmd, nil)).NotTo(Succeed())
+	})
+})
+
+var _ = Describe("runPluginTests", func() {
+	var (
+		testDir string
+		out     bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		testDir = GinkgoT().TempDir()
+		out.Reset()
+		Expect(os.WriteFile(filepath.Join(testDir, plugintest.ScriptFileName),
+			[]byte("plugins: [\"sample/v1\"]\nsteps:\n- args: [\"init\"]\n"), 0o644)).To(Succeed())
+	})
+
+	It("should update the golden directory and then pass", func() {
+		Expect(runPluginTests(&out, fakeRunner{}, []string{testDir}, true)).To(BeTrue())
+		Expect(out.String()).To(Equal("updated " + filepath.Join(testDir, plugintest.GoldenDirName) + "\n"))
+		Expect(os.ReadFile(filepath.Join(testDir, plugintest.GoldenDirName, "init.txt"))).
+			To(BeEquivalentTo("init --plugins sample/v1\n"))
+
+		out.Reset()
+		Expect(runPluginTests(&out, fakeRunner{}, []string{testDir}, false)).To(BeTrue())
+		Expect(out.String()).To(Equal("ok   " + testDir + "\n"))
+	})
+
+	It("should fail and print the differences from the golden directory", func() {
+		Expect(runPluginTests(&out, fakeRunner{}, []string{testDir}, false)).To(BeFalse())
+		Expect(out.String()).To(ContainSubstring("FAIL " + testDir + ": 1 file(s) differ from the golden directory"))
+		Expect(out.String()).To(ContainSubstring("+++ b/init.txt\n"))
+	})
+
+	It("should fail if the script cannot be loaded", func() {
+		Expect(runPluginTests(&out, fakeRunner{}, []string{filepath.Join(testDir, "missing")}, false)).To(BeFalse())
+		Expect(out.String()).To(ContainSubstring("failed to read"))
+	})
+})
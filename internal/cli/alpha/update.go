@@ -24,12 +24,16 @@ import (
 	"github.com/spf13/cobra"
 
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update"
+	"sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/internal/update/helpers"
+	"sigs.k8s.io/kubebuilder/v4/internal/logging"
 )
 
 // NewUpdateCommand creates and returns a new Cobra command for updating Kubebuilder projects.
 func NewUpdateCommand() *cobra.Command {
 	opts := update.Update{}
 	var gitCfg []string
+	// stdout receives the JSON report, as everything else is written to stderr with --output=json
+	stdout := os.Stdout
 	updateCmd := &cobra.Command{
 		Use:   "update",
 		Short: "Update your project to a newer version (3-way merge; squash by default)",
@@ -49,6 +53,9 @@ Output branch & history:
 Conflicts:
   • Default: stop on conflicts and leave the merge branch for manual resolution.
   • --force: commit with conflict markers so automation can proceed.
+  • --format-aware-merge: first resolve the conflicts in go.mod, Makefile and kustomization.yaml files
+      by merges aware of their format (highest module versions, by target and variable, by
+      resources/patches item).
 
 Other options:
   • --restore-path: restore paths from base when squashing (e.g., CI configs).
@@ -58,6 +65,13 @@ Other options:
   • --push: push the output branch to 'origin' after the update.
   • --git-config: pass per-invocation Git config as -c key=value (repeatable). When not set,
       defaults are set to improve detection during merges.
+  • --output=json: write a JSON report of the run to stdout (logs go to stderr), with the versions,
+      the branches, the conflicted files and the outcome of the make targets, for automation.
+  • --open-gh-issue: open an issue with a checklist and compare link to track the update. --forge selects
+      where: github (default, via gh), gitlab, gitea, or markdown to write it to --issue-file.
+  • --from-binary / --to-binary: use local kubebuilder binaries of the versions instead of downloading
+      the releases, e.g. in air-gapped environments. --to-binary requires --to-version.
+  • --binary-cache-dir: use and store the binaries in <dir>/<version>/kubebuilder.
 
 Defaults:
   • --from-version / --to-version: resolved from PROJECT and the latest release if unset.
@@ -92,12 +106,37 @@ Defaults:
   # Create an issue and add an AI overview comment
   kubebuilder alpha update --open-gh-issue --use-gh-models
 
+  # Create the issue on GitLab (the project is derived from the origin remote)
+  GITLAB_TOKEN=<token> kubebuilder alpha update --open-gh-issue --forge gitlab
+
+  # Write the issue to a markdown file instead, e.g. to open it from a CI job
+  kubebuilder alpha update --open-gh-issue --forge markdown --issue-file update-issue.md
+
   # Add extra Git configs (no need to re-specify defaults)
   kubebuilder alpha update --git-config merge.conflictStyle=diff3 --git-config rerere.enabled=true
                                           
   # Disable Git config defaults completely, use only custom configs
-  kubebuilder alpha update --git-config disable --git-config rerere.enabled=true`,
+  kubebuilder alpha update --git-config disable --git-config rerere.enabled=true
+
+  # Update without network access using locally provided binaries
+  kubebuilder alpha update --from-version v4.5.0 --to-version v4.7.0 \
+    --from-binary ./bin/kubebuilder-v4.5.0 --to-binary ./bin/kubebuilder-v4.7.0
+
+  # Use a cache of binaries laid out as <dir>/<version>/kubebuilder
+  kubebuilder alpha update --to-version v4.7.0 --binary-cache-dir ~/.cache/kubebuilder
+
+  # Write a JSON report of the update to stdout for automation
+  kubebuilder alpha update --force --output json > update-report.json`,
 		PreRunE: func(_ *cobra.Command, _ []string) error {
+			switch opts.Output {
+			case update.OutputText:
+			case update.OutputJSON:
+				redirectOutputToStderr()
+			default:
+				return fmt.Errorf("unsupported --output %q, must be one of %q or %q",
+					opts.Output, update.OutputText, update.OutputJSON)
+			}
+
 			if opts.ShowCommits && len(opts.RestorePath) > 0 {
 				return fmt.Errorf("the --restore-path flag is not supported with --show-commits")
 			}
@@ -131,13 +170,28 @@ Defaults:
 				opts.GitConfig = append(defaults, filtered...)
 			}
 
-			if err := opts.Prepare(); err != nil {
-				return fmt.Errorf("failed to prepare update: %w", err)
+			err := opts.Prepare()
+			if err != nil {
+				err = fmt.Errorf("failed to prepare update: %w", err)
+			} else {
+				err = opts.Validate()
 			}
-			return opts.Validate()
+			if err != nil && opts.Output == update.OutputJSON {
+				if reportErr := opts.WriteReport(stdout, err); reportErr != nil {
+					slog.Error("Failed to write the update report", "error", reportErr)
+				}
+			}
+			return err
 		},
 		Run: func(_ *cobra.Command, _ []string) {
-			if err := opts.Update(); err != nil {
+			err := opts.Update()
+			if opts.Output == update.OutputJSON {
+				if reportErr := opts.WriteReport(stdout, err); reportErr != nil {
+					slog.Error("Failed to write the update report", "error", reportErr)
+					os.Exit(1)
+				}
+			}
+			if err != nil {
 				slog.Error("Update failed", "error", err)
 				os.Exit(1)
 			}
@@ -170,7 +224,16 @@ Defaults:
 		"Custom commit message for merges with conflicts. "+
 			"Defaults to 'chore(kubebuilder): (:warning: manual conflict resolution required) update scaffold <from> -> <to>'.")
 	updateCmd.Flags().BoolVar(&opts.OpenGhIssue, "open-gh-issue", false,
-		"Create a GitHub issue with a pre-filled checklist and compare link after the update completes (requires `gh`).")
+		"Create an issue with a pre-filled checklist and compare link after the update completes, "+
+			"on GitHub by default (requires `gh`). Use --forge to open it elsewhere.")
+	updateCmd.Flags().StringVar(&opts.Forge, "forge", helpers.ForgeGitHub,
+		"Forge where --open-gh-issue opens the issue: github, gitlab, gitea, or markdown to write it to "+
+			"--issue-file. GitLab and Gitea require the GITLAB_TOKEN or GITEA_TOKEN environment variable.")
+	updateCmd.Flags().StringVar(&opts.ForgeURL, "forge-url", "",
+		"Base URL of the GitLab or Gitea instance, e.g. https://gitlab.example.com. "+
+			"Defaults to the host of the origin remote.")
+	updateCmd.Flags().StringVar(&opts.IssueFile, "issue-file", helpers.DefaultIssueFile,
+		"Markdown file the issue is written to with --forge=markdown.")
 	updateCmd.Flags().BoolVar(
 		&opts.UseGhModels,
 		"use-gh-models",
@@ -184,5 +247,30 @@ Defaults:
 		"Per-invocation Git config (repeatable). "+
 			"Defaults: -c merge.renameLimit=999999 -c diff.renameLimit=999999 -c merge.conflictStyle=merge. "+
 			"Your configs are applied on top. To disable defaults, include `--git-config disable`")
+	updateCmd.Flags().BoolVar(&opts.FormatAwareMerge, "format-aware-merge", false,
+		"Resolve the conflicts in go.mod, Makefile and kustomization.yaml files with merges aware of their "+
+			"format, leaving conflict markers only where both sides changed the same directive, target or item.")
+	updateCmd.Flags().StringVar(&opts.FromBinary, "from-binary", "",
+		"Path to a local kubebuilder binary of --from-version to use instead of downloading the release.")
+	updateCmd.Flags().StringVar(&opts.ToBinary, "to-binary", "",
+		"Path to a local kubebuilder binary of --to-version to use instead of downloading the release. "+
+			"Requires --to-version.")
+	updateCmd.Flags().StringVar(&opts.BinaryCacheDir, "binary-cache-dir", "",
+		"Directory of kubebuilder binaries laid out as <dir>/<version>/kubebuilder. Cached binaries are used "+
+			"instead of downloading the releases, and downloaded releases are stored in it.")
+	updateCmd.Flags().StringVar(&opts.Output, "output", update.OutputText,
+		"Output format of the result of the update: text or json. With json, a report of the run is written "+
+			"to stdout and the logs to stderr.")
 	return updateCmd
 }
+
+// redirectOutputToStderr sends the logs, and the output of the commands run during the update,
+// to stderr so that stdout only receives the JSON report.
+func redirectOutputToStderr() {
+	os.Stdout = os.Stderr
+	slog.SetDefault(slog.New(logging.NewHandler(os.Stderr, logging.HandlerOptions{
+		SlogOpts: slog.HandlerOptions{
+			Level: slog.LevelInfo,
+		},
+	})))
+}
@@ -39,6 +39,26 @@ var _ = Describe("NewUpdateCommand", func() {
 			Expect(flags.Lookup("restore-path")).NotTo(BeNil())
 			Expect(flags.Lookup("output-branch")).NotTo(BeNil())
 			Expect(flags.Lookup("push")).NotTo(BeNil())
+			Expect(flags.Lookup("from-binary")).NotTo(BeNil())
+			Expect(flags.Lookup("to-binary")).NotTo(BeNil())
+			Expect(flags.Lookup("binary-cache-dir")).NotTo(BeNil())
+			Expect(flags.Lookup("forge")).NotTo(BeNil())
+			Expect(flags.Lookup("forge").DefValue).To(Equal("github"))
+			Expect(flags.Lookup("forge-url")).NotTo(BeNil())
+			Expect(flags.Lookup("issue-file")).NotTo(BeNil())
+			Expect(flags.Lookup("format-aware-merge")).NotTo(BeNil())
+			Expect(flags.Lookup("format-aware-merge").DefValue).To(Equal("false"))
+			Expect(flags.Lookup("output")).NotTo(BeNil())
+			Expect(flags.Lookup("output").DefValue).To(Equal("text"))
+		})
+
+		It("rejects an unsupported output format", func() {
+			cmd := NewUpdateCommand()
+			Expect(cmd.Flags().Set("output", "yaml")).To(Succeed())
+
+			err := cmd.PreRunE(cmd, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`unsupported --output "yaml"`))
 		})
 	})
 })
@@ -22,10 +22,12 @@ import (
 
 	"github.com/spf13/afero"
 
+	alphaplugin "sigs.k8s.io/kubebuilder/v4/internal/cli/alpha/plugin"
 	"sigs.k8s.io/kubebuilder/v4/internal/cli/version"
 	"sigs.k8s.io/kubebuilder/v4/internal/logging"
 	"sigs.k8s.io/kubebuilder/v4/pkg/cli"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	kustomizecommonv2 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2"
@@ -81,7 +83,9 @@ func Run() {
 		),
 		cli.WithPlugins(externalPlugins...),
 		cli.WithDefaultPlugins(cfgv3.Version, gov4Bundle),
+		cli.WithDefaultPlugins(cfgv4.Version, gov4Bundle),
 		cli.WithDefaultProjectVersion(cfgv3.Version),
+		cli.WithExtraAlphaCommands(alphaplugin.NewPluginCommand()),
 		cli.WithCompletion(),
 	)
 	if err != nil {
@@ -31,6 +31,7 @@ const (
 
 var alphaCommands = []*cobra.Command{
 	newAlphaCommand(),
+	alpha.NewDoctorCommand(),
 	alpha.NewScaffoldCommand(),
 	alpha.NewUpdateCommand(),
 }
@@ -58,6 +59,7 @@ Alpha subcommands are for unstable features.
 	for i := range alphaCommands {
 		cmd.AddCommand(alphaCommands[i])
 	}
+	cmd.AddCommand(c.newAlphaProjectCmd())
 	return cmd
 }
 
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/store"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+func (c *CLI) newAlphaProjectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project",
+		Short: "Describe, validate and migrate the project configuration (PROJECT file)",
+	}
+	cmd.AddCommand(c.newAlphaProjectSchemaCmd(), c.newAlphaProjectValidateCmd(), c.newAlphaProjectMigrateCmd())
+	return cmd
+}
+
+func (c *CLI) newAlphaProjectSchemaCmd() *cobra.Command {
+	var projectVersion string
+
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Print the JSON Schema of the project configuration",
+		Long: strings.TrimSpace(`
+Print the JSON Schema of the project configuration (PROJECT file), including the configuration
+objects of the plugins registered in the CLI. Editors can use it to validate and complete the PROJECT file.
+`),
+		Example: fmt.Sprintf(`  # Write the schema of the PROJECT file
+  %[1]s alpha project schema > project.schema.json
+
+  # Validate the PROJECT file in VS Code or other editors using the YAML language server by adding
+  # this line at its top
+  # yaml-language-server: $schema=./project.schema.json`, c.commandName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var version config.Version
+			if err := version.Parse(projectVersion); err != nil {
+				return fmt.Errorf("invalid project version %q: %w", projectVersion, err)
+			}
+			cfg, err := config.New(version)
+			if err != nil {
+				return fmt.Errorf("failed to create config for version %q: %w", version, err)
+			}
+			provider, isProvider := cfg.(config.SchemaProvider)
+			if !isProvider {
+				return fmt.Errorf("project version %q does not provide a schema", version)
+			}
+
+			content, err := json.MarshalIndent(provider.Schema(c.pluginConfigs()), "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal schema: %w", err)
+			}
+			_, _ = fmt.Fprintln(cmd.OutOrStdout(), string(content))
+			return nil
+		},
+	}
+
+	version := c.projectVersion
+	if version.Validate() != nil {
+		version = c.defaultProjectVersion
+	}
+	cmd.Flags().StringVar(&projectVersion, projectVersionFlag, version.String(), "project version")
+
+	return cmd
+}
+
+func (c *CLI) newAlphaProjectValidateCmd() *cobra.Command {
+	var path string
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the project configuration",
+		Long: strings.TrimSpace(`
+Validate the project configuration (PROJECT file) against its schema, reporting the unknown fields,
+like typos, and the invalid values with their line numbers.
+
+The command exits with a non-zero code if the project configuration is invalid, so it can be used in CI.
+`),
+		Example: fmt.Sprintf(`  # Validate the PROJECT file in the current directory
+  %[1]s alpha project validate`, c.commandName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			store := yamlstore.New(c.fs, yamlstore.WithStrict(c.pluginConfigs()))
+			if err := store.LoadFrom(path); err != nil {
+				return fmt.Errorf("%s is not valid: %w", path, err)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s is valid\n", path)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "path", yamlstore.DefaultPath, "path of the project configuration file")
+
+	return cmd
+}
+
+func (c *CLI) newAlphaProjectMigrateCmd() *cobra.Command {
+	var (
+		path           string
+		projectVersion string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate the project configuration to another project version",
+		Long: strings.TrimSpace(`
+Migrate the project configuration (PROJECT file) to another project version, keeping its content.
+
+Project version 4-alpha records, for each resource, the plugins and flags of the subcommands that scaffolded it,
+so that "alpha generate" can run them again exactly. The subcommands run before the migration are not recorded.
+`),
+		Example: fmt.Sprintf(`  # Migrate the PROJECT file in the current directory to project version 4-alpha
+  %[1]s alpha project migrate`, c.commandName),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			var version config.Version
+			if err := version.Parse(projectVersion); err != nil {
+				return fmt.Errorf("invalid project version %q: %w", projectVersion, err)
+			}
+
+			s := yamlstore.New(c.fs)
+			if err := s.LoadFrom(path); err != nil {
+				return fmt.Errorf("failed to load %s: %w", path, err)
+			}
+			if err := s.(store.Migrator).Migrate(version); err != nil {
+				return fmt.Errorf("failed to migrate %s: %w", path, err)
+			}
+			if err := s.SaveTo(path); err != nil {
+				return fmt.Errorf("failed to save %s: %w", path, err)
+			}
+			_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%s migrated to project version %s\n", path, version)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&path, "path", yamlstore.DefaultPath, "path of the project configuration file")
+	cmd.Flags().StringVar(&projectVersion, projectVersionFlag, cfgv4.Version.String(), "project version to migrate to")
+
+	return cmd
+}
+
+// pluginConfigs returns zero values of the configuration objects of the registered plugins, mapped by plugin key
+func (c CLI) pluginConfigs() map[string]any {
+	pluginConfigs := make(map[string]any)
+	for key, p := range c.plugins {
+		if configurable, isConfigurable := p.(plugin.Configurable); isConfigurable {
+			pluginConfigs[key] = configurable.PluginConfig()
+		}
+	}
+	return pluginConfigs
+}
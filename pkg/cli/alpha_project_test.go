@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+type samplePluginConfig struct {
+	Output string `json:"output,omitempty"`
+}
+
+type mockConfigurablePlugin struct {
+	mockPlugin
+}
+
+func (mockConfigurablePlugin) PluginConfig() any { return samplePluginConfig{} }
+
+var _ = Describe("alpha project", func() {
+	var (
+		c   *CLI
+		out *bytes.Buffer
+	)
+
+	BeforeEach(func() {
+		p := mockConfigurablePlugin{newMockPlugin("sample.kubebuilder.io", "v1", cfgv3.Version).(mockPlugin)}
+		c = &CLI{
+			commandName:           "kubebuilder",
+			plugins:               map[string]plugin.Plugin{plugin.KeyFor(p): p},
+			defaultProjectVersion: cfgv3.Version,
+			fs:                    machinery.Filesystem{FS: afero.NewMemMapFs()},
+		}
+		out = &bytes.Buffer{}
+	})
+
+	Context("schema", func() {
+		It("should print the schema including the configurations of the registered plugins", func() {
+			cmd := c.newAlphaProjectSchemaCmd()
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{})
+			Expect(cmd.Execute()).To(Succeed())
+
+			var s map[string]any
+			Expect(json.Unmarshal(out.Bytes(), &s)).To(Succeed())
+			Expect(s).To(HaveKeyWithValue("$schema", "https://json-schema.org/draft/2020-12/schema"))
+			Expect(out.String()).To(ContainSubstring(`"sample.kubebuilder.io/v1": {`))
+		})
+
+		It("should fail for an unknown project version", func() {
+			cmd := c.newAlphaProjectSchemaCmd()
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs([]string{"--project-version", "2"})
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring(`failed to create config for version "2"`)))
+		})
+	})
+
+	Context("validate", func() {
+		It("should validate the project configuration", func() {
+			Expect(afero.WriteFile(c.fs.FS, "PROJECT", []byte(`version: "3"
+plugins:
+  sample.kubebuilder.io/v1:
+    output: dist
+`), 0o644)).To(Succeed())
+
+			cmd := c.newAlphaProjectValidateCmd()
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{})
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(out.String()).To(Equal("PROJECT is valid\n"))
+		})
+
+		It("should report the errors of the project configuration", func() {
+			Expect(afero.WriteFile(c.fs.FS, "config/PROJECT", []byte(`version: "3"
+plugins:
+  sample.kubebuilder.io/v1:
+    outputDir: dist
+`), 0o644)).To(Succeed())
+
+			cmd := c.newAlphaProjectValidateCmd()
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs([]string{"--path", "config/PROJECT"})
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring(
+				`line 4: plugins["sample.kubebuilder.io/v1"].outputDir: unknown field`)))
+		})
+	})
+
+	Context("migrate", func() {
+		It("should migrate the project configuration", func() {
+			Expect(afero.WriteFile(c.fs.FS, "PROJECT", []byte(`version: "3"
+domain: example.com
+`), 0o644)).To(Succeed())
+
+			cmd := c.newAlphaProjectMigrateCmd()
+			cmd.SetOut(out)
+			cmd.SetArgs([]string{})
+			Expect(cmd.Execute()).To(Succeed())
+			Expect(out.String()).To(Equal("PROJECT migrated to project version 4-alpha\n"))
+
+			content, err := afero.ReadFile(c.fs.FS, "PROJECT")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(HaveSuffix("domain: example.com\nversion: 4-alpha\n"))
+		})
+
+		It("should fail for an unsupported migration", func() {
+			Expect(afero.WriteFile(c.fs.FS, "PROJECT", []byte(`version: "3"
+`), 0o644)).To(Succeed())
+
+			cmd := c.newAlphaProjectMigrateCmd()
+			cmd.SetOut(out)
+			cmd.SetErr(out)
+			cmd.SetArgs([]string{"--project-version", "2"})
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring("migration from version 3 to 2 is not supported")))
+		})
+	})
+
+	It("should collect the configurations of the registered plugins", func() {
+		c.plugins["other.kubebuilder.io/v1"] = newMockPlugin("other.kubebuilder.io", "v1", config.Version{Number: 3})
+		Expect(c.pluginConfigs()).To(Equal(map[string]any{"sample.kubebuilder.io/v1": samplePluginConfig{}}))
+	})
+})
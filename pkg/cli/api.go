@@ -47,8 +47,8 @@ func (c CLI) newCreateAPICmd() *cobra.Command {
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.CreateAPI.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.CreateAPI)
-			return isValid
+			s, isValid := p.(plugin.CreateAPI)
+			return isValid && s.GetCreateAPISubcommand() != nil
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
 			return p.(plugin.CreateAPI).GetCreateAPISubcommand()
@@ -65,8 +65,8 @@ func (c CLI) newCreateAPICmd() *cobra.Command {
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.CreateAPI)
-		return isValid
+		s, isValid := p.(plugin.CreateAPI)
+		return isValid && s.GetCreateAPISubcommand() != nil
 	}, "Available plugins that support 'create api'")
 
 	return cmd
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
 const (
@@ -40,6 +41,7 @@ const (
 
 	pluginsFlag        = "plugins"
 	projectVersionFlag = "project-version"
+	dryRunFlag         = "dry-run"
 )
 
 // CLI is the command line utility that is used to scaffold kubebuilder project files.
@@ -71,6 +73,8 @@ type CLI struct {
 
 	// Plugin keys to scaffold with.
 	pluginKeys []string
+	// Plugin keys the project was scaffolded with, if a project configuration file was found.
+	projectPluginKeys []string
 	// Project version to scaffold.
 	projectVersion config.Version
 
@@ -323,6 +327,7 @@ func patchProjectFileInMemoryIfNeeded(fs afero.Fs, path string) error {
 // It is extracted from getInfoFromConfigFile for testing purposes.
 func (c *CLI) getInfoFromConfig(projectConfig config.Config) error {
 	c.pluginKeys = projectConfig.GetPluginChain()
+	c.projectPluginKeys = c.pluginKeys
 	c.projectVersion = projectConfig.GetVersion()
 
 	for _, pluginKey := range c.pluginKeys {
@@ -460,6 +465,11 @@ func (c *CLI) getInfoFromDefaults() {
 const unstablePluginMsg = " (plugin version is unstable, there may be an upgrade available: " +
 	"https://kubebuilder.io/plugins/plugins-versioning)"
 
+// cliVersionChecker is implemented by plugins that require some versions of the CLI, like external plugins.
+type cliVersionChecker interface {
+	CheckCLIVersion(version string) error
+}
+
 // resolvePlugins selects from the available plugins those that match the project version and plugin keys provided.
 func (c *CLI) resolvePlugins() error {
 	knownProjectVersion := c.projectVersion.Validate() == nil
@@ -494,6 +504,11 @@ func (c *CLI) resolvePlugins() error {
 		// Only 1 plugin can match
 		switch len(plugins) {
 		case 1:
+			if checker, isChecker := plugins[0].(cliVersionChecker); isChecker {
+				if err := checker.CheckCLIVersion(c.cliVersion); err != nil {
+					return fmt.Errorf("incompatible plugin %q: %w", pluginKey, err)
+				}
+			}
 			c.resolvedPlugins = append(c.resolvedPlugins, plugins[0])
 		case 0:
 			return fmt.Errorf("no plugin could be resolved with key %q%s", pluginKey, extraErrMsg)
@@ -502,6 +517,10 @@ func (c *CLI) resolvePlugins() error {
 		}
 	}
 
+	if err := c.orderPlugins(); err != nil {
+		return err
+	}
+
 	// Now we can try to resolve the project version if not known by this point
 	if !knownProjectVersion && len(c.resolvedPlugins) > 0 {
 		// Extract the common supported project versions
@@ -533,6 +552,37 @@ func (c *CLI) resolvePlugins() error {
 	return nil
 }
 
+// orderPlugins validates the requirements and conflicts of the resolved plugins, also considering the plugins
+// the project was scaffolded with, and orders them so that every plugin runs after the plugins it requires.
+func (c *CLI) orderPlugins() error {
+	scaffolded := make([]plugin.Plugin, 0, len(c.projectPluginKeys))
+	for _, pluginKey := range c.projectPluginKeys {
+		// Plugins that are no longer available can neither satisfy requirements nor conflict
+		if p, found := c.plugins[pluginKey]; found {
+			scaffolded = append(scaffolded, p)
+		}
+	}
+
+	ordered, err := plugin.OrderChain(c.resolvedPlugins, scaffolded)
+	if err != nil {
+		return fmt.Errorf("invalid plugin chain: %w", err)
+	}
+
+	keys := make([]string, 0, len(ordered))
+	reordered := false
+	for i, p := range ordered {
+		keys = append(keys, plugin.KeyFor(p))
+		reordered = reordered || keys[i] != plugin.KeyFor(c.resolvedPlugins[i])
+	}
+	if reordered {
+		log.Info("Plugin chain reordered to run plugins after the plugins they require",
+			"chain", strings.Join(keys, ","))
+	}
+	c.resolvedPlugins = ordered
+
+	return nil
+}
+
 // addSubcommands returns a root command with a subcommand tree reflecting the
 // current project's state.
 func (c *CLI) addSubcommands() {
@@ -554,6 +604,16 @@ func (c *CLI) addSubcommands() {
 		c.cmd.AddCommand(createCmd)
 	}
 
+	// kubebuilder delete
+	deleteCmd := c.newDeleteCmd()
+	// kubebuilder delete api
+	deleteCmd.AddCommand(c.newDeleteAPICmd())
+	// kubebuilder delete webhook
+	deleteCmd.AddCommand(c.newDeleteWebhookCmd())
+	if deleteCmd.HasSubCommands() {
+		c.cmd.AddCommand(deleteCmd)
+	}
+
 	// kubebuilder edit
 	c.cmd.AddCommand(c.newEditCmd())
 
@@ -583,8 +643,8 @@ func (c *CLI) addExtraCommands() error {
 // printDeprecationWarnings prints the deprecation warnings of the resolved plugins.
 func (c CLI) printDeprecationWarnings() {
 	for _, p := range c.resolvedPlugins {
-		if p != nil && p.(plugin.Deprecated) != nil && len(p.(plugin.Deprecated).DeprecationWarning()) > 0 {
-			_, _ = fmt.Fprintf(os.Stderr, noticeColor, fmt.Sprintf(deprecationFmt, p.(plugin.Deprecated).DeprecationWarning()))
+		if deprecated, isDeprecated := p.(plugin.Deprecated); isDeprecated && len(deprecated.DeprecationWarning()) > 0 {
+			_, _ = fmt.Fprintf(os.Stderr, noticeColor, fmt.Sprintf(deprecationFmt, deprecated.DeprecationWarning()))
 		}
 	}
 }
@@ -600,6 +660,14 @@ func (c CLI) metadata() plugin.CLIMetadata {
 //
 // If an error is found, command help and examples will be printed.
 func (c CLI) Run() error {
+	// The long-lived external plugins are stopped by their post-scaffold phase, which is not run on failures
+	// nor with --dry-run
+	defer func() {
+		if err := external.StopPlugins(); err != nil {
+			log.Warn("failed to stop the external plugins", "error", err)
+		}
+	}()
+
 	if err := c.cmd.Execute(); err != nil {
 		// Don't return error if help was displayed (from --plugins --help pattern)
 		if err == errHelpDisplayed {
@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 	golangv4 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4"
 )
 
@@ -586,6 +587,73 @@ plugins:
 			Expect(c.resolvePlugins()).To(Succeed())
 			Expect(c.projectVersion.Compare(projectVersion)).To(Equal(0))
 		})
+
+		It("should fail if the plugin requires a newer CLI version", func() {
+			ep := external.Plugin{
+				PName:                     "sample.example.com",
+				PVersion:                  plugin.Version{Number: 1},
+				PSupportedProjectVersions: []config.Version{projectVersion},
+				RequiredCLIVersion:        "v4.9.0",
+			}
+			c.plugins[plugin.KeyFor(ep)] = ep
+			c.pluginKeys = []string{"sample.example.com/v1"}
+			c.projectVersion = projectVersion
+
+			c.cliVersion = "4.8.0"
+			Expect(c.resolvePlugins()).To(MatchError(ContainSubstring("requires kubebuilder v4.9.0 or newer")))
+
+			c.cliVersion = "4.9.1"
+			Expect(c.resolvePlugins()).To(Succeed())
+		})
+
+		Context("with plugin requirements and conflicts", func() {
+			var ep external.Plugin
+
+			BeforeEach(func() {
+				ep = external.Plugin{
+					PName:                     "sample.example.com",
+					PVersion:                  plugin.Version{Number: 1},
+					PSupportedProjectVersions: []config.Version{projectVersion},
+					PRequires:                 []string{"foo.example.com/v1"},
+					PConflictsWith:            []string{"bar.example.com"},
+				}
+				c.plugins[plugin.KeyFor(ep)] = ep
+				c.projectVersion = projectVersion
+			})
+
+			It("should order the plugins after the plugins they require", func() {
+				c.pluginKeys = []string{"sample.example.com/v1", "baz.example.com/v1", "foo.example.com/v1"}
+
+				Expect(c.resolvePlugins()).To(Succeed())
+				Expect(c.resolvedPlugins).To(HaveLen(3))
+				Expect(plugin.KeyFor(c.resolvedPlugins[0])).To(Equal("baz.example.com/v1"))
+				Expect(plugin.KeyFor(c.resolvedPlugins[1])).To(Equal("foo.example.com/v1"))
+				Expect(plugin.KeyFor(c.resolvedPlugins[2])).To(Equal("sample.example.com/v1"))
+			})
+
+			It("should accept requirements satisfied by the plugins the project was scaffolded with", func() {
+				c.projectPluginKeys = []string{"foo.example.com/v1"}
+				c.pluginKeys = []string{"sample.example.com/v1"}
+
+				Expect(c.resolvePlugins()).To(Succeed())
+				Expect(c.resolvedPlugins).To(HaveLen(1))
+			})
+
+			It("should fail if a required plugin is missing", func() {
+				c.pluginKeys = []string{"sample.example.com/v1"}
+
+				Expect(c.resolvePlugins()).To(MatchError(ContainSubstring(
+					`plugin "sample.example.com/v1" requires "foo.example.com/v1"`)))
+			})
+
+			It("should fail if conflicting plugins are used together", func() {
+				c.projectPluginKeys = []string{"bar.example.com/v1"}
+				c.pluginKeys = []string{"foo.example.com/v1", "sample.example.com/v1"}
+
+				Expect(c.resolvePlugins()).To(MatchError(ContainSubstring(
+					`plugins "bar.example.com/v1" and "sample.example.com/v1" cannot be used together`)))
+			})
+		})
 	})
 
 	Context("applySubcommandHooks", func() {
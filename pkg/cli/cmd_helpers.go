@@ -19,7 +19,10 @@ package cli
 import (
 	"errors"
 	"fmt"
+	"io"
+	log "log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -136,16 +139,23 @@ func (c *CLI) applySubcommandHooks(
 		}
 	}
 
+	// We extract the plugin keys again instead of using the ones obtained when filtering subcommands
+	// as these plugins are unbundled but we want to keep bundle names.
+	resolvedPluginKeys := make([]string, 0, len(c.resolvedPlugins))
+	for _, p := range c.resolvedPlugins {
+		resolvedPluginKeys = append(resolvedPluginKeys, plugin.KeyFor(p))
+	}
+
 	// In case we create a new project configuration we need to compute the plugin chain.
-	pluginChain := make([]string, 0, len(c.resolvedPlugins))
+	var pluginChain []string
 	if createConfig {
-		// We extract the plugin keys again instead of using the ones obtained when filtering subcommands
-		// as these plugins are unbundled but we want to keep bundle names in the plugin chain.
-		for _, p := range c.resolvedPlugins {
-			pluginChain = append(pluginChain, plugin.KeyFor(p))
-		}
+		pluginChain = resolvedPluginKeys
 	}
 
+	cmd.Flags().Bool(dryRunFlag, false,
+		"print the changes that would be made as a unified diff instead of writing them, "+
+			"post-scaffold tasks (e.g. go mod tidy, make) are skipped")
+
 	result, err := initializationHooks(cmd, subcommands, c.metadata())
 	if err != nil {
 		cmdErr(cmd, err)
@@ -159,6 +169,7 @@ func (c *CLI) applySubcommandHooks(
 		errorMessage:        errorMessage,
 		projectVersion:      c.projectVersion,
 		pluginChain:         pluginChain,
+		resolvedPluginKeys:  resolvedPluginKeys,
 		cliVersion:          c.cliVersion,
 		duplicateFlagValues: result.duplicateFlagValues,
 	}
@@ -301,20 +312,36 @@ type executionHooksFactory struct {
 	projectVersion config.Version
 	// pluginChain is the plugin chain configured for this project.
 	pluginChain []string
+	// resolvedPluginKeys are the keys of the plugins resolved for this subcommand, keeping bundle keys.
+	resolvedPluginKeys []string
 	// cliVersion is the version of the CLI.
 	cliVersion string
 	// duplicateFlagValues maps flag names to Values to sync from the parsed flag in PreRunE.
 	duplicateFlagValues map[string][]pflag.Value
+	// recorder keeps the changes instead of writing them when running with --dry-run.
+	recorder *machinery.RecordingFs
+	// manifest tracks the files scaffolded by the plugins.
+	manifest *machinery.Manifest
+	// unmodifiedFiles are the files tracked by the manifest that were not modified before running the hooks.
+	unmodifiedFiles []string
+	// resource is the resource the subcommand was run for, if any.
+	resource *resource.Resource
 }
 
 func (factory *executionHooksFactory) forEach(cb func(subcommand plugin.Subcommand) error, errorMessage string) error {
+	return factory.forEachTuple(func(tuple keySubcommandTuple) error {
+		return cb(tuple.subcommand)
+	}, errorMessage)
+}
+
+func (factory *executionHooksFactory) forEachTuple(cb func(tuple keySubcommandTuple) error, errorMessage string) error {
 	for i, tuple := range factory.subcommands {
 		if tuple.skip {
 			continue
 		}
 
 		err := factory.withPluginChain(tuple, func() error {
-			return cb(tuple.subcommand)
+			return cb(tuple)
 		})
 
 		var exitError plugin.ExitError
@@ -405,6 +432,9 @@ func (factory *executionHooksFactory) preRunEFunc(
 		if len(factory.duplicateFlagValues) > 0 {
 			syncDuplicateFlags(cmd.Flags(), factory.duplicateFlagValues)
 		}
+		if dryRun, _ := cmd.Flags().GetBool(dryRunFlag); dryRun {
+			factory.startDryRun()
+		}
 		if createConfig {
 			// Check if a project configuration is already present.
 			if err := factory.store.Load(); err == nil || !errors.Is(err, os.ErrNotExist) {
@@ -426,6 +456,11 @@ func (factory *executionHooksFactory) preRunEFunc(
 		}
 		cfg := factory.store.Config()
 
+		// Load the scaffold manifest.
+		if err := factory.loadManifest(); err != nil {
+			return fmt.Errorf("%s: %w", factory.errorMessage, err)
+		}
+
 		// Set the CLI version if creating a new project configuration.
 		if createConfig {
 			_ = cfg.SetCliVersion(factory.cliVersion)
@@ -446,6 +481,7 @@ func (factory *executionHooksFactory) preRunEFunc(
 			}
 			res = options.newResource()
 		}
+		factory.resource = res
 
 		// Inject config hook.
 		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
@@ -475,9 +511,9 @@ func (factory *executionHooksFactory) preRunEFunc(
 
 		// Pre-scaffold hook.
 		//nolint:revive
-		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
-			if subcommand, hasPreScaffold := subcommand.(plugin.HasPreScaffold); hasPreScaffold {
-				return subcommand.PreScaffold(factory.fs)
+		if err := factory.forEachTuple(func(tuple keySubcommandTuple) error {
+			if subcommand, hasPreScaffold := tuple.subcommand.(plugin.HasPreScaffold); hasPreScaffold {
+				return subcommand.PreScaffold(factory.fsFor(tuple.key))
 			}
 			return nil
 		}, "unable to run pre-scaffold tasks of"); err != nil {
@@ -493,8 +529,8 @@ func (factory *executionHooksFactory) runEFunc() func(*cobra.Command, []string)
 	return func(*cobra.Command, []string) error {
 		// Scaffold hook.
 		//nolint:revive
-		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
-			return subcommand.Scaffold(factory.fs)
+		if err := factory.forEachTuple(func(tuple keySubcommandTuple) error {
+			return tuple.subcommand.Scaffold(factory.fsFor(tuple.key))
 		}, "unable to scaffold with"); err != nil {
 			return err
 		}
@@ -506,22 +542,175 @@ func (factory *executionHooksFactory) runEFunc() func(*cobra.Command, []string)
 // postRunEFunc returns a cobra RunE function that saves the configuration
 // and executes the post-scaffold hook.
 func (factory *executionHooksFactory) postRunEFunc() func(*cobra.Command, []string) error {
-	return func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, _ []string) error {
+		if err := factory.recordScaffold(cmd); err != nil {
+			return fmt.Errorf("%s: %w", factory.errorMessage, err)
+		}
+
 		if err := factory.store.Save(); err != nil {
 			return fmt.Errorf("%s: failed to save configuration file: %w", factory.errorMessage, err)
 		}
 
+		if factory.recorder != nil {
+			if err := factory.saveManifest(); err != nil {
+				return fmt.Errorf("%s: %w", factory.errorMessage, err)
+			}
+
+			log.Info("Dry run: no file was written and the post-scaffold tasks were skipped")
+			if err := printChanges(cmd.OutOrStdout(), factory.recorder); err != nil {
+				return fmt.Errorf("%s: failed to print the changes: %w", factory.errorMessage, err)
+			}
+			return nil
+		}
+
 		// Post-scaffold hook.
-		//nolint:revive
-		if err := factory.forEach(func(subcommand plugin.Subcommand) error {
+		postScaffoldErr := factory.forEach(func(subcommand plugin.Subcommand) error {
 			if subcommand, hasPostScaffold := subcommand.(plugin.HasPostScaffold); hasPostScaffold {
 				return subcommand.PostScaffold()
 			}
 			return nil
-		}, "unable to run post-scaffold tasks of"); err != nil {
-			return err
+		}, "unable to run post-scaffold tasks of")
+
+		// The manifest is saved after the post-scaffold tasks, as they also update scaffolded files (e.g. go.mod).
+		if err := factory.saveManifest(); err != nil {
+			return errors.Join(postScaffoldErr, fmt.Errorf("%s: %w", factory.errorMessage, err))
+		}
+
+		return postScaffoldErr
+	}
+}
+
+// recordScaffold records the plugins and flags the subcommand was run with for its resource,
+// if the project configuration keeps track of how resources were scaffolded.
+func (factory *executionHooksFactory) recordScaffold(cmd *cobra.Command) error {
+	tracker, tracksProvenance := factory.store.Config().(config.ProvenanceTracker)
+	if !tracksProvenance || factory.resource == nil {
+		return nil
+	}
+
+	record := config.ScaffoldRecord{
+		Command: strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		Plugins: factory.resolvedPluginKeys,
+		Flags:   scaffoldFlags(cmd.LocalNonPersistentFlags()),
+	}
+	// Subcommands can remove the resource, e.g. when deleting its last webhook, so there is nothing to record
+	err := tracker.RecordScaffold(factory.resource.GVK, record)
+	if err != nil && !errors.As(err, &config.ResourceNotFoundError{}) {
+		return fmt.Errorf("failed to record the scaffold of the resource: %w", err)
+	}
+
+	return nil
+}
+
+// scaffoldFlags returns the flags that were set or that plugins changed from their default values,
+// in the "--name=value" form, skipping the ones that identify the resource or do not scaffold anything.
+func scaffoldFlags(fs *pflag.FlagSet) []string {
+	var flags []string
+	fs.VisitAll(func(flag *pflag.Flag) {
+		switch flag.Name {
+		case "group", "version", "kind", dryRunFlag, "help":
+			return
+		}
+		if !flag.Changed && flag.Value.String() == flag.DefValue {
+			return
+		}
+
+		if slice, isSlice := flag.Value.(pflag.SliceValue); isSlice {
+			for _, value := range slice.GetSlice() {
+				flags = append(flags, fmt.Sprintf("--%s=%s", flag.Name, value))
+			}
+			return
+		}
+		flags = append(flags, fmt.Sprintf("--%s=%s", flag.Name, flag.Value))
+	})
+
+	return flags
+}
+
+// fsFor returns the filesystem for the plugin with the provided key to scaffold with,
+// which records the scaffolded files in the manifest.
+func (factory *executionHooksFactory) fsFor(pluginKey string) machinery.Filesystem {
+	fs := factory.fs
+	fs.Manifest = factory.manifest
+	fs.PluginKey = pluginKey
+	return fs
+}
+
+// loadManifest loads the scaffold manifest and the files it tracks that were not modified.
+func (factory *executionHooksFactory) loadManifest() error {
+	manifest, err := machinery.LoadManifest(factory.fs.FS, machinery.DefaultManifestPath)
+	if err != nil {
+		return fmt.Errorf("failed to load scaffold manifest: %w", err)
+	}
+
+	unmodifiedFiles, err := manifest.UnmodifiedFiles(factory.fs.FS)
+	if err != nil {
+		return fmt.Errorf("failed to check the files tracked by the scaffold manifest: %w", err)
+	}
+
+	factory.manifest = manifest
+	factory.unmodifiedFiles = unmodifiedFiles
+	return nil
+}
+
+// saveManifest updates the checksums of the files that were not modified by the user and saves the manifest.
+func (factory *executionHooksFactory) saveManifest() error {
+	if factory.manifest == nil {
+		return nil
+	}
+
+	if err := factory.manifest.Sync(factory.fs.FS, factory.unmodifiedFiles); err != nil {
+		return fmt.Errorf("failed to update scaffold manifest: %w", err)
+	}
+
+	// Do not create an empty manifest for commands that did not scaffold any file
+	if len(factory.manifest.Files) == 0 {
+		if _, err := factory.fs.FS.Stat(machinery.DefaultManifestPath); os.IsNotExist(err) {
+			return nil
 		}
+	}
+	if err := factory.manifest.Save(factory.fs.FS, machinery.DefaultManifestPath); err != nil {
+		return fmt.Errorf("failed to save scaffold manifest: %w", err)
+	}
 
+	return nil
+}
+
+// startDryRun makes the hooks record the changes in memory instead of writing them.
+func (factory *executionHooksFactory) startDryRun() {
+	factory.recorder = machinery.NewRecordingFs(factory.fs.FS)
+	factory.fs = machinery.Filesystem{FS: factory.recorder, DryRun: true}
+	factory.store = yamlstore.New(factory.fs)
+}
+
+// printChanges writes the recorded changes as a unified diff with git-style paths.
+func printChanges(w io.Writer, recorder *machinery.RecordingFs) error {
+	changes, err := recorder.Changes()
+	if err != nil {
+		return fmt.Errorf("error collecting changes: %w", err)
+	}
+	if len(changes) == 0 {
+		log.Info("Dry run: no file would be changed")
 		return nil
 	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error getting current directory: %w", err)
+	}
+	for _, change := range changes {
+		// Show the paths relative to the project, as some plugins write to absolute paths.
+		if filepath.IsAbs(change.Path) {
+			if rel, relErr := filepath.Rel(wd, change.Path); relErr == nil {
+				change.Path = rel
+			}
+		}
+		change.Path = filepath.ToSlash(change.Path)
+
+		if _, err = io.WriteString(w, change.UnifiedDiff()); err != nil {
+			return fmt.Errorf("error writing diff of %q: %w", change.Path, err)
+		}
+	}
+
+	return nil
 }
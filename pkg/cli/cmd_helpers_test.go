@@ -17,16 +17,21 @@ limitations under the License.
 package cli
 
 import (
+	"bytes"
 	"errors"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	yamlstore "sigs.k8s.io/kubebuilder/v4/pkg/config/store/yaml"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	pluginutil "sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 )
 
 var _ = Describe("cmd_helpers", func() {
@@ -437,8 +442,232 @@ var _ = Describe("cmd_helpers", func() {
 			Expect(pluginB.Force).To(BeTrue(), "second plugin (duplicate) receives same value after sync")
 		})
 	})
+
+	Context("dry run", func() {
+		const projectFile = `# Code generated by tool. DO NOT EDIT.
+# This file is used to track the info used to scaffold your project
+# and allow the plugins properly work.
+# More info: https://book.kubebuilder.io/reference/project-config.html
+domain: my.domain
+version: "3"
+`
+
+		var (
+			fs         machinery.Filesystem
+			cmd        *cobra.Command
+			out        *bytes.Buffer
+			subcommand *mockScaffoldingSubcommand
+			factory    *executionHooksFactory
+		)
+
+		BeforeEach(func() {
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "PROJECT", []byte(projectFile), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, "Makefile", []byte("all: build\n"), 0o644)).To(Succeed())
+
+			out = &bytes.Buffer{}
+			cmd = &cobra.Command{Use: "edit"}
+			cmd.SetOut(out)
+			cmd.Flags().Bool(dryRunFlag, false, "")
+
+			subcommand = &mockScaffoldingSubcommand{}
+			factory = &executionHooksFactory{
+				fs:           fs,
+				store:        yamlstore.New(fs),
+				subcommands:  []keySubcommandTuple{{key: "mock.kubebuilder.io/v1", subcommand: subcommand}},
+				errorMessage: "failed to edit project",
+			}
+		})
+
+		run := func(args ...string) {
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+			Expect(factory.preRunEFunc(nil, false)(cmd, nil)).To(Succeed())
+			Expect(factory.runEFunc()(cmd, nil)).To(Succeed())
+			Expect(factory.postRunEFunc()(cmd, nil)).To(Succeed())
+		}
+
+		It("should write the changes and run the post-scaffold hook without the flag", func() {
+			run()
+
+			Expect(subcommand.dryRun).To(BeFalse())
+			Expect(subcommand.postScaffolded).To(BeTrue())
+			Expect(out.String()).To(BeEmpty())
+
+			content, err := afero.ReadFile(fs.FS, "new.txt")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("new\n"))
+		})
+
+		It("should print the changes instead of writing them", func() {
+			run("--dry-run")
+
+			Expect(subcommand.dryRun).To(BeTrue())
+			Expect(subcommand.postScaffolded).To(BeFalse())
+
+			exists, err := afero.Exists(fs.FS, "new.txt")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+			content, err := afero.ReadFile(fs.FS, "Makefile")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal("all: build\n"))
+			content, err = afero.ReadFile(fs.FS, "PROJECT")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(projectFile))
+
+			Expect(out.String()).To(Equal(`--- a/Makefile
++++ b/Makefile
+@@ -1 +1,2 @@
+ all: build
++build:
+--- a/PROJECT
++++ b/PROJECT
+@@ -3,4 +3,5 @@
+ # and allow the plugins properly work.
+ # More info: https://book.kubebuilder.io/reference/project-config.html
+ domain: my.domain
++projectName: mock
+ version: "3"
+--- /dev/null
++++ b/new.txt
+@@ -0,0 +1 @@
++new
+`))
+		})
+	})
+
+	Context("scaffold provenance", func() {
+		var (
+			fs      machinery.Filesystem
+			cmd     *cobra.Command
+			options *resourceOptions
+			factory *executionHooksFactory
+		)
+
+		BeforeEach(func() {
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+			Expect(afero.WriteFile(fs.FS, "PROJECT", []byte("domain: my.domain\nversion: 4-alpha\n"), 0o644)).
+				To(Succeed())
+
+			root := &cobra.Command{Use: "kubebuilder"}
+			root.PersistentFlags().StringSlice(pluginsFlag, nil, "")
+			create := &cobra.Command{Use: "create"}
+			cmd = &cobra.Command{Use: "api"}
+			root.AddCommand(create)
+			create.AddCommand(cmd)
+
+			options = bindResourceFlags(cmd.Flags())
+			cmd.Flags().Bool(dryRunFlag, false, "")
+			cmd.Flags().Bool("make", true, "")
+			cmd.Flags().String("controller-name", "", "")
+			cmd.Flags().StringSlice("spoke", nil, "")
+
+			factory = &executionHooksFactory{
+				fs:    fs,
+				store: yamlstore.New(fs),
+				subcommands: []keySubcommandTuple{
+					{key: "mock.kubebuilder.io/v1", subcommand: &mockResourceSubcommand{}},
+				},
+				resolvedPluginKeys: []string{"mock.kubebuilder.io/v1"},
+				errorMessage:       "failed to create API",
+			}
+		})
+
+		run := func(args ...string) {
+			Expect(cmd.ParseFlags(args)).To(Succeed())
+			Expect(factory.preRunEFunc(options, false)(cmd, nil)).To(Succeed())
+			Expect(factory.runEFunc()(cmd, nil)).To(Succeed())
+			Expect(factory.postRunEFunc()(cmd, nil)).To(Succeed())
+		}
+
+		It("should record the plugins and flags the resource was scaffolded with", func() {
+			run("--group", "crew", "--version", "v1", "--kind", "Captain", "--plugins", "mock.kubebuilder.io/v1",
+				"--controller-name", "captain-backup", "--spoke", "v2", "--spoke", "v3", "--make=true")
+
+			Expect(factory.store.Load()).To(Succeed())
+			tracker := factory.store.Config().(config.ProvenanceTracker)
+			records, err := tracker.GetScaffoldRecords(factory.resource.GVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]config.ScaffoldRecord{{
+				Command: "create api",
+				Plugins: []string{"mock.kubebuilder.io/v1"},
+				Flags:   []string{"--controller-name=captain-backup", "--make=true", "--spoke=v2", "--spoke=v3"},
+			}}))
+		})
+
+		It("should not record anything for project versions that do not track it", func() {
+			Expect(afero.WriteFile(fs.FS, "PROJECT", []byte("domain: my.domain\nversion: \"3\"\n"), 0o644)).
+				To(Succeed())
+			run("--group", "crew", "--version", "v1", "--kind", "Captain", "--controller-name", "captain-backup")
+
+			content, err := afero.ReadFile(fs.FS, "PROJECT")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).NotTo(ContainSubstring("scaffold:"))
+		})
+	})
+
+	Context("scaffoldFlags", func() {
+		It("should include the flags whose value was changed by plugins", func() {
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			doAPI := flags.Bool("resource", true, "")
+			flags.Bool("controller", true, "")
+			flags.String("group", "", "")
+			Expect(flags.Parse([]string{"--group", "crew"})).To(Succeed())
+			*doAPI = false
+
+			Expect(scaffoldFlags(flags)).To(Equal([]string{"--resource=false"}))
+		})
+	})
 })
 
+// mockResourceSubcommand adds the injected resource to the project configuration.
+type mockResourceSubcommand struct {
+	config   config.Config
+	resource *resource.Resource
+}
+
+func (m *mockResourceSubcommand) InjectConfig(c config.Config) error {
+	m.config = c
+	return nil
+}
+
+func (m *mockResourceSubcommand) InjectResource(res *resource.Resource) error {
+	m.resource = res
+	return nil
+}
+
+func (m *mockResourceSubcommand) Scaffold(machinery.Filesystem) error {
+	return m.config.UpdateResource(*m.resource)
+}
+
+// mockScaffoldingSubcommand updates the project configuration, writes a file and edits
+// another one through the plugin util helpers, and records the hooks it went through.
+type mockScaffoldingSubcommand struct {
+	config         config.Config
+	dryRun         bool
+	postScaffolded bool
+}
+
+func (m *mockScaffoldingSubcommand) InjectConfig(c config.Config) error {
+	m.config = c
+	return nil
+}
+
+func (m *mockScaffoldingSubcommand) Scaffold(fs machinery.Filesystem) error {
+	m.dryRun = fs.DryRun
+	if err := m.config.SetProjectName("mock"); err != nil {
+		return err
+	}
+	if err := afero.WriteFile(fs.FS, "new.txt", []byte("new\n"), 0o644); err != nil {
+		return err
+	}
+	return pluginutil.AppendCodeAtTheEndInFS(fs, "Makefile", "build:\n")
+}
+
+func (m *mockScaffoldingSubcommand) PostScaffold() error {
+	m.postScaffolded = true
+	return nil
+}
+
 type mockTestSubcommand struct{}
 
 func (m *mockTestSubcommand) Scaffold(machinery.Filesystem) error {
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+//nolint:dupl
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+const deleteAPIErrorMsg = "failed to delete API"
+
+func (c CLI) newDeleteAPICmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "api",
+		Short: "Remove a scaffolded Kubernetes API",
+		Long:  `Remove a scaffolded Kubernetes API and its controllers.`,
+		RunE: errCmdFunc(
+			fmt.Errorf("api subcommand requires an existing project"),
+		),
+	}
+
+	// In case no plugin was resolved, instead of failing the construction of the CLI, fail the execution of
+	// this subcommand. This allows the use of subcommands that do not require resolved plugins like help.
+	if len(c.resolvedPlugins) == 0 {
+		cmdErr(cmd, noResolvedPluginError{})
+		return cmd
+	}
+
+	// Obtain the plugin keys and subcommands from the plugins that implement plugin.DeleteAPI.
+	subcommands := c.filterSubcommands(
+		func(p plugin.Plugin) bool {
+			s, isValid := p.(plugin.DeleteAPI)
+			return isValid && s.GetDeleteAPISubcommand() != nil
+		},
+		func(p plugin.Plugin) plugin.Subcommand {
+			return p.(plugin.DeleteAPI).GetDeleteAPISubcommand()
+		},
+	)
+
+	// Verify that there is at least one remaining plugin.
+	if len(subcommands) == 0 {
+		cmdErr(cmd, noAvailablePluginError{"API deletion"})
+		return cmd
+	}
+
+	c.applySubcommandHooks(cmd, subcommands, deleteAPIErrorMsg, false)
+
+	// Append plugin table after metadata updates
+	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
+		s, isValid := p.(plugin.DeleteAPI)
+		return isValid && s.GetDeleteAPISubcommand() != nil
+	}, "Available plugins that support 'delete api'")
+
+	return cmd
+}
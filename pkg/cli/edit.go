@@ -47,8 +47,8 @@ func (c CLI) newEditCmd() *cobra.Command {
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.Edit.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.Edit)
-			return isValid
+			s, isValid := p.(plugin.Edit)
+			return isValid && s.GetEditSubcommand() != nil
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
 			return p.(plugin.Edit).GetEditSubcommand()
@@ -65,8 +65,8 @@ func (c CLI) newEditCmd() *cobra.Command {
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.Edit)
-		return isValid
+		s, isValid := p.(plugin.Edit)
+		return isValid && s.GetEditSubcommand() != nil
 	}, "Available plugins that support 'edit'")
 
 	return cmd
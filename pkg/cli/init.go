@@ -56,8 +56,8 @@ For further help about a specific plugin, set --plugins.
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.Init.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.Init)
-			return isValid
+			s, isValid := p.(plugin.Init)
+			return isValid && s.GetInitSubcommand() != nil
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
 			return p.(plugin.Init).GetInitSubcommand()
@@ -74,8 +74,8 @@ For further help about a specific plugin, set --plugins.
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.Init)
-		return isValid
+		s, isValid := p.(plugin.Init)
+		return isValid && s.GetInitSubcommand() != nil
 	}, "Available plugins that support 'init'")
 
 	return cmd
@@ -287,13 +287,22 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 				continue
 			}
 
-			pluginFiles, err := afero.ReadDir(filesystem, filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name()))
+			versionPath := filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name())
+			pluginFiles, err := afero.ReadDir(filesystem, versionPath)
 			if err != nil {
-				return nil, fmt.Errorf("error reading plugion version directory %q: %w",
-					filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name()), err)
+				return nil, fmt.Errorf("error reading plugion version directory %q: %w", versionPath, err)
+			}
+
+			manifest, err := loadExternalPluginManifest(filesystem, versionPath, pluginInfo.Name(), version.Name())
+			if err != nil {
+				return nil, err
 			}
 
 			for _, pluginFile := range pluginFiles {
+				if pluginFile.Name() == external.ManifestFileName {
+					continue
+				}
+
 				// find the executable that matches the same name as info.Name().
 				// if no match is found, compare the external plugin string name before dot
 				// and match it with info.Name() which is the external plugin root dir.
@@ -311,7 +320,7 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 
 					ep := external.Plugin{
 						PName:                     pluginInfo.Name(),
-						Path:                      filepath.Join(pluginsRoot, pluginInfo.Name(), version.Name(), pluginFile.Name()),
+						Path:                      filepath.Join(versionPath, pluginFile.Name()),
 						PSupportedProjectVersions: []config.Version{cfgv3.Version},
 						Args:                      parseExternalPluginArgs(),
 					}
@@ -320,6 +329,10 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 						return nil, fmt.Errorf("error parsing external plugin version %q: %w", version.Name(), err)
 					}
 
+					if manifest != nil {
+						manifest.Apply(&ep)
+					}
+
 					slog.Debug("Adding external plugin", "plugin name", ep.Name())
 
 					ps = append(ps, ep)
@@ -331,6 +344,25 @@ func DiscoverExternalPlugins(filesystem afero.Fs) (ps []plugin.Plugin, err error
 	return ps, nil
 }
 
+// loadExternalPluginManifest loads the optional manifest of the external plugin found in versionPath.
+// It returns nil if the plugin does not provide a manifest.
+func loadExternalPluginManifest(filesystem afero.Fs, versionPath, name, version string) (*external.Manifest, error) {
+	manifestPath := filepath.Join(versionPath, external.ManifestFileName)
+	if _, err := filesystem.Stat(manifestPath); err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting stats for plugin manifest %q: %w", manifestPath, err)
+	}
+
+	manifest, err := external.LoadManifest(filesystem, manifestPath, name, version)
+	if err != nil {
+		return nil, fmt.Errorf("error loading external plugin %q: %w", name, err)
+	}
+
+	return manifest, nil
+}
+
 // isPluginExecutable checks if a plugin is an executable based on the bitmask and returns true or false.
 func isPluginExecutable(mode fs.FileMode) bool {
 	return mode&0o111 != 0
@@ -23,6 +23,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -33,6 +34,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/external"
 )
 
 var _ = Describe("Discover external plugins", func() {
@@ -308,6 +310,92 @@ var _ = Describe("Discover external plugins", func() {
 			Expect(plugins[1].Name()).To(Equal("myotherexternalPlugin"))
 		})
 
+		Context("with a plugin manifest", func() {
+			var manifestPath string
+
+			BeforeEach(func() {
+				err = filesystem.FS.Chmod(pluginFilePath, filePermissions)
+				Expect(err).ToNot(HaveOccurred())
+
+				manifestPath = filepath.Join(filepath.Dir(pluginFilePath), external.ManifestFileName)
+			})
+
+			It("should register the plugin with the metadata of the manifest", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte(`name: externalPlugin
+version: v1
+description: Sample external plugin
+supportedProjectVersions: ["3"]
+subcommands: ["init", "edit"]
+deprecationMessage: use another plugin
+requiredKubebuilderVersion: v4.5.0
+timeout: 30s
+env: ["GITHUB_TOKEN"]
+requires: ["base.go.kubebuilder.io/v4"]
+conflictsWith: ["helm.kubebuilder.io/v1-alpha"]
+`), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(plugins).To(HaveLen(1))
+
+				ep, isExternal := plugins[0].(external.Plugin)
+				Expect(isExternal).To(BeTrue())
+				Expect(ep.Description()).To(Equal("Sample external plugin"))
+				Expect(ep.DeprecationWarning()).To(Equal("use another plugin"))
+				Expect(ep.SupportedProjectVersions()).To(Equal([]config.Version{{Number: 3}}))
+				Expect(ep.GetInitSubcommand()).NotTo(BeNil())
+				Expect(ep.GetCreateAPISubcommand()).To(BeNil())
+				Expect(ep.CheckCLIVersion("4.5.0")).To(Succeed())
+				Expect(ep.CheckCLIVersion("4.4.0")).To(MatchError(ContainSubstring("requires kubebuilder v4.5.0")))
+				Expect(ep.Timeout).To(Equal(30 * time.Second))
+				Expect(ep.Env).To(Equal([]string{"GITHUB_TOKEN"}))
+				Expect(ep.Requires()).To(Equal([]string{"base.go.kubebuilder.io/v4"}))
+				Expect(ep.ConflictsWith()).To(Equal([]string{"helm.kubebuilder.io/v1-alpha"}))
+			})
+
+			It("should error if the manifest does not match the plugin directory", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte("name: otherPlugin\n"), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).To(MatchError(ContainSubstring(`name "otherPlugin" does not match`)))
+				Expect(plugins).To(BeEmpty())
+			})
+
+			It("should error if the manifest declares an unknown subcommand", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte("subcommands: [\"delete api\"]\n"),
+					0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).To(MatchError(ContainSubstring(`unknown subcommand "delete api"`)))
+				Expect(plugins).To(BeEmpty())
+			})
+
+			It("should error if the manifest has an invalid timeout", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte("timeout: -1m\n"), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).To(MatchError(ContainSubstring(`invalid timeout "-1m"`)))
+				Expect(plugins).To(BeEmpty())
+			})
+
+			It("should error if the manifest requires an invalid plugin key", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte("requires: [\"go/v1.0\"]\n"),
+					0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).To(MatchError(ContainSubstring(`invalid plugin key "go/v1.0"`)))
+				Expect(plugins).To(BeEmpty())
+			})
+
+			It("should error if the manifest has unknown fields", func() {
+				Expect(afero.WriteFile(filesystem.FS, manifestPath, []byte("descripton: typo\n"), 0o644)).To(Succeed())
+
+				plugins, err = DiscoverExternalPlugins(filesystem.FS)
+				Expect(err).To(MatchError(ContainSubstring("failed to unmarshal plugin manifest")))
+				Expect(plugins).To(BeEmpty())
+			})
+		})
+
 		Context("that are invalid", func() {
 			BeforeEach(func() {
 				filesystem = machinery.Filesystem{
@@ -130,7 +130,7 @@ Available plugins:
 
 To see which plugins support a specific command:
 
-    %[1]s <init|edit|create> --help
+    %[1]s <init|edit|create|delete> --help
 `,
 		c.commandName, c.getPluginTable())
 
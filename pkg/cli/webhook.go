@@ -47,8 +47,8 @@ func (c CLI) newCreateWebhookCmd() *cobra.Command {
 	// Obtain the plugin keys and subcommands from the plugins that implement plugin.CreateWebhook.
 	subcommands := c.filterSubcommands(
 		func(p plugin.Plugin) bool {
-			_, isValid := p.(plugin.CreateWebhook)
-			return isValid
+			s, isValid := p.(plugin.CreateWebhook)
+			return isValid && s.GetCreateWebhookSubcommand() != nil
 		},
 		func(p plugin.Plugin) plugin.Subcommand {
 			return p.(plugin.CreateWebhook).GetCreateWebhookSubcommand()
@@ -65,8 +65,8 @@ func (c CLI) newCreateWebhookCmd() *cobra.Command {
 
 	// Append plugin table after metadata updates
 	c.appendPluginTable(cmd, func(p plugin.Plugin) bool {
-		_, isValid := p.(plugin.CreateWebhook)
-		return isValid
+		s, isValid := p.(plugin.CreateWebhook)
+		return isValid && s.GetCreateWebhookSubcommand() != nil
 	}, "Available plugins that support 'create webhook'")
 
 	return cmd
@@ -32,6 +32,17 @@ func (e UnsupportedVersionError) Error() string {
 	return fmt.Sprintf("version %s is not supported", e.Version)
 }
 
+// UnsupportedMigrationError is returned by Migrate when no migration between two versions was registered.
+type UnsupportedMigrationError struct {
+	From Version
+	To   Version
+}
+
+// Error implements error interface
+func (e UnsupportedMigrationError) Error() string {
+	return fmt.Sprintf("migration from version %s to %s is not supported", e.From, e.To)
+}
+
 // UnsupportedFieldError is returned when a project configuration version does not support
 // one of the fields as interface must be common for all the versions
 type UnsupportedFieldError struct {
@@ -41,6 +41,23 @@ var _ = Describe("UnsupportedVersionError", func() {
 	})
 })
 
+var _ = Describe("UnsupportedMigrationError", func() {
+	var err UnsupportedMigrationError
+
+	BeforeEach(func() {
+		err = UnsupportedMigrationError{
+			From: Version{Number: 1},
+			To:   Version{Number: 2},
+		}
+	})
+
+	Context("Error", func() {
+		It("should return the correct error message", func() {
+			Expect(err.Error()).To(Equal("migration from version 1 to 2 is not supported"))
+		})
+	})
+})
+
 var _ = Describe("UnsupportedFieldError", func() {
 	var err UnsupportedFieldError
 
@@ -17,6 +17,7 @@ limitations under the License.
 package config
 
 import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/schema"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
@@ -75,6 +76,13 @@ type Config interface {
 	// ClearNamespaced disables namespace-scoped deployment (default: cluster-scoped).
 	ClearNamespaced() error
 
+	/* List fields */
+
+	// GetWatchNamespaces returns the namespaces watched by a namespace-scoped manager, if any.
+	GetWatchNamespaces() []string
+	// SetWatchNamespaces sets the namespaces watched by a namespace-scoped manager, an empty list unsets them.
+	SetWatchNamespaces(namespaces []string) error
+
 	/* Resources */
 
 	// ResourcesLength returns the number of tracked resources.
@@ -89,6 +97,11 @@ type Config interface {
 	AddResource(res resource.Resource) error
 	// UpdateResource adds the provided resource if it was not present, modifies it if it was already present.
 	UpdateResource(res resource.Resource) error
+	// SetResource replaces the stored resource matching the GVK of the provided one, errors if it was not present.
+	// Unlike UpdateResource, fields are overwritten instead of merged, which allows to unset them.
+	SetResource(res resource.Resource) error
+	// RemoveResource removes the resource matching the provided GVK, errors if it was not present.
+	RemoveResource(gvk resource.GVK) error
 
 	// HasGroup checks if the provided group is the same as any of the tracked resources.
 	HasGroup(group string) bool
@@ -113,3 +126,21 @@ type Config interface {
 	// UnmarshalYAML Unmarshal loads the Config fields from its YAML representation.
 	UnmarshalYAML([]byte) error
 }
+
+// SchemaProvider is an optional interface for project configuration types that describe their YAML representation.
+type SchemaProvider interface {
+	// Schema returns the JSON Schema of the YAML representation of the configuration. The configuration objects
+	// stored by plugins are described by the zero values of pluginConfigs, mapped by plugin key.
+	Schema(pluginConfigs map[string]any) *schema.Schema
+}
+
+// ProvenanceTracker is an optional interface for project configuration types that record how resources were
+// scaffolded, so that the same subcommands can be run again to re-scaffold them.
+type ProvenanceTracker interface {
+	// RecordScaffold appends record to the subcommands that scaffolded the resource matching the provided GVK,
+	// errors if it was not present.
+	RecordScaffold(gvk resource.GVK, record ScaffoldRecord) error
+	// GetScaffoldRecords returns the subcommands that scaffolded the resource matching the provided GVK
+	// in the order they were run, errors if it was not present.
+	GetScaffoldRecords(gvk resource.GVK) ([]ScaffoldRecord, error)
+}
@@ -0,0 +1,41 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package config
+
+import (
+	"strings"
+)
+
+// ScaffoldRecord records a subcommand that scaffolded a resource
+type ScaffoldRecord struct {
+	// Command is the subcommand that was run, e.g. "create webhook"
+	Command string `json:"command"`
+	// Plugins are the keys of the plugins the subcommand was run with
+	Plugins []string `json:"plugins,omitempty"`
+	// Flags are the flags the subcommand was run with, in the "--name=value" form,
+	// except the ones identifying the resource (group, version and kind)
+	Flags []string `json:"flags,omitempty"`
+}
+
+// Args returns the arguments to run the recorded subcommand again, which still need the resource flags
+func (r ScaffoldRecord) Args() []string {
+	args := strings.Fields(r.Command)
+	if len(r.Plugins) != 0 {
+		args = append(args, "--plugins="+strings.Join(r.Plugins, ","))
+	}
+	return append(args, r.Flags...)
+}
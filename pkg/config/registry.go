@@ -16,7 +16,15 @@ limitations under the License.
 
 package config
 
-var registry = make(map[Version]func() Config)
+var (
+	registry   = make(map[Version]func() Config)
+	migrations = make(map[migrationKey]func(Config) (Config, error))
+)
+
+// migrationKey identifies a migration between two versions
+type migrationKey struct {
+	from, to Version
+}
 
 // Register allows implementations of Config to register themselves so that they can be created with New
 func Register(version Version, constructor func() Config) {
@@ -37,3 +45,24 @@ func New(version Version) (Config, error) {
 
 	return nil, UnsupportedVersionError{Version: version}
 }
+
+// RegisterMigration allows implementations of Config to register how to migrate configurations from a previous
+// version so that they can be migrated with Migrate
+func RegisterMigration(from, to Version, migrate func(Config) (Config, error)) {
+	migrations[migrationKey{from: from, to: to}] = migrate
+}
+
+// Migrate converts cfg to the provided version through the previously registered migrations through
+// RegisterMigration, returning cfg itself if it already has that version
+func Migrate(cfg Config, to Version) (Config, error) {
+	from := cfg.GetVersion()
+	if from.Compare(to) == 0 {
+		return cfg, nil
+	}
+
+	if migrate, exists := migrations[migrationKey{from: from, to: to}]; exists {
+		return migrate(cfg)
+	}
+
+	return nil, UnsupportedMigrationError{From: from, To: to}
+}
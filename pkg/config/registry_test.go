@@ -21,6 +21,14 @@ import (
 	. "github.com/onsi/gomega"
 )
 
+// versionedConfig is a Config that only implements GetVersion
+type versionedConfig struct {
+	Config
+	version Version
+}
+
+func (c versionedConfig) GetVersion() Version { return c.version }
+
 var _ = Describe("registry", func() {
 	var (
 		version Version
@@ -34,6 +42,7 @@ var _ = Describe("registry", func() {
 
 	AfterEach(func() {
 		registry = make(map[Version]func() Config)
+		migrations = make(map[migrationKey]func(Config) (Config, error))
 	})
 
 	Context("Register", func() {
@@ -67,4 +76,37 @@ var _ = Describe("registry", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Context("Migrate", func() {
+		var (
+			from Version
+			to   Version
+		)
+
+		BeforeEach(func() {
+			from = Version{Number: 1}
+			to = Version{Number: 2}
+		})
+
+		It("should use the registered migrations", func() {
+			RegisterMigration(from, to, func(Config) (Config, error) {
+				return versionedConfig{version: to}, nil
+			})
+			result, err := Migrate(versionedConfig{version: from}, to)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.GetVersion()).To(Equal(to))
+		})
+
+		It("should return the same config if it already has the version", func() {
+			cfg := versionedConfig{version: to}
+			result, err := Migrate(cfg, to)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(cfg))
+		})
+
+		It("should fail for unregistered migrations", func() {
+			_, err := Migrate(versionedConfig{version: from}, to)
+			Expect(err).To(MatchError(UnsupportedMigrationError{From: from, To: to}))
+		})
+	})
 })
@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schema generates JSON Schemas from Go types and validates YAML documents against them,
+// reporting the line of each error so that files like PROJECT can be validated by editors and CI.
+package schema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Draft is the JSON Schema dialect of the generated schemas
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Types of the values described by a Schema
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeBoolean = "boolean"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+)
+
+// Schema is the subset of JSON Schema used to describe YAML documents
+type Schema struct {
+	Schema      string `json:"$schema,omitempty"`
+	ID          string `json:"$id,omitempty"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type is the type of the value, any type is allowed if empty
+	Type string `json:"type,omitempty"`
+
+	// Properties describe the properties of an object
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	// AdditionalProperties describes the properties of an object not listed in Properties,
+	// which accept any value if nil
+	AdditionalProperties *Schema `json:"-"`
+	// Closed rejects the properties of an object not listed in Properties
+	Closed bool `json:"-"`
+	// Required lists the properties that an object must have
+	Required []string `json:"required,omitempty"`
+
+	// Items describes the items of an array
+	Items *Schema `json:"items,omitempty"`
+
+	// Pattern is a regular expression that a string must match
+	Pattern string `json:"pattern,omitempty"`
+	// Enum lists the values allowed for a string
+	Enum []string `json:"enum,omitempty"`
+
+	// AnyOf lists alternative schemas, the value must match at least one of them
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (s Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	out := struct {
+		schema
+		AdditionalProperties any `json:"additionalProperties,omitempty"`
+	}{schema: schema(s)}
+
+	if s.Closed {
+		out.AdditionalProperties = false
+	} else if s.AdditionalProperties != nil {
+		out.AdditionalProperties = s.AdditionalProperties
+	}
+
+	b, err := json.Marshal(out)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	return b, nil
+}
+
+// Provider is implemented by types with a custom YAML representation to describe it
+type Provider interface {
+	JSONSchema() *Schema
+}
+
+var providerType = reflect.TypeFor[Provider]()
+
+// For returns the schema of the YAML representation of v, as marshaled by sigs.k8s.io/yaml.
+// Structs are described by their exported fields, named after their json tags, and reject unknown properties.
+// Types implementing Provider describe themselves.
+func For(v any) *Schema {
+	if v == nil {
+		return &Schema{}
+	}
+	return forType(reflect.TypeOf(v))
+}
+
+func forType(t reflect.Type) *Schema {
+	if t.Implements(providerType) || reflect.PointerTo(t).Implements(providerType) {
+		return reflect.New(t).Interface().(Provider).JSONSchema()
+	}
+
+	switch t.Kind() {
+	case reflect.Pointer:
+		return forType(t.Elem())
+	case reflect.String:
+		return &Schema{Type: TypeString}
+	case reflect.Bool:
+		return &Schema{Type: TypeBoolean}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: TypeInteger}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: TypeNumber}
+	case reflect.Slice, reflect.Array:
+		// Byte slices are encoded as base64 strings
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: TypeString}
+		}
+		return &Schema{Type: TypeArray, Items: forType(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: TypeObject, AdditionalProperties: forType(t.Elem())}
+	case reflect.Struct:
+		s := &Schema{Type: TypeObject, Properties: make(map[string]*Schema), Closed: true}
+		addFields(s, t)
+		return s
+	default:
+		// Interfaces, and any other type, accept any value
+		return &Schema{}
+	}
+}
+
+// addFields adds the exported fields of the struct type t to the properties of s
+func addFields(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+
+		// Embedded structs without a name are inlined
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				addFields(s, embedded)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+		s.Properties[name] = forType(field.Type)
+	}
+}
@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.yaml.in/yaml/v3"
+)
+
+type sampleName string
+
+func (sampleName) JSONSchema() *Schema {
+	return &Schema{Type: TypeString, Pattern: `^[a-z]+$`}
+}
+
+type sampleMeta struct {
+	Name sampleName `json:"name"`
+}
+
+type sample struct {
+	sampleMeta `json:",inline"`
+
+	Replicas int               `json:"replicas,omitempty"`
+	Enabled  *bool             `json:"enabled,omitempty"`
+	Tags     []string          `json:"tags,omitempty"`
+	Labels   map[string]string `json:"labels,omitempty"`
+	Extra    any               `json:"extra,omitempty"`
+	Ignored  string            `json:"-"`
+	internal string
+}
+
+var _ = Describe("For", func() {
+	It("should describe the YAML representation of a type", func() {
+		s := For(sample{})
+
+		Expect(s.Type).To(Equal(TypeObject))
+		Expect(s.Closed).To(BeTrue())
+		Expect(s.Properties).To(HaveLen(6))
+		Expect(s.Properties["name"]).To(Equal(&Schema{Type: TypeString, Pattern: `^[a-z]+$`}))
+		Expect(s.Properties["replicas"]).To(Equal(&Schema{Type: TypeInteger}))
+		Expect(s.Properties["enabled"]).To(Equal(&Schema{Type: TypeBoolean}))
+		Expect(s.Properties["tags"]).To(Equal(&Schema{Type: TypeArray, Items: &Schema{Type: TypeString}}))
+		Expect(s.Properties["labels"]).To(Equal(
+			&Schema{Type: TypeObject, AdditionalProperties: &Schema{Type: TypeString}}))
+		Expect(s.Properties["extra"]).To(Equal(&Schema{}))
+	})
+
+	It("should marshal the additional properties", func() {
+		s := For(sample{})
+
+		b, err := json.Marshal(s)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(b)).To(ContainSubstring(`"additionalProperties":false`))
+		Expect(string(b)).To(ContainSubstring(`"labels":{"type":"object","additionalProperties":{"type":"string"}}`))
+	})
+})
+
+var _ = Describe("Validate", func() {
+	var s *Schema
+
+	BeforeEach(func() {
+		s = For(sample{})
+	})
+
+	It("should accept a valid document", func() {
+		Expect(s.Validate([]byte(`name: sample
+replicas: 3
+enabled: true
+tags: [a, b]
+labels:
+  app: sample
+extra:
+  anything: [1, 2]
+`))).To(Succeed())
+	})
+
+	It("should accept null values and empty documents", func() {
+		Expect(s.Validate([]byte("name: sample\nenabled:\n"))).To(Succeed())
+		Expect(s.Validate(nil)).To(Succeed())
+	})
+
+	It("should report each error with its line number", func() {
+		err := s.Validate([]byte(`name: Sample
+replicas: three
+tags: a
+labels:
+  app: [sample]
+unknown: true
+`))
+		Expect(err).To(MatchError(ContainSubstring(`line 1: name: invalid value "Sample", expected a value matching`)))
+		Expect(err).To(MatchError(ContainSubstring("line 2: replicas: expected an integer")))
+		Expect(err).To(MatchError(ContainSubstring("line 3: tags: expected an array")))
+		Expect(err).To(MatchError(ContainSubstring("line 5: labels.app: expected a string")))
+		Expect(err).To(MatchError(ContainSubstring("line 6: unknown: unknown field")))
+	})
+
+	It("should validate enums, required properties and alternatives", func() {
+		s = &Schema{
+			Type: TypeObject,
+			Properties: map[string]*Schema{
+				"kind": {Type: TypeString, Enum: []string{"a", "b"}},
+				"layout": {AnyOf: []*Schema{
+					{Type: TypeString},
+					{Type: TypeArray, Items: &Schema{Type: TypeString}},
+				}},
+				"version": {Type: TypeString},
+			},
+			Required: []string{"version"},
+		}
+
+		Expect(s.Validate([]byte("version: v1\nlayout: go\n"))).To(Succeed())
+		Expect(s.Validate([]byte("version: v1\nlayout: [go]\n"))).To(Succeed())
+
+		err := s.Validate([]byte("kind: c\nlayout: {go: true}\n"))
+		Expect(err).To(MatchError(ContainSubstring(`line 1: kind: invalid value "c", expected one of ["a" "b"]`)))
+		Expect(err).To(MatchError(ContainSubstring(`line 2: layout: expected one of the types ["string" "array"]`)))
+		Expect(err).To(MatchError(ContainSubstring("line 1: version: missing field")))
+	})
+
+	It("should quote the keys with dots or slashes", func() {
+		s = &Schema{Type: TypeObject, AdditionalProperties: &Schema{Type: TypeObject, Closed: true}}
+
+		Expect(s.Validate([]byte("sample.kubebuilder.io/v1:\n  typo: true\n"))).To(MatchError(
+			`line 2: ["sample.kubebuilder.io/v1"].typo: unknown field`))
+	})
+
+	It("should fail for invalid YAML", func() {
+		Expect(s.Validate([]byte("name: [sample\n"))).To(MatchError(ContainSubstring("failed to parse YAML")))
+	})
+})
+
+var _ = Describe("Node", func() {
+	It("should return the node at the provided path", func() {
+		var document yaml.Node
+		Expect(yaml.Unmarshal([]byte("version: kind\nkind: v1\nitems:\n- name: a\n- name: b\n"), &document)).To(Succeed())
+
+		node, found := Node(&document, "kind")
+		Expect(found).To(BeTrue())
+		Expect(node.Value).To(Equal("v1"))
+
+		node, found = Node(&document, "items", 1, "name")
+		Expect(found).To(BeTrue())
+		Expect(node.Value).To(Equal("b"))
+		Expect(node.Line).To(Equal(5))
+
+		_, found = Node(&document, "items", 2)
+		Expect(found).To(BeFalse())
+		_, found = Node(&document, "version", "name")
+		Expect(found).To(BeFalse())
+	})
+})
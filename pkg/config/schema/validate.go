@@ -0,0 +1,209 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schema
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// FieldError is an error found in a field of a YAML document
+type FieldError struct {
+	// Line is the line of the field in the document, starting at 1
+	Line int
+	// Field is the path of the field, e.g. "resources[0].webhooks", empty for the document itself
+	Field string
+	// Message describes the error
+	Message string
+}
+
+// Error implements error
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+	}
+	return fmt.Sprintf("line %d: %s: %s", e.Line, e.Field, e.Message)
+}
+
+// Validate checks the YAML document in content against the schema. It returns the FieldErrors found joined,
+// or an error if content is not valid YAML.
+func (s *Schema) Validate(content []byte) error {
+	var document yaml.Node
+	if err := yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+	if len(document.Content) == 0 {
+		return nil
+	}
+
+	var errs []error
+	s.validate(document.Content[0], "", &errs)
+	return errors.Join(errs...)
+}
+
+// Node returns the node of the field at the provided path of the document, and if it was found.
+// Path elements are either the keys of mappings or the indexes of sequences.
+func Node(document *yaml.Node, path ...any) (*yaml.Node, bool) {
+	node := document
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+
+	for _, element := range path {
+		switch element := element.(type) {
+		case string:
+			if node.Kind != yaml.MappingNode {
+				return nil, false
+			}
+			value, found := mappingValue(node, element)
+			if !found {
+				return nil, false
+			}
+			node = value
+		case int:
+			if node.Kind != yaml.SequenceNode || element < 0 || element >= len(node.Content) {
+				return nil, false
+			}
+			node = node.Content[element]
+		default:
+			return nil, false
+		}
+	}
+
+	return node, true
+}
+
+// mappingValue returns the value of key in the mapping node, and if it was found
+func mappingValue(node *yaml.Node, key string) (*yaml.Node, bool) {
+	// Keys and values are interleaved
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1], true
+		}
+	}
+	return nil, false
+}
+
+func (s *Schema) validate(node *yaml.Node, field string, errs *[]error) {
+	if node.Kind == yaml.AliasNode {
+		node = node.Alias
+	}
+	// Null values are decoded as zero values
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!null" {
+		return
+	}
+
+	if len(s.AnyOf) > 0 {
+		s.validateAnyOf(node, field, errs)
+		return
+	}
+
+	fail := func(format string, args ...any) {
+		*errs = append(*errs, FieldError{Line: node.Line, Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	switch s.Type {
+	case TypeObject:
+		if node.Kind != yaml.MappingNode {
+			fail("expected an object")
+			return
+		}
+		s.validateObject(node, field, errs)
+	case TypeArray:
+		if node.Kind != yaml.SequenceNode {
+			fail("expected an array")
+			return
+		}
+		for i, item := range node.Content {
+			s.Items.validate(item, fmt.Sprintf("%s[%d]", field, i), errs)
+		}
+	case TypeString:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+			fail("expected a string")
+		} else if len(s.Enum) > 0 && !slices.Contains(s.Enum, node.Value) {
+			fail("invalid value %q, expected one of %q", node.Value, s.Enum)
+		} else if s.Pattern != "" && !regexp.MustCompile(s.Pattern).MatchString(node.Value) {
+			fail("invalid value %q, expected a value matching %q", node.Value, s.Pattern)
+		}
+	case TypeBoolean:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!bool" {
+			fail("expected a boolean")
+		}
+	case TypeInteger:
+		if node.Kind != yaml.ScalarNode || node.Tag != "!!int" {
+			fail("expected an integer")
+		}
+	case TypeNumber:
+		if node.Kind != yaml.ScalarNode || (node.Tag != "!!int" && node.Tag != "!!float") {
+			fail("expected a number")
+		}
+	}
+}
+
+func (s *Schema) validateObject(node *yaml.Node, field string, errs *[]error) {
+	keys := make([]string, 0, len(node.Content)/2)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		keys = append(keys, key.Value)
+		path := join(field, key.Value)
+
+		if property, isProperty := s.Properties[key.Value]; isProperty {
+			property.validate(value, path, errs)
+		} else if s.Closed {
+			*errs = append(*errs, FieldError{Line: key.Line, Field: path, Message: "unknown field"})
+		} else if s.AdditionalProperties != nil {
+			s.AdditionalProperties.validate(value, path, errs)
+		}
+	}
+
+	for _, required := range s.Required {
+		if !slices.Contains(keys, required) {
+			*errs = append(*errs, FieldError{Line: node.Line, Field: join(field, required), Message: "missing field"})
+		}
+	}
+}
+
+func (s *Schema) validateAnyOf(node *yaml.Node, field string, errs *[]error) {
+	types := make([]string, 0, len(s.AnyOf))
+	for _, alternative := range s.AnyOf {
+		var alternativeErrs []error
+		alternative.validate(node, field, &alternativeErrs)
+		if len(alternativeErrs) == 0 {
+			return
+		}
+		types = append(types, alternative.Type)
+	}
+
+	*errs = append(*errs, FieldError{Line: node.Line, Field: field,
+		Message: fmt.Sprintf("expected one of the types %q", types)})
+}
+
+// join appends key to the path of a field, quoting keys that contain dots or slashes like plugin keys
+func join(field, key string) string {
+	if strings.ContainsAny(key, "./[]") {
+		return fmt.Sprintf("%s[%q]", field, key)
+	}
+	if field == "" {
+		return key
+	}
+	return field + "." + key
+}
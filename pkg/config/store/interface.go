@@ -36,3 +36,9 @@ type Store interface {
 	// Config returns the stored config.Config
 	Config() config.Config
 }
+
+// Migrator is an optional interface for a Store that can convert the stored config.Config to another version
+type Migrator interface {
+	// Migrate replaces the stored config.Config with its conversion to the provided version
+	Migrate(config.Version) error
+}
@@ -47,13 +47,36 @@ type yamlStore struct {
 	fs afero.Fs
 	// mustNotExist requires the file not to exist when saving it
 	mustNotExist bool
+	// strict reports the unknown fields and invalid values of the loaded configuration
+	strict bool
+	// pluginConfigs are zero values of the plugin configuration objects, mapped by plugin key
+	pluginConfigs map[string]any
 
 	cfg config.Config
 }
 
+var _ store.Migrator = &yamlStore{}
+
+// Option configures a store created with New
+type Option func(*yamlStore)
+
+// WithStrict makes the store fail to load configurations with unknown fields or invalid values, reporting
+// their line numbers. The configuration objects of the plugins are validated against the zero values of
+// pluginConfigs, mapped by plugin key, and any object is accepted for other plugins.
+func WithStrict(pluginConfigs map[string]any) Option {
+	return func(s *yamlStore) {
+		s.strict = true
+		s.pluginConfigs = pluginConfigs
+	}
+}
+
 // New creates a new configuration that will be stored at the provided path
-func New(fs machinery.Filesystem) store.Store {
-	return &yamlStore{fs: fs.FS}
+func New(fs machinery.Filesystem, options ...Option) store.Store {
+	s := &yamlStore{fs: fs.FS}
+	for _, option := range options {
+		option(s)
+	}
+	return s
 }
 
 // New implements store.Store interface
@@ -100,11 +123,39 @@ func (s *yamlStore) LoadFrom(path string) error {
 		return store.LoadError{Err: fmt.Errorf("failed to create config for version %q: %w", versioned.Version, err)}
 	}
 
+	if provider, isProvider := cfg.(config.SchemaProvider); s.strict && isProvider {
+		if err = provider.Schema(s.pluginConfigs).Validate(in); err != nil {
+			return store.LoadError{Err: fmt.Errorf("invalid config at %q:\n%w", path, err)}
+		}
+	}
+
 	// Unmarshal the file content
-	if err := cfg.UnmarshalYAML(in); err != nil {
+	if err = cfg.UnmarshalYAML(in); err != nil {
 		return store.LoadError{Err: fmt.Errorf("failed to unmarshal config at %q: %w", path, err)}
 	}
 
+	if s.strict {
+		if err = validateResources(cfg, in); err != nil {
+			return store.LoadError{Err: fmt.Errorf("invalid config at %q:\n%w", path, err)}
+		}
+	}
+
+	s.cfg = cfg
+	return nil
+}
+
+// Migrate implements store.Migrator interface
+func (s *yamlStore) Migrate(version config.Version) error {
+	// If yamlStore is unset, none of New, Load, or LoadFrom were called successfully
+	if s.cfg == nil {
+		return fmt.Errorf("undefined config, use one of the initializers: New, Load, LoadFrom")
+	}
+
+	cfg, err := config.Migrate(s.cfg, version)
+	if err != nil {
+		return fmt.Errorf("failed to migrate config to version %q: %w", version, err)
+	}
+
 	s.cfg = cfg
 	return nil
 }
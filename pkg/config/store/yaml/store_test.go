@@ -29,6 +29,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/config/store"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
@@ -210,6 +211,107 @@ layout: ""
 		})
 	})
 
+	Context("LoadFrom in strict mode", func() {
+		type samplePluginConfig struct {
+			Output string `json:"output,omitempty"`
+		}
+
+		BeforeEach(func() {
+			pluginConfigs := map[string]any{"sample.kubebuilder.io/v1": samplePluginConfig{}}
+			s = New(machinery.Filesystem{FS: afero.NewMemMapFs()}, WithStrict(pluginConfigs)).(*yamlStore)
+		})
+
+		It("should load a valid Config", func() {
+			Expect(afero.WriteFile(s.fs, path, []byte(commentStr+`domain: example.com
+layout:
+- go.kubebuilder.io/v4
+plugins:
+  external.example.com/v1:
+    anything: true
+  sample.kubebuilder.io/v1:
+    output: dist
+resources:
+- group: crew
+  kind: Captain
+  version: v1
+version: "3"
+`), os.ModePerm)).To(Succeed())
+
+			Expect(s.LoadFrom(path)).To(Succeed())
+			Expect(s.Config().GetDomain()).To(Equal("example.com"))
+		})
+
+		It("should report the unknown fields and invalid values with their line numbers", func() {
+			Expect(afero.WriteFile(s.fs, path, []byte(`domain: example.com
+multigroup: "yes"
+plugins:
+  sample.kubebuilder.io/v1:
+    outputDir: dist
+resources:
+- group: crew
+  kind: Captain
+  version: v1
+  webhook:
+    defaulting: true
+version: "3"
+`), os.ModePerm)).To(Succeed())
+
+			err := s.LoadFrom(path)
+			Expect(err).To(MatchError(ContainSubstring("line 2: multigroup: expected a boolean")))
+			Expect(err).To(MatchError(ContainSubstring(
+				`line 5: plugins["sample.kubebuilder.io/v1"].outputDir: unknown field`)))
+			Expect(err).To(MatchError(ContainSubstring("line 10: resources[0].webhook: unknown field")))
+		})
+
+		It("should report the invalid resources with their line numbers", func() {
+			Expect(afero.WriteFile(s.fs, path, []byte(`domain: example.com
+resources:
+- group: crew
+  kind: Captain
+  version: v1
+- group: crew
+  kind: captain
+  version: v1
+version: "3"
+`), os.ModePerm)).To(Succeed())
+
+			err := s.LoadFrom(path)
+			Expect(err).To(MatchError(ContainSubstring("line 6: resources[1]: invalid Kind")))
+			Expect(err).NotTo(MatchError(ContainSubstring("resources[0]")))
+		})
+
+		It("should not report unknown fields when not strict", func() {
+			s = New(machinery.Filesystem{FS: afero.NewMemMapFs()}).(*yamlStore)
+			Expect(afero.WriteFile(s.fs, path, []byte("version: \"3\"\nwebhook: {}\n"), os.ModePerm)).To(Succeed())
+
+			Expect(s.LoadFrom(path)).To(Succeed())
+		})
+	})
+
+	Context("Migrate", func() {
+		It("should replace the config with the migrated one", func() {
+			s.cfg = cfgv3.New()
+			Expect(s.Migrate(cfgv4.Version)).To(Succeed())
+			Expect(s.Config().GetVersion().Compare(cfgv4.Version)).To(Equal(0))
+			Expect(s.Save()).To(Succeed())
+
+			cfgBytes, err := afero.ReadFile(s.fs, DefaultPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(cfgBytes)).To(Equal(commentStr + "version: 4-alpha\n"))
+		})
+
+		It("should fail for an unsupported migration", func() {
+			s.cfg = cfgv4.New()
+			Expect(s.Migrate(cfgv3.Version)).To(MatchError(
+				config.UnsupportedMigrationError{From: cfgv4.Version, To: cfgv3.Version}))
+			Expect(s.Config().GetVersion().Compare(cfgv4.Version)).To(Equal(0))
+		})
+
+		It("should fail for an empty config", func() {
+			Expect(s.Migrate(cfgv4.Version)).NotTo(Succeed())
+		})
+	})
+
 	Context("Save", func() {
 		It("should succeed for a valid config", func() {
 			s.cfg = cfgv3.New()
@@ -0,0 +1,53 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package yaml
+
+import (
+	"errors"
+	"fmt"
+
+	"go.yaml.in/yaml/v3"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/schema"
+)
+
+// validateResources checks the values of the resources of the configuration, reporting the line of each resource
+func validateResources(cfg config.Config, content []byte) error {
+	resources, err := cfg.GetResources()
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	var document yaml.Node
+	if err = yaml.Unmarshal(content, &document); err != nil {
+		return fmt.Errorf("failed to parse YAML: %w", err)
+	}
+
+	var errs []error
+	for i, res := range resources {
+		if err = res.Validate(); err != nil {
+			fieldErr := schema.FieldError{Field: fmt.Sprintf("resources[%d]", i), Message: err.Error()}
+			if node, found := schema.Node(&document, "resources", i); found {
+				fieldErr.Line = node.Line
+			}
+			errs = append(errs, fieldErr)
+		}
+	}
+
+	return errors.Join(errs...)
+}
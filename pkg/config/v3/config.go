@@ -18,11 +18,13 @@ package v3
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/schema"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
@@ -52,6 +54,14 @@ func (ss *stringSlice) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// JSONSchema implements schema.Provider
+func (stringSlice) JSONSchema() *schema.Schema {
+	return &schema.Schema{AnyOf: []*schema.Schema{
+		{Type: schema.TypeString},
+		{Type: schema.TypeArray, Items: &schema.Schema{Type: schema.TypeString}},
+	}}
+}
+
 // Cfg defines the Project Config (PROJECT file)
 type Cfg struct {
 	// Version
@@ -68,6 +78,9 @@ type Cfg struct {
 	MultiGroup bool `json:"multigroup,omitempty"`
 	Namespaced bool `json:"namespaced,omitempty"`
 
+	// List fields
+	WatchNamespaces []string `json:"watchNamespaces,omitempty"`
+
 	// Resources
 	Resources []resource.Resource `json:"resources,omitempty"`
 
@@ -81,6 +94,8 @@ type pluginConfigs map[string]pluginConfig
 // pluginConfig is an arbitrary plugin configuration object.
 type pluginConfig any
 
+var _ config.SchemaProvider = Cfg{}
+
 // New returns a new config.Config
 func New() config.Config {
 	return &Cfg{Version: Version}
@@ -184,6 +199,21 @@ func (c *Cfg) ClearNamespaced() error {
 	return nil
 }
 
+// GetWatchNamespaces implements config.Config
+func (c Cfg) GetWatchNamespaces() []string {
+	return c.WatchNamespaces
+}
+
+// SetWatchNamespaces implements config.Config
+func (c *Cfg) SetWatchNamespaces(namespaces []string) error {
+	if len(namespaces) == 0 {
+		c.WatchNamespaces = nil
+		return nil
+	}
+	c.WatchNamespaces = slices.Clone(namespaces)
+	return nil
+}
+
 // ResourcesLength implements config.Config
 func (c Cfg) ResourcesLength() int {
 	return len(c.Resources)
@@ -277,6 +307,38 @@ func (c *Cfg) UpdateResource(res resource.Resource) error {
 	return nil
 }
 
+// SetResource implements config.Config
+func (c *Cfg) SetResource(res resource.Resource) error {
+	// As res is passed by value it is already a shallow copy, but we need to make a deep copy
+	res = res.Copy()
+
+	// Plural is only stored if irregular
+	if res.Plural == resource.RegularPlural(res.Kind) {
+		res.Plural = ""
+	}
+
+	for i, r := range c.Resources {
+		if res.IsEqualTo(r.GVK) {
+			c.Resources[i] = res
+			return nil
+		}
+	}
+
+	return config.ResourceNotFoundError{GVK: res.GVK}
+}
+
+// RemoveResource implements config.Config
+func (c *Cfg) RemoveResource(gvk resource.GVK) error {
+	for i, r := range c.Resources {
+		if gvk.IsEqualTo(r.GVK) {
+			c.Resources = append(c.Resources[:i], c.Resources[i+1:]...)
+			return nil
+		}
+	}
+
+	return config.ResourceNotFoundError{GVK: gvk}
+}
+
 // HasGroup implements config.Config
 func (c Cfg) HasGroup(group string) bool {
 	// Return true if the target group is found in the tracked resources
@@ -386,6 +448,29 @@ func (c Cfg) MarshalYAML() ([]byte, error) {
 	return content, nil
 }
 
+// Schema implements config.SchemaProvider
+func (Cfg) Schema(pluginConfigs map[string]any) *schema.Schema {
+	s := schema.For(Cfg{})
+	s.Schema = schema.Draft
+	s.Title = "Kubebuilder project configuration"
+	s.Description = "The PROJECT file tracks the information used to scaffold a Kubebuilder project."
+	s.Required = []string{"version"}
+	s.Properties["version"] = &schema.Schema{Type: schema.TypeString, Enum: []string{Version.String()}}
+
+	// Plugins not listed, like external plugins, can store any object
+	plugins := &schema.Schema{
+		Type:                 schema.TypeObject,
+		Properties:           make(map[string]*schema.Schema, len(pluginConfigs)),
+		AdditionalProperties: &schema.Schema{Type: schema.TypeObject},
+	}
+	for key, pluginCfg := range pluginConfigs {
+		plugins.Properties[key] = schema.For(pluginCfg)
+	}
+	s.Properties["plugins"] = plugins
+
+	return s
+}
+
 // UnmarshalYAML implements config.Config
 func (c *Cfg) UnmarshalYAML(b []byte) error {
 	// Use non-strict unmarshaling to allow forward compatibility and external plugin fields.
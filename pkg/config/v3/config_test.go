@@ -17,6 +17,7 @@ limitations under the License.
 package v3
 
 import (
+	"errors"
 	"slices"
 	"testing"
 
@@ -134,6 +135,23 @@ var _ = Describe("Cfg", func() {
 		})
 	})
 
+	Context("Watch namespaces", func() {
+		It("GetWatchNamespaces should return nil if not set", func() {
+			Expect(c.GetWatchNamespaces()).To(BeNil())
+		})
+
+		It("SetWatchNamespaces should set the watched namespaces", func() {
+			Expect(c.SetWatchNamespaces([]string{"tenant-a", "tenant-b"})).To(Succeed())
+			Expect(c.WatchNamespaces).To(Equal([]string{"tenant-a", "tenant-b"}))
+		})
+
+		It("SetWatchNamespaces should unset the watched namespaces if empty", func() {
+			c.WatchNamespaces = []string{"tenant-a"}
+			Expect(c.SetWatchNamespaces(nil)).To(Succeed())
+			Expect(c.WatchNamespaces).To(BeNil())
+		})
+	})
+
 	Context("Resources", func() {
 		var (
 			res              resource.Resource
@@ -276,6 +294,43 @@ var _ = Describe("Cfg", func() {
 			checkResource(c.Resources[0], resWithoutPlural)
 		})
 
+		It("SetResource should fail for a non-existent resource", func() {
+			err := c.SetResource(res)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, &config.ResourceNotFoundError{})).To(BeTrue())
+		})
+
+		It("SetResource should overwrite an existent resource", func() {
+			r := resWithoutPlural.Copy()
+			r.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true, Validation: true}
+			c.Resources = append(c.Resources, r)
+
+			updated := res.Copy()
+			updated.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Validation: true}
+			Expect(c.SetResource(updated)).To(Succeed())
+			Expect(c.Resources).To(HaveLen(1))
+			Expect(c.Resources[0].Plural).To(BeEmpty())
+			Expect(c.Resources[0].Webhooks.Defaulting).To(BeFalse())
+			Expect(c.Resources[0].Webhooks.Validation).To(BeTrue())
+		})
+
+		It("RemoveResource should fail for a non-existent resource", func() {
+			err := c.RemoveResource(res.GVK)
+			Expect(err).To(HaveOccurred())
+			Expect(errors.As(err, &config.ResourceNotFoundError{})).To(BeTrue())
+		})
+
+		It("RemoveResource should remove an existent resource", func() {
+			other := resWithoutPlural.Copy()
+			other.Kind = "OtherKind"
+			c.Resources = append(c.Resources, resWithoutPlural, other)
+
+			Expect(c.RemoveResource(res.GVK)).To(Succeed())
+			Expect(c.Resources).To(HaveLen(1))
+			Expect(c.HasResource(res.GVK)).To(BeFalse())
+			Expect(c.HasResource(other.GVK)).To(BeTrue())
+		})
+
 		It("HasGroup should return false with no tracked resources", func() {
 			Expect(c.HasGroup(res.Group)).To(BeFalse())
 		})
@@ -694,6 +749,38 @@ resources:
 	})
 })
 
+var _ = Describe("Schema", func() {
+	type samplePluginConfig struct {
+		Output string `json:"output,omitempty"`
+	}
+
+	It("should describe the project configuration and the plugin configurations", func() {
+		s := Cfg{}.Schema(map[string]any{"sample.kubebuilder.io/v1": samplePluginConfig{}})
+
+		Expect(s.Required).To(Equal([]string{"version"}))
+		Expect(s.Properties["version"].Enum).To(Equal([]string{"3"}))
+		Expect(s.Properties["resources"].Items.Properties).To(HaveKey("webhooks"))
+		Expect(s.Properties["plugins"].Properties).To(HaveKey("sample.kubebuilder.io/v1"))
+		Expect(s.Properties["plugins"].Properties["sample.kubebuilder.io/v1"].Properties).To(HaveKey("output"))
+	})
+
+	It("should validate a marshaled configuration", func() {
+		c := Cfg{Version: Version, Domain: "example.com", PluginChain: stringSlice{"go.kubebuilder.io/v4"}}
+		Expect(c.AddResource(resource.Resource{
+			GVK:      resource.GVK{Group: "crew", Domain: "example.com", Version: "v1", Kind: "Captain"},
+			Plural:   "captains",
+			API:      &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{WebhookVersion: "v1", Defaulting: true},
+		})).To(Succeed())
+		Expect(c.EncodePluginConfig("sample.kubebuilder.io/v1", samplePluginConfig{Output: "dist"})).To(Succeed())
+
+		content, err := c.MarshalYAML()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Schema(map[string]any{"sample.kubebuilder.io/v1": samplePluginConfig{}}).Validate(content)).
+			To(Succeed())
+	})
+})
+
 var _ = Describe("New", func() {
 	It("should return a new config for project configuration 3", func() {
 		Expect(New().GetVersion().Compare(Version)).To(Equal(0))
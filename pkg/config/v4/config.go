@@ -0,0 +1,183 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"fmt"
+	"slices"
+
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/schema"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
+)
+
+// Version is the config.Version for project configuration 4-alpha
+var Version = config.Version{Number: 4, Stage: stage.Alpha}
+
+// Cfg defines the Project Config (PROJECT file) of version 4-alpha, which extends the one of version 3
+// with the subcommands that scaffolded each resource
+type Cfg struct {
+	cfgv3.Cfg
+
+	// scaffolds holds the subcommands that scaffolded each resource, mapped by GVK
+	scaffolds map[resource.GVK][]config.ScaffoldRecord
+}
+
+// Resource is the representation of a resource in the PROJECT file
+type Resource struct {
+	resource.Resource
+
+	// Scaffold are the subcommands that scaffolded the resource, in the order they were run
+	Scaffold []config.ScaffoldRecord `json:"scaffold,omitempty"`
+}
+
+// document is the representation of Cfg in the PROJECT file
+type document struct {
+	cfgv3.Cfg
+
+	Resources []Resource `json:"resources,omitempty"`
+}
+
+var (
+	_ config.SchemaProvider    = Cfg{}
+	_ config.ProvenanceTracker = &Cfg{}
+)
+
+// New returns a new config.Config
+func New() config.Config {
+	return &Cfg{Cfg: cfgv3.Cfg{Version: Version}}
+}
+
+func init() {
+	config.Register(Version, New)
+	config.RegisterMigration(cfgv3.Version, Version, MigrateFromV3)
+}
+
+// MigrateFromV3 converts a project configuration of version 3 into version 4-alpha. The subcommands that
+// scaffolded the existing resources are unknown, so they are only recorded from then on.
+func MigrateFromV3(cfg config.Config) (config.Config, error) {
+	if cfg.GetVersion().Compare(cfgv3.Version) != 0 {
+		return nil, config.UnsupportedMigrationError{From: cfg.GetVersion(), To: Version}
+	}
+
+	// The content is the same, so we just need to replace the version
+	content, err := cfg.MarshalYAML()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal project configuration: %w", err)
+	}
+
+	migrated := &Cfg{}
+	if err = migrated.UnmarshalYAML(content); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal project configuration: %w", err)
+	}
+	migrated.Version = Version
+
+	return migrated, nil
+}
+
+// RemoveResource implements config.Config
+func (c *Cfg) RemoveResource(gvk resource.GVK) error {
+	if err := c.Cfg.RemoveResource(gvk); err != nil {
+		return fmt.Errorf("failed to remove resource: %w", err)
+	}
+
+	delete(c.scaffolds, gvk)
+	return nil
+}
+
+// RecordScaffold implements config.ProvenanceTracker
+func (c *Cfg) RecordScaffold(gvk resource.GVK, record config.ScaffoldRecord) error {
+	if !c.HasResource(gvk) {
+		return config.ResourceNotFoundError{GVK: gvk}
+	}
+
+	if c.scaffolds == nil {
+		c.scaffolds = make(map[resource.GVK][]config.ScaffoldRecord)
+	}
+	record.Plugins = slices.Clone(record.Plugins)
+	record.Flags = slices.Clone(record.Flags)
+	c.scaffolds[gvk] = append(c.scaffolds[gvk], record)
+	return nil
+}
+
+// GetScaffoldRecords implements config.ProvenanceTracker
+func (c Cfg) GetScaffoldRecords(gvk resource.GVK) ([]config.ScaffoldRecord, error) {
+	if !c.HasResource(gvk) {
+		return nil, config.ResourceNotFoundError{GVK: gvk}
+	}
+
+	return slices.Clone(c.scaffolds[gvk]), nil
+}
+
+// MarshalYAML implements config.Config
+func (c Cfg) MarshalYAML() ([]byte, error) {
+	doc := document{Cfg: c.Cfg, Resources: make([]Resource, 0, len(c.Cfg.Resources))}
+	for _, r := range c.Cfg.Resources {
+		// If API is empty, omit it (prevents `api: {}`).
+		if r.API != nil && r.API.IsEmpty() {
+			r.API = nil
+		}
+		// If Webhooks is empty, omit it (prevents `webhooks: {}`).
+		if r.Webhooks != nil && r.Webhooks.IsEmpty() {
+			r.Webhooks = nil
+		}
+		doc.Resources = append(doc.Resources, Resource{Resource: r, Scaffold: c.scaffolds[r.GVK]})
+	}
+
+	content, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, config.MarshalError{Err: err}
+	}
+
+	return content, nil
+}
+
+// UnmarshalYAML implements config.Config
+func (c *Cfg) UnmarshalYAML(b []byte) error {
+	// Unknown fields are ignored for forward compatibility, as done for version 3.
+	var doc document
+	if err := yaml.Unmarshal(b, &doc); err != nil {
+		return config.UnmarshalError{Err: err}
+	}
+
+	c.Cfg = doc.Cfg
+	c.Cfg.Resources = make([]resource.Resource, 0, len(doc.Resources))
+	c.scaffolds = make(map[resource.GVK][]config.ScaffoldRecord)
+	for _, r := range doc.Resources {
+		// Normalize resources to auto-migrate legacy controller: true format
+		r.Normalize()
+		c.Cfg.Resources = append(c.Cfg.Resources, r.Resource)
+		if len(r.Scaffold) != 0 {
+			c.scaffolds[r.GVK] = r.Scaffold
+		}
+	}
+
+	return nil
+}
+
+// Schema implements config.SchemaProvider
+func (c Cfg) Schema(pluginConfigs map[string]any) *schema.Schema {
+	s := c.Cfg.Schema(pluginConfigs)
+	s.Properties["version"].Enum = []string{Version.String()}
+	s.Properties["resources"] = schema.For(document{}).Properties["resources"]
+
+	return s
+}
@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+func TestConfigV4(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Config V4 Suite")
+}
+
+var _ = Describe("Cfg", func() {
+	var (
+		c   *Cfg
+		res resource.Resource
+
+		createAPI     config.ScaffoldRecord
+		createWebhook config.ScaffoldRecord
+	)
+
+	BeforeEach(func() {
+		c = New().(*Cfg)
+		Expect(c.SetDomain("example.com")).To(Succeed())
+		Expect(c.SetPluginChain([]string{"go.kubebuilder.io/v4"})).To(Succeed())
+
+		res = resource.Resource{
+			GVK:      resource.GVK{Group: "crew", Domain: "example.com", Version: "v1", Kind: "Captain"},
+			Plural:   "captains",
+			Path:     "example.com/project/api/v1",
+			API:      &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{WebhookVersion: "v1", Defaulting: true, DefaultingPath: "/default"},
+		}
+
+		createAPI = config.ScaffoldRecord{
+			Command: "create api",
+			Plugins: []string{"go.kubebuilder.io/v4"},
+			Flags:   []string{"--controller-name=captain-backup", "--controller=true", "--resource=true"},
+		}
+		createWebhook = config.ScaffoldRecord{
+			Command: "create webhook",
+			Plugins: []string{"go.kubebuilder.io/v4"},
+			Flags:   []string{"--defaulting-path=/default", "--defaulting=true"},
+		}
+	})
+
+	It("should have version 4-alpha", func() {
+		Expect(c.GetVersion().Compare(Version)).To(Equal(0))
+		Expect(c.GetVersion().String()).To(Equal("4-alpha"))
+	})
+
+	Context("Provenance", func() {
+		It("RecordScaffold should fail for a non-existent resource", func() {
+			err := c.RecordScaffold(res.GVK, createAPI)
+			Expect(err).To(MatchError(config.ResourceNotFoundError{GVK: res.GVK}))
+		})
+
+		It("GetScaffoldRecords should fail for a non-existent resource", func() {
+			_, err := c.GetScaffoldRecords(res.GVK)
+			Expect(err).To(MatchError(config.ResourceNotFoundError{GVK: res.GVK}))
+		})
+
+		It("should return the records of a resource in the order they were recorded", func() {
+			Expect(c.AddResource(res)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createAPI)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createWebhook)).To(Succeed())
+
+			records, err := c.GetScaffoldRecords(res.GVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]config.ScaffoldRecord{createAPI, createWebhook}))
+		})
+
+		It("RemoveResource should remove the records of the resource", func() {
+			Expect(c.AddResource(res)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createAPI)).To(Succeed())
+			Expect(c.RemoveResource(res.GVK)).To(Succeed())
+			Expect(c.AddResource(res)).To(Succeed())
+
+			records, err := c.GetScaffoldRecords(res.GVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(BeEmpty())
+		})
+	})
+
+	Context("Persistence", func() {
+		It("should store the records under each resource", func() {
+			Expect(c.AddResource(res)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createAPI)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createWebhook)).To(Succeed())
+
+			content, err := c.MarshalYAML()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(Equal(`domain: example.com
+layout:
+- go.kubebuilder.io/v4
+resources:
+- api:
+    crdVersion: v1
+    namespaced: true
+  domain: example.com
+  group: crew
+  kind: Captain
+  path: example.com/project/api/v1
+  scaffold:
+  - command: create api
+    flags:
+    - --controller-name=captain-backup
+    - --controller=true
+    - --resource=true
+    plugins:
+    - go.kubebuilder.io/v4
+  - command: create webhook
+    flags:
+    - --defaulting-path=/default
+    - --defaulting=true
+    plugins:
+    - go.kubebuilder.io/v4
+  version: v1
+  webhooks:
+    defaulting: true
+    defaultingPath: /default
+    webhookVersion: v1
+version: 4-alpha
+`))
+
+			unmarshalled := &Cfg{}
+			Expect(unmarshalled.UnmarshalYAML(content)).To(Succeed())
+			Expect(unmarshalled.GetVersion().Compare(Version)).To(Equal(0))
+			Expect(unmarshalled.GetResource(res.GVK)).To(Equal(res))
+			Expect(unmarshalled.GetScaffoldRecords(res.GVK)).To(Equal([]config.ScaffoldRecord{createAPI, createWebhook}))
+		})
+
+		It("should fail to unmarshal invalid content", func() {
+			Expect((&Cfg{}).UnmarshalYAML([]byte("resources: foo"))).NotTo(Succeed())
+		})
+	})
+
+	Context("Schema", func() {
+		It("should validate a marshaled configuration", func() {
+			Expect(c.AddResource(res)).To(Succeed())
+			Expect(c.RecordScaffold(res.GVK, createAPI)).To(Succeed())
+
+			content, err := c.MarshalYAML()
+			Expect(err).NotTo(HaveOccurred())
+			s := c.Schema(nil)
+			Expect(s.Properties["version"].Enum).To(Equal([]string{"4-alpha"}))
+			Expect(s.Validate(content)).To(Succeed())
+		})
+
+		It("should report unknown fields of the records", func() {
+			content := []byte(`version: 4-alpha
+resources:
+- group: crew
+  version: v1
+  kind: Captain
+  scaffold:
+  - command: create api
+    flag: --resource=true
+`)
+			Expect(c.Schema(nil).Validate(content)).To(MatchError(ContainSubstring("flag")))
+		})
+	})
+})
+
+var _ = Describe("MigrateFromV3", func() {
+	It("should keep the content of the configuration", func() {
+		v3 := cfgv3.New()
+		Expect(v3.SetDomain("example.com")).To(Succeed())
+		Expect(v3.SetProjectName("project")).To(Succeed())
+		Expect(v3.SetMultiGroup()).To(Succeed())
+		Expect(v3.AddResource(resource.Resource{
+			GVK:         resource.GVK{Group: "crew", Domain: "example.com", Version: "v1", Kind: "Captain"},
+			Plural:      "captains",
+			API:         &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controllers: &resource.Controllers{{Name: "captain"}},
+		})).To(Succeed())
+		Expect(v3.EncodePluginConfig("sample.kubebuilder.io/v1", map[string]string{"output": "dist"})).To(Succeed())
+
+		migrated, err := config.Migrate(v3, Version)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migrated.GetVersion().Compare(Version)).To(Equal(0))
+		Expect(migrated.GetDomain()).To(Equal("example.com"))
+		Expect(migrated.GetProjectName()).To(Equal("project"))
+		Expect(migrated.IsMultiGroup()).To(BeTrue())
+		resources, err := v3.GetResources()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(migrated.GetResources()).To(Equal(resources))
+
+		var pluginCfg map[string]string
+		Expect(migrated.DecodePluginConfig("sample.kubebuilder.io/v1", &pluginCfg)).To(Succeed())
+		Expect(pluginCfg).To(HaveKeyWithValue("output", "dist"))
+
+		records, err := migrated.(config.ProvenanceTracker).GetScaffoldRecords(
+			resource.GVK{Group: "crew", Domain: "example.com", Version: "v1", Kind: "Captain"})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(records).To(BeEmpty())
+	})
+
+	It("should fail for other versions", func() {
+		_, err := MigrateFromV3(New())
+		Expect(err).To(MatchError(config.UnsupportedMigrationError{From: Version, To: Version}))
+	})
+})
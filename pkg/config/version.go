@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 
+	"sigs.k8s.io/kubebuilder/v4/pkg/config/schema"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 )
 
@@ -129,3 +130,8 @@ func (v *Version) UnmarshalJSON(b []byte) error {
 
 	return v.Parse(str)
 }
+
+// JSONSchema implements schema.Provider
+func (Version) JSONSchema() *schema.Schema {
+	return &schema.Schema{Type: schema.TypeString, Pattern: `^[1-9][0-9]*(-(alpha|beta))?$`}
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"fmt"
+	"strings"
+)
+
+// diffContext is the number of unchanged lines shown around each change of a unified diff
+const diffContext = 3
+
+// diffOp is an operation of the edit script that transforms a file into another
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+// UnifiedDiff returns the changes of the file in the unified diff format, using a/ and b/ prefixes
+// for the old and new paths as git does, and /dev/null for files that are created or removed.
+func (c FileChange) UnifiedDiff() string {
+	oldPath, newPath := "a/"+c.Path, "b/"+c.Path
+	if c.Created {
+		oldPath = "/dev/null"
+	}
+	if c.Removed {
+		newPath = "/dev/null"
+	}
+
+	ops := diffLines(splitLines(string(c.Before)), splitLines(string(c.After)))
+
+	out := &strings.Builder{}
+	_, _ = fmt.Fprintf(out, "--- %s\n+++ %s\n", oldPath, newPath)
+	for _, hunk := range hunks(ops) {
+		writeHunk(out, ops, hunk[0], hunk[1])
+	}
+
+	return out.String()
+}
+
+// splitLines splits text into lines keeping their line endings, so that a missing final newline is a change
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes the edit script that transforms a into b from their longest common subsequence
+func diffLines(a, b []string) []diffOp {
+	// Common prefix and suffix are left out of the quadratic comparison
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of midA[i:] and midB[j:]
+	lcs := make([][]int, len(midA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(midB)+1)
+	}
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, diffOp{kind: ' ', line: line})
+	}
+	i, j := 0, 0
+	for i < len(midA) && j < len(midB) {
+		switch {
+		case midA[i] == midB[j]:
+			ops = append(ops, diffOp{kind: ' ', line: midA[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{kind: '-', line: midA[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{kind: '+', line: midB[j]})
+			j++
+		}
+	}
+	for ; i < len(midA); i++ {
+		ops = append(ops, diffOp{kind: '-', line: midA[i]})
+	}
+	for ; j < len(midB); j++ {
+		ops = append(ops, diffOp{kind: '+', line: midB[j]})
+	}
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{kind: ' ', line: line})
+	}
+
+	return ops
+}
+
+// hunks returns the [start, end) ranges of ops that contain changes and their surrounding context
+func hunks(ops []diffOp) [][2]int {
+	var ranges [][2]int
+	for i, op := range ops {
+		if op.kind == ' ' {
+			continue
+		}
+
+		start, end := max(i-diffContext, 0), min(i+1+diffContext, len(ops))
+		if len(ranges) > 0 && start <= ranges[len(ranges)-1][1] {
+			ranges[len(ranges)-1][1] = end
+			continue
+		}
+		ranges = append(ranges, [2]int{start, end})
+	}
+
+	return ranges
+}
+
+// writeHunk writes the ops in the [start, end) range with their header
+func writeHunk(out *strings.Builder, ops []diffOp, start, end int) {
+	// Count the lines of each file before and inside the hunk
+	oldStart, newStart := 0, 0
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			oldStart++
+		}
+		if op.kind != '-' {
+			newStart++
+		}
+	}
+	oldLen, newLen := 0, 0
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			oldLen++
+		}
+		if op.kind != '-' {
+			newLen++
+		}
+	}
+
+	_, _ = fmt.Fprintf(out, "@@ -%s +%s @@\n", hunkRange(oldStart, oldLen), hunkRange(newStart, newLen))
+	for _, op := range ops[start:end] {
+		out.WriteByte(op.kind)
+		out.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			out.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+// hunkRange formats the range of lines of a hunk header, where empty ranges start at the previous line
+func hunkRange(start, length int) string {
+	switch length {
+	case 0:
+		return fmt.Sprintf("%d,0", start)
+	case 1:
+		return fmt.Sprintf("%d", start+1)
+	default:
+		return fmt.Sprintf("%d,%d", start+1, length)
+	}
+}
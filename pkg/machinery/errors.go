@@ -113,6 +113,16 @@ func (e CloseFileError) Unwrap() error {
 	return e.error
 }
 
+// RemoveFileError is a wrapper error that will be used for errors when removing a file
+type RemoveFileError struct {
+	error
+}
+
+// Unwrap implements Wrapper interface
+func (e RemoveFileError) Unwrap() error {
+	return e.error
+}
+
 // ModelAlreadyExistsError is returned if the file is expected not to exist but a previous model does
 type ModelAlreadyExistsError struct {
 	path string
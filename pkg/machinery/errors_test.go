@@ -50,6 +50,7 @@ var _ = Describe("Errors", func() {
 		Entry("for file reading errors", func() error { return ReadFileError{testErr} }),
 		Entry("for file writing errors", func() error { return WriteFileError{testErr} }),
 		Entry("for file closing errors", func() error { return CloseFileError{testErr} }),
+		Entry("for file removing errors", func() error { return RemoveFileError{testErr} }),
 	)
 
 	// NOTE: the following test increases coverage
@@ -28,6 +28,10 @@ const (
 
 	// OverwriteFile truncates and overwrites the existing file
 	OverwriteFile
+
+	// OverwriteFileIfUnmodified overwrites the existing file only if the scaffold manifest
+	// tracks it as unmodified since it was scaffolded, and skips it otherwise
+	OverwriteFileIfUnmodified
 )
 
 // IfNotExistsAction determines what to do if a file to be updated does not exist
@@ -54,4 +58,7 @@ type File struct {
 
 	// IfNotExistsAction determines what to do if the file is missing (optional updates only)
 	IfNotExistsAction IfNotExistsAction
+
+	// templateName is the name of the template that scaffolded the file, if any
+	templateName string
 }
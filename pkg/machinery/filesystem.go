@@ -23,4 +23,13 @@ import (
 // Filesystem abstracts the underlying disk for scaffolding
 type Filesystem struct {
 	FS afero.Fs
+
+	// DryRun indicates that FS only records the changes to preview them, so plugins
+	// should not run commands or modify the disk by other means while scaffolding.
+	DryRun bool
+
+	// Manifest, if set, records the files scaffolded through this filesystem.
+	Manifest *Manifest
+	// PluginKey is the key of the plugin that scaffolds through this filesystem, recorded in Manifest.
+	PluginKey string
 }
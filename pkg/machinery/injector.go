@@ -52,6 +52,9 @@ func (i injector) injectInto(builder Builder) {
 		if builderWithNamespaced, hasNamespaced := builder.(HasNamespaced); hasNamespaced {
 			builderWithNamespaced.InjectNamespaced(i.config.IsNamespaced())
 		}
+		if builderWithWatchNamespaces, hasWatchNamespaces := builder.(HasWatchNamespaces); hasWatchNamespaces {
+			builderWithWatchNamespaces.InjectWatchNamespaces(i.config.GetWatchNamespaces())
+		}
 	}
 	// Inject boilerplate
 	if builderWithBoilerplate, hasBoilerplate := builder.(HasBoilerplate); hasBoilerplate {
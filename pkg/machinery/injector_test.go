@@ -74,6 +74,15 @@ func (t *templateWithMultiGroup) InjectMultiGroup(multiGroup bool) {
 	t.multiGroup = multiGroup
 }
 
+type templateWithWatchNamespaces struct {
+	templateBase
+	watchNamespaces []string
+}
+
+func (t *templateWithWatchNamespaces) InjectWatchNamespaces(namespaces []string) {
+	t.watchNamespaces = namespaces
+}
+
 type templateWithBoilerplate struct {
 	templateBase
 	boilerplate string
@@ -212,6 +221,26 @@ var _ = Describe("injector", func() {
 					Expect(template.multiGroup).To(BeTrue())
 				})
 			})
+
+			Context("Watch namespaces", func() {
+				var template *templateWithWatchNamespaces
+
+				BeforeEach(func() {
+					template = &templateWithWatchNamespaces{templateBase: tmp}
+				})
+
+				It("should not inject anything if the config doesn't have watched namespaces", func() {
+					injector{config: c}.injectInto(template)
+					Expect(template.watchNamespaces).To(BeEmpty())
+				})
+
+				It("should inject the watched namespaces of the config", func() {
+					Expect(c.SetWatchNamespaces([]string{"tenant-a", "tenant-b"})).To(Succeed())
+
+					injector{config: c}.injectInto(template)
+					Expect(template.watchNamespaces).To(Equal([]string{"tenant-a", "tenant-b"}))
+				})
+			})
 		})
 
 		Context("Boilerplate", func() {
@@ -94,6 +94,12 @@ type HasNamespaced interface {
 	InjectNamespaced(bool)
 }
 
+// HasWatchNamespaces allows the namespaces watched by the manager to be used on a template
+type HasWatchNamespaces interface {
+	// InjectWatchNamespaces sets the template watched namespaces
+	InjectWatchNamespaces([]string)
+}
+
 // HasBoilerplate allows a boilerplate to be used on a template
 type HasBoilerplate interface {
 	// InjectBoilerplate sets the template boilerplate
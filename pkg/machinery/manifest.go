@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// DefaultManifestPath is the default path of the scaffold manifest
+	DefaultManifestPath = ".kubebuilder/manifest.yaml"
+
+	// Comment for the scaffold manifest file
+	manifestCommentStr = `# Code generated by tool. DO NOT EDIT.
+# This file tracks the files scaffolded by the plugins and the checksum of their
+# content when they were last written, to tell them apart from the user-modified ones.
+`
+)
+
+// ManifestEntry records a file scaffolded by a plugin
+type ManifestEntry struct {
+	// Path is the path of the scaffolded file
+	Path string `json:"path"`
+	// Plugin is the key of the plugin that scaffolded the file
+	Plugin string `json:"plugin,omitempty"`
+	// Template is the name of the template that scaffolded the file, i.e. its type without the package path
+	Template string `json:"template,omitempty"`
+	// SHA256 is the checksum of the content of the file when it was last written
+	SHA256 string `json:"sha256"`
+}
+
+// Manifest tracks the scaffolded files of a project
+type Manifest struct {
+	// Files are the scaffolded files sorted by path
+	Files []ManifestEntry `json:"files,omitempty"`
+
+	// recorded holds the paths of the files recorded since the manifest was loaded
+	recorded map[string]struct{}
+}
+
+// LoadManifest reads the manifest at path, returning an empty manifest if it does not exist
+func LoadManifest(fs afero.Fs, path string) (*Manifest, error) {
+	m := &Manifest{recorded: make(map[string]struct{})}
+
+	in, err := afero.ReadFile(fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return m, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to read %q file: %w", path, err)
+	}
+
+	if err = yaml.Unmarshal(in, m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal manifest at %q: %w", path, err)
+	}
+
+	return m, nil
+}
+
+// Save writes the manifest to path
+func (m *Manifest) Save(fs afero.Fs, path string) error {
+	content, err := yaml.Marshal(m)
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	if err = fs.MkdirAll(filepath.Dir(path), DefaultDirectoryPermission); err != nil {
+		return fmt.Errorf("failed to create directory for %q: %w", path, err)
+	}
+	if err = afero.WriteFile(fs, path, append([]byte(manifestCommentStr), content...),
+		DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write %q file: %w", path, err)
+	}
+
+	return nil
+}
+
+// Get returns the entry of the file at path, if it is tracked
+func (m *Manifest) Get(path string) (ManifestEntry, bool) {
+	path = filepath.ToSlash(filepath.Clean(path))
+	for _, entry := range m.Files {
+		if entry.Path == path {
+			return entry, true
+		}
+	}
+
+	return ManifestEntry{}, false
+}
+
+// Record tracks the file at path as scaffolded by the plugin with the provided template and content
+func (m *Manifest) Record(path, pluginKey, templateName string, content []byte) {
+	path = filepath.ToSlash(filepath.Clean(path))
+	if m.recorded == nil {
+		m.recorded = make(map[string]struct{})
+	}
+	m.recorded[path] = struct{}{}
+
+	entry := ManifestEntry{Path: path, Plugin: pluginKey, Template: templateName, SHA256: checksum(content)}
+	i, found := slices.BinarySearchFunc(m.Files, path, func(e ManifestEntry, path string) int {
+		return strings.Compare(e.Path, path)
+	})
+	if found {
+		m.Files[i] = entry
+		return
+	}
+	m.Files = slices.Insert(m.Files, i, entry)
+}
+
+// Remove stops tracking the file at path
+func (m *Manifest) Remove(path string) {
+	path = filepath.ToSlash(filepath.Clean(path))
+	delete(m.recorded, path)
+	for i, entry := range m.Files {
+		if entry.Path == path {
+			m.Files = append(m.Files[:i], m.Files[i+1:]...)
+			return
+		}
+	}
+}
+
+// IsUnmodified checks if the file at path is tracked and its content did not change since it was scaffolded
+func (m *Manifest) IsUnmodified(fs afero.Fs, path string) (bool, error) {
+	entry, tracked := m.Get(path)
+	if !tracked {
+		return false, nil
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read %q file: %w", path, err)
+	}
+
+	return checksum(content) == entry.SHA256, nil
+}
+
+// UnmodifiedFiles returns the paths of the tracked files whose content did not change since they were scaffolded
+func (m *Manifest) UnmodifiedFiles(fs afero.Fs) ([]string, error) {
+	paths := make([]string, 0, len(m.Files))
+	for _, entry := range m.Files {
+		unmodified, err := m.IsUnmodified(fs, entry.Path)
+		if err != nil {
+			return nil, err
+		}
+		if unmodified {
+			paths = append(paths, entry.Path)
+		}
+	}
+
+	return paths, nil
+}
+
+// Sync updates the checksum of the files recorded since the manifest was loaded and of the provided unmodified
+// files to their current content, as they can be further edited after being written, e.g. by other plugins.
+// Files that no longer exist stop being tracked.
+func (m *Manifest) Sync(fs afero.Fs, unmodified []string) error {
+	refresh := make(map[string]struct{}, len(unmodified)+len(m.recorded))
+	for _, path := range unmodified {
+		refresh[filepath.ToSlash(filepath.Clean(path))] = struct{}{}
+	}
+	for path := range m.recorded {
+		refresh[path] = struct{}{}
+	}
+
+	files := make([]ManifestEntry, 0, len(m.Files))
+	for _, entry := range m.Files {
+		content, err := afero.ReadFile(fs, entry.Path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return fmt.Errorf("failed to read %q file: %w", entry.Path, err)
+		}
+
+		if _, found := refresh[entry.Path]; found {
+			entry.SHA256 = checksum(content)
+		}
+		files = append(files, entry)
+	}
+	m.Files = files
+
+	return nil
+}
+
+// checksum returns the hex encoded SHA-256 checksum of content
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
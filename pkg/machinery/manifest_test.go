@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+var _ = Describe("Manifest", func() {
+	const (
+		pluginKey    = "go.kubebuilder.io/v4"
+		templateType = "Main"
+		mainPath     = "cmd/main.go"
+		makefilePath = "Makefile"
+	)
+
+	var fs afero.Fs
+
+	BeforeEach(func() {
+		fs = afero.NewMemMapFs()
+	})
+
+	It("should be empty if the file does not exist", func() {
+		m, err := LoadManifest(fs, DefaultManifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(m.Files).To(BeEmpty())
+	})
+
+	It("should be saved and loaded back", func() {
+		m := &Manifest{}
+		m.Record(mainPath, pluginKey, templateType, []byte("package main\n"))
+		m.Record(makefilePath, pluginKey, "Makefile", []byte("all: build\n"))
+		Expect(m.Save(fs, DefaultManifestPath)).To(Succeed())
+
+		content, err := afero.ReadFile(fs, DefaultManifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(HavePrefix(manifestCommentStr))
+
+		loaded, err := LoadManifest(fs, DefaultManifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(loaded.Files).To(Equal([]ManifestEntry{
+			{Path: makefilePath, Plugin: pluginKey, Template: "Makefile", SHA256: checksum([]byte("all: build\n"))},
+			{Path: mainPath, Plugin: pluginKey, Template: templateType, SHA256: checksum([]byte("package main\n"))},
+		}))
+	})
+
+	It("should fail to load an invalid file", func() {
+		Expect(afero.WriteFile(fs, DefaultManifestPath, []byte("files: {"), 0o644)).To(Succeed())
+
+		_, err := LoadManifest(fs, DefaultManifestPath)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should replace the entry of files recorded again", func() {
+		m := &Manifest{}
+		m.Record(mainPath, pluginKey, templateType, []byte("package main\n"))
+		m.Record("./"+mainPath, "other.kubebuilder.io/v1", templateType, []byte("package other\n"))
+
+		Expect(m.Files).To(Equal([]ManifestEntry{
+			{
+				Path: mainPath, Plugin: "other.kubebuilder.io/v1", Template: templateType,
+				SHA256: checksum([]byte("package other\n")),
+			},
+		}))
+	})
+
+	It("should stop tracking removed files", func() {
+		m := &Manifest{}
+		m.Record(mainPath, pluginKey, templateType, []byte("package main\n"))
+		m.Remove(mainPath)
+
+		_, tracked := m.Get(mainPath)
+		Expect(tracked).To(BeFalse())
+	})
+
+	It("should tell unmodified files from modified ones", func() {
+		Expect(afero.WriteFile(fs, mainPath, []byte("package main\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, makefilePath, []byte("all: test\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, "README.md", []byte("# README\n"), 0o644)).To(Succeed())
+
+		m := &Manifest{}
+		m.Record(mainPath, pluginKey, templateType, []byte("package main\n"))
+		m.Record(makefilePath, pluginKey, "Makefile", []byte("all: build\n"))
+		m.Record("Dockerfile", pluginKey, "Dockerfile", []byte("FROM scratch\n"))
+
+		unmodified, err := m.IsUnmodified(fs, mainPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmodified).To(BeTrue())
+		unmodified, err = m.IsUnmodified(fs, makefilePath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmodified).To(BeFalse())
+		unmodified, err = m.IsUnmodified(fs, "README.md")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(unmodified).To(BeFalse())
+
+		Expect(m.UnmodifiedFiles(fs)).To(Equal([]string{mainPath}))
+	})
+
+	It("should sync the checksums of the recorded and unmodified files", func() {
+		Expect(afero.WriteFile(fs, mainPath, []byte("package main\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, makefilePath, []byte("all: build\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, "Dockerfile", []byte("FROM scratch\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, DefaultManifestPath, []byte(`files:
+- path: Dockerfile
+  sha256: modified
+- path: Makefile
+  sha256: `+checksum([]byte("all: build\n"))+`
+- path: README.md
+  sha256: removed
+`), 0o644)).To(Succeed())
+
+		m, err := LoadManifest(fs, DefaultManifestPath)
+		Expect(err).NotTo(HaveOccurred())
+		unmodified, err := m.UnmodifiedFiles(fs)
+		Expect(err).NotTo(HaveOccurred())
+
+		// Files updated after they were written, e.g. by other plugins
+		m.Record(mainPath, pluginKey, templateType, []byte("package main\n"))
+		Expect(afero.WriteFile(fs, mainPath, []byte("package main\n\nfunc main() {}\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, makefilePath, []byte("all: build test\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, "Dockerfile", []byte("FROM alpine\n"), 0o644)).To(Succeed())
+
+		Expect(m.Sync(fs, unmodified)).To(Succeed())
+		Expect(m.Files).To(Equal([]ManifestEntry{
+			{Path: "Dockerfile", SHA256: "modified"},
+			{Path: makefilePath, SHA256: checksum([]byte("all: build test\n"))},
+			{Path: mainPath, Plugin: pluginKey, Template: templateType,
+				SHA256: checksum([]byte("package main\n\nfunc main() {}\n"))},
+		}))
+	})
+})
@@ -140,6 +140,17 @@ func (m *NamespacedMixin) InjectNamespaced(flag bool) {
 	m.Namespaced = flag
 }
 
+// WatchNamespacesMixin provides templates with a injectable watched namespaces field
+type WatchNamespacesMixin struct {
+	// WatchNamespaces are the namespaces watched by a namespace-scoped manager
+	WatchNamespaces []string
+}
+
+// InjectWatchNamespaces implements HasWatchNamespaces
+func (m *WatchNamespacesMixin) InjectWatchNamespaces(namespaces []string) {
+	m.WatchNamespaces = namespaces
+}
+
 // BoilerplateMixin provides templates with a injectable boilerplate field
 type BoilerplateMixin struct {
 	// Boilerplate is the contents of a Boilerplate go header file
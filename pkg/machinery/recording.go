@@ -0,0 +1,380 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+)
+
+var _ afero.Fs = &RecordingFs{}
+
+// FileChange describes how a file recorded by a RecordingFs differs from the underlying filesystem
+type FileChange struct {
+	// Path is the path of the file as it was written or removed
+	Path string
+
+	// Before is the content of the file in the underlying filesystem, nil if it did not exist
+	Before []byte
+	// After is the recorded content of the file, nil if it was removed
+	After []byte
+
+	// Created indicates that the file did not exist in the underlying filesystem
+	Created bool
+	// Removed indicates that the file was removed
+	Removed bool
+}
+
+// RecordingFs is an afero.Fs that reads from an underlying filesystem but keeps every write and removal
+// in memory, so that the changes can be inspected with Changes without modifying the underlying filesystem.
+type RecordingFs struct {
+	base  afero.Fs
+	layer afero.Fs
+	union afero.Fs
+
+	// written holds the paths of the files opened for writing
+	written map[string]struct{}
+	// removed holds the paths of the files of the underlying filesystem that were removed
+	removed map[string]struct{}
+}
+
+// NewRecordingFs returns a RecordingFs on top of the provided filesystem
+func NewRecordingFs(base afero.Fs) *RecordingFs {
+	layer := afero.NewMemMapFs()
+
+	return &RecordingFs{
+		base:    base,
+		layer:   layer,
+		union:   afero.NewCopyOnWriteFs(afero.NewReadOnlyFs(base), layer),
+		written: make(map[string]struct{}),
+		removed: make(map[string]struct{}),
+	}
+}
+
+// Changes returns the files that differ from the underlying filesystem, sorted by path
+func (fs *RecordingFs) Changes() ([]FileChange, error) {
+	changes := make([]FileChange, 0, len(fs.written)+len(fs.removed))
+
+	for path := range fs.written {
+		if _, isRemoved := fs.removed[path]; isRemoved {
+			// Recreated files are reported below, against the content of the underlying filesystem
+			continue
+		}
+
+		after, err := readRegularFile(fs.layer, path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading recorded file %q: %w", path, err)
+		}
+		if after == nil {
+			continue
+		}
+
+		before, err := readRegularFile(fs.base, path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file %q: %w", path, err)
+		}
+		if before != nil && bytes.Equal(before, after) {
+			continue
+		}
+
+		changes = append(changes, FileChange{Path: path, Before: before, After: after, Created: before == nil})
+	}
+
+	for path := range fs.removed {
+		before, err := readRegularFile(fs.base, path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading file %q: %w", path, err)
+		}
+		if before == nil {
+			continue
+		}
+
+		after, err := readRegularFile(fs.layer, path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading recorded file %q: %w", path, err)
+		}
+		switch {
+		case after == nil:
+			changes = append(changes, FileChange{Path: path, Before: before, Removed: true})
+		case !bytes.Equal(before, after):
+			changes = append(changes, FileChange{Path: path, Before: before, After: after})
+		}
+	}
+
+	slices.SortFunc(changes, func(a, b FileChange) int { return strings.Compare(a.Path, b.Path) })
+
+	return changes, nil
+}
+
+// readRegularFile returns the content of the file, or nil if it does not exist or is a directory
+func readRegularFile(fs afero.Fs, path string) ([]byte, error) {
+	info, err := fs.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	if info.IsDir() {
+		return nil, nil
+	}
+
+	content, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		content = []byte{}
+	}
+
+	return content, nil
+}
+
+// isRemoved checks if the file was removed from the underlying filesystem and not created again
+func (fs *RecordingFs) isRemoved(name string) bool {
+	if _, isRemoved := fs.removed[filepath.Clean(name)]; !isRemoved {
+		return false
+	}
+	_, err := fs.layer.Stat(name)
+	return err != nil
+}
+
+func notExist(op, name string) error {
+	return &os.PathError{Op: op, Path: name, Err: os.ErrNotExist}
+}
+
+// Name implements afero.Fs
+func (fs *RecordingFs) Name() string {
+	return "RecordingFs"
+}
+
+// Create implements afero.Fs
+func (fs *RecordingFs) Create(name string) (afero.File, error) {
+	return fs.OpenFile(name, os.O_CREATE|os.O_TRUNC|os.O_RDWR, 0o666)
+}
+
+// Mkdir implements afero.Fs
+func (fs *RecordingFs) Mkdir(name string, perm os.FileMode) error {
+	return fs.union.Mkdir(name, perm)
+}
+
+// MkdirAll implements afero.Fs
+func (fs *RecordingFs) MkdirAll(path string, perm os.FileMode) error {
+	return fs.union.MkdirAll(path, perm)
+}
+
+// Open implements afero.Fs
+func (fs *RecordingFs) Open(name string) (afero.File, error) {
+	if fs.isRemoved(name) {
+		return nil, notExist("open", name)
+	}
+
+	f, err := fs.union.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingFile{File: f, fs: fs, path: filepath.Clean(name)}, nil
+}
+
+// OpenFile implements afero.Fs
+func (fs *RecordingFs) OpenFile(name string, flag int, perm os.FileMode) (afero.File, error) {
+	path := filepath.Clean(name)
+
+	if fs.isRemoved(path) {
+		if flag&os.O_CREATE == 0 {
+			return nil, notExist("open", name)
+		}
+		// Create an empty file in the layer so that the removed content is not copied from the base
+		if err := fs.layer.MkdirAll(filepath.Dir(path), 0o777); err != nil {
+			return nil, err
+		}
+		if err := afero.WriteFile(fs.layer, path, nil, perm); err != nil {
+			return nil, err
+		}
+	}
+
+	if flag&(os.O_WRONLY|os.O_RDWR|os.O_APPEND|os.O_CREATE|os.O_TRUNC) == 0 {
+		f, err := fs.union.OpenFile(name, flag, perm)
+		if err != nil {
+			return nil, err
+		}
+		return &recordingFile{File: f, fs: fs, path: path}, nil
+	}
+
+	f, err := fs.union.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	fs.written[path] = struct{}{}
+
+	return f, nil
+}
+
+// Remove implements afero.Fs
+func (fs *RecordingFs) Remove(name string) error {
+	path := filepath.Clean(name)
+
+	inLayer := false
+	if _, err := fs.layer.Stat(path); err == nil {
+		inLayer = true
+	}
+	inBase := false
+	if _, isRemoved := fs.removed[path]; !isRemoved {
+		if _, err := fs.base.Stat(path); err == nil {
+			inBase = true
+		}
+	}
+
+	if !inLayer && !inBase {
+		return notExist("remove", name)
+	}
+	if inLayer {
+		if err := fs.layer.Remove(path); err != nil {
+			return err
+		}
+	}
+	if inBase {
+		fs.removed[path] = struct{}{}
+	}
+
+	return nil
+}
+
+// RemoveAll implements afero.Fs
+func (fs *RecordingFs) RemoveAll(path string) error {
+	info, err := fs.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if info.IsDir() {
+		entries, err := afero.ReadDir(fs, path)
+		if err != nil {
+			return err
+		}
+		for _, entry := range entries {
+			if err = fs.RemoveAll(filepath.Join(path, entry.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = fs.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// Rename implements afero.Fs
+func (fs *RecordingFs) Rename(oldname, newname string) error {
+	content, err := afero.ReadFile(fs, oldname)
+	if err != nil {
+		return err
+	}
+	info, err := fs.Stat(oldname)
+	if err != nil {
+		return err
+	}
+
+	if err = afero.WriteFile(fs, newname, content, info.Mode()); err != nil {
+		return err
+	}
+
+	return fs.Remove(oldname)
+}
+
+// Stat implements afero.Fs
+func (fs *RecordingFs) Stat(name string) (os.FileInfo, error) {
+	if fs.isRemoved(name) {
+		return nil, notExist("stat", name)
+	}
+
+	return fs.union.Stat(name)
+}
+
+// Chmod implements afero.Fs
+func (fs *RecordingFs) Chmod(name string, mode os.FileMode) error {
+	if fs.isRemoved(name) {
+		return notExist("chmod", name)
+	}
+
+	return fs.union.Chmod(name, mode)
+}
+
+// Chown implements afero.Fs
+func (fs *RecordingFs) Chown(name string, uid, gid int) error {
+	if fs.isRemoved(name) {
+		return notExist("chown", name)
+	}
+
+	return fs.union.Chown(name, uid, gid)
+}
+
+// Chtimes implements afero.Fs
+func (fs *RecordingFs) Chtimes(name string, atime time.Time, mtime time.Time) error {
+	if fs.isRemoved(name) {
+		return notExist("chtimes", name)
+	}
+
+	return fs.union.Chtimes(name, atime, mtime)
+}
+
+// recordingFile is a file opened for reading from a RecordingFs. Directory listings leave out the entries
+// that were removed, which the underlying filesystem still holds.
+type recordingFile struct {
+	afero.File
+
+	fs   *RecordingFs
+	path string
+}
+
+// Readdir implements afero.File
+func (f *recordingFile) Readdir(count int) ([]os.FileInfo, error) {
+	var infos []os.FileInfo
+	for {
+		batch, err := f.File.Readdir(count)
+		for _, info := range batch {
+			if !f.fs.isRemoved(filepath.Join(f.path, info.Name())) {
+				infos = append(infos, info)
+			}
+		}
+		// Read the next batch when every entry of this one was removed, as a partial listing must not be empty
+		if err != nil || count <= 0 || len(infos) != 0 || len(batch) == 0 {
+			return infos, err
+		}
+	}
+}
+
+// Readdirnames implements afero.File
+func (f *recordingFile) Readdirnames(n int) ([]string, error) {
+	infos, err := f.Readdir(n)
+	names := make([]string, 0, len(infos))
+	for _, info := range infos {
+		names = append(names, info.Name())
+	}
+	return names, err
+}
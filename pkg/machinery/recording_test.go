@@ -0,0 +1,268 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinery
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+)
+
+var _ = Describe("RecordingFs", func() {
+	const (
+		existingPath = "config/existing.yaml"
+		otherPath    = "config/other.yaml"
+		newPath      = "api/v1/new.go"
+	)
+
+	var (
+		base afero.Fs
+		fs   *RecordingFs
+	)
+
+	BeforeEach(func() {
+		base = afero.NewMemMapFs()
+		Expect(afero.WriteFile(base, existingPath, []byte("a\nb\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(base, otherPath, []byte("other\n"), 0o644)).To(Succeed())
+
+		fs = NewRecordingFs(base)
+	})
+
+	It("should read the files of the underlying filesystem", func() {
+		content, err := afero.ReadFile(fs, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("a\nb\n"))
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("should record new and updated files without writing them", func() {
+		Expect(fs.MkdirAll("api/v1", 0o755)).To(Succeed())
+		Expect(afero.WriteFile(fs, newPath, []byte("package v1\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs, existingPath, []byte("a\nc\n"), 0o644)).To(Succeed())
+
+		content, err := afero.ReadFile(fs, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("a\nc\n"))
+
+		exists, err := afero.Exists(base, newPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		content, err = afero.ReadFile(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("a\nb\n"))
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: newPath, After: []byte("package v1\n"), Created: true},
+			{Path: existingPath, Before: []byte("a\nb\n"), After: []byte("a\nc\n")},
+		}))
+	})
+
+	It("should record appends to the files of the underlying filesystem", func() {
+		f, err := fs.OpenFile(existingPath, os.O_APPEND|os.O_WRONLY, 0o644)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString("c\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Before: []byte("a\nb\n"), After: []byte("a\nb\nc\n")},
+		}))
+	})
+
+	It("should not report files written with the same content", func() {
+		Expect(afero.WriteFile(fs, existingPath, []byte("a\nb\n"), 0o644)).To(Succeed())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("should record removed files without removing them", func() {
+		Expect(fs.Remove(existingPath)).To(Succeed())
+
+		exists, err := afero.Exists(fs, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		Expect(fs.Remove(existingPath)).To(MatchError(os.ErrNotExist))
+
+		exists, err = afero.Exists(base, existingPath)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Before: []byte("a\nb\n"), Removed: true},
+		}))
+	})
+
+	It("should record the removal of directories", func() {
+		Expect(fs.RemoveAll("config")).To(Succeed())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Before: []byte("a\nb\n"), Removed: true},
+			{Path: otherPath, Before: []byte("other\n"), Removed: true},
+		}))
+	})
+
+	It("should leave the removed files out of the directory listings", func() {
+		Expect(fs.Remove(existingPath)).To(Succeed())
+
+		entries, err := afero.ReadDir(fs, "config")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(entries).To(HaveLen(1))
+		Expect(entries[0].Name()).To(Equal("other.yaml"))
+
+		empty, err := afero.IsEmpty(fs, "config")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(empty).To(BeFalse())
+
+		Expect(fs.Remove(otherPath)).To(Succeed())
+		empty, err = afero.IsEmpty(fs, "config")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(empty).To(BeTrue())
+
+		Expect(fs.Remove("config")).To(Succeed())
+		exists, err := afero.DirExists(fs, "config")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should not copy the removed content into files created again", func() {
+		Expect(fs.Remove(existingPath)).To(Succeed())
+
+		_, err := fs.OpenFile(existingPath, os.O_WRONLY, 0o644)
+		Expect(err).To(MatchError(os.ErrNotExist))
+
+		f, err := fs.OpenFile(existingPath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		Expect(err).NotTo(HaveOccurred())
+		_, err = f.WriteString("c\n")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]FileChange{
+			{Path: existingPath, Before: []byte("a\nb\n"), After: []byte("c\n")},
+		}))
+	})
+
+	It("should not report files created and removed", func() {
+		Expect(fs.MkdirAll("api/v1", 0o755)).To(Succeed())
+		Expect(afero.WriteFile(fs, newPath, []byte("package v1\n"), 0o644)).To(Succeed())
+		Expect(fs.Remove(newPath)).To(Succeed())
+
+		changes, err := fs.Changes()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+})
+
+var _ = Describe("FileChange", func() {
+	Describe("UnifiedDiff", func() {
+		It("should show the changed lines with their context", func() {
+			change := FileChange{
+				Path:   "main.go",
+				Before: []byte("1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n"),
+				After:  []byte("1\n2\n3\n4\n5\nsix\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n"),
+			}
+
+			Expect(change.UnifiedDiff()).To(Equal(`--- a/main.go
++++ b/main.go
+@@ -3,7 +3,7 @@
+ 3
+ 4
+ 5
+-6
++six
+ 7
+ 8
+ 9
+@@ -13,3 +13,4 @@
+ 13
+ 14
+ 15
++16
+`))
+		})
+
+		It("should merge changes with overlapping context into a hunk", func() {
+			change := FileChange{
+				Path:   "Makefile",
+				Before: []byte("a\nb\nc\nd\ne\n"),
+				After:  []byte("A\nb\nc\nd\nE\n"),
+			}
+
+			Expect(change.UnifiedDiff()).To(Equal(`--- a/Makefile
++++ b/Makefile
+@@ -1,5 +1,5 @@
+-a
++A
+ b
+ c
+ d
+-e
++E
+`))
+		})
+
+		It("should diff created files against /dev/null", func() {
+			change := FileChange{Path: "PROJECT", After: []byte("domain: my.domain\n"), Created: true}
+
+			Expect(change.UnifiedDiff()).To(Equal(`--- /dev/null
++++ b/PROJECT
+@@ -0,0 +1 @@
++domain: my.domain
+`))
+		})
+
+		It("should diff removed files against /dev/null", func() {
+			change := FileChange{Path: "PROJECT", Before: []byte("domain: my.domain\nrepo: x\n"), Removed: true}
+
+			Expect(change.UnifiedDiff()).To(Equal(`--- a/PROJECT
++++ /dev/null
+@@ -1,2 +0,0 @@
+-domain: my.domain
+-repo: x
+`))
+		})
+
+		It("should mark lines without a final newline", func() {
+			change := FileChange{Path: "go.mod", Before: []byte("a\nb"), After: []byte("a\nb\n")}
+
+			Expect(change.UnifiedDiff()).To(Equal(`--- a/go.mod
++++ b/go.mod
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++b
+`))
+		})
+	})
+})
@@ -24,6 +24,8 @@ import (
 	log "log/slog"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -65,6 +67,10 @@ type Scaffold struct {
 
 	// injector is used to provide several fields to the templates
 	injector injector
+
+	// manifest records the scaffolded files, as the plugin with key pluginKey
+	manifest  *Manifest
+	pluginKey string
 }
 
 // ScaffoldOption allows to provide optional arguments to the Scaffold
@@ -73,9 +79,11 @@ type ScaffoldOption func(*Scaffold)
 // NewScaffold returns a new Scaffold with the provided plugins
 func NewScaffold(fs Filesystem, options ...ScaffoldOption) *Scaffold {
 	s := &Scaffold{
-		fs:       fs.FS,
-		dirPerm:  DefaultDirectoryPermission,
-		filePerm: DefaultFilePermission,
+		fs:        fs.FS,
+		dirPerm:   DefaultDirectoryPermission,
+		filePerm:  DefaultFilePermission,
+		manifest:  fs.Manifest,
+		pluginKey: fs.PluginKey,
 	}
 
 	for _, option := range options {
@@ -166,6 +174,40 @@ func (s *Scaffold) Execute(builders ...Builder) error {
 	return nil
 }
 
+// Delete removes from disk what the provided builders scaffold. Inserters get their code
+// fragments removed from the file they update, as those files are shared with other resources,
+// while any other Template gets its file removed along with the directories left empty.
+// Missing files are skipped.
+func (s *Scaffold) Delete(builders ...Builder) error {
+	for _, builder := range builders {
+		// Inject common fields
+		s.injector.injectInto(builder)
+
+		// Set the template default values, as paths are computed there
+		t, isTemplate := builder.(Template)
+		if isTemplate {
+			if err := t.SetTemplateDefaults(); err != nil {
+				return SetTemplateDefaultsError{err}
+			}
+		}
+
+		if i, isInserter := builder.(Inserter); isInserter {
+			if err := s.removeCodeFragments(i); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if isTemplate {
+			if err := s.deleteFile(t.GetPath()); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // buildFileModel scaffolds a single file
 func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 	// Set the template default values
@@ -182,7 +224,7 @@ func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 			return nil
 		case Error:
 			return ModelAlreadyExistsError{path}
-		case OverwriteFile:
+		case OverwriteFile, OverwriteFileIfUnmodified:
 		default:
 			return UnknownIfExistsActionError{path, t.GetIfExistsAction()}
 		}
@@ -197,6 +239,7 @@ func (Scaffold) buildFileModel(t Template, models map[string]*File) error {
 		Path:           path,
 		Contents:       string(b),
 		IfExistsAction: t.GetIfExistsAction(),
+		templateName:   templateName(t),
 	}
 	return nil
 }
@@ -330,6 +373,20 @@ func (s Scaffold) loadPreviousModel(i Inserter, models map[string]*File) (*File,
 		case OverwriteFile:
 			// Model has preference
 			return m, nil
+		case OverwriteFileIfUnmodified:
+			// Model has preference only if the file was not modified
+			unmodified, err := s.isUnmodified(path)
+			if err != nil {
+				return nil, err
+			}
+			if unmodified {
+				return m, nil
+			}
+			fromFile, err := s.loadModelFromFile(path)
+			if err != nil {
+				return m, nil
+			}
+			return fromFile, nil
 		default:
 			return nil, UnknownIfExistsActionError{path, m.IfExistsAction}
 		}
@@ -508,6 +565,119 @@ func insertStrings(content string, codeFragmentsMap CodeFragmentsMap) ([]byte, e
 	return out.Bytes(), nil
 }
 
+// removeCodeFragments removes the code fragments of an Inserter from the file it updates
+func (s Scaffold) removeCodeFragments(i Inserter) error {
+	path := i.GetPath()
+
+	m, err := s.loadModelFromFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("failed to load previous model for %s: %w", path, err)
+	}
+
+	isGoFile := filepath.Ext(path) == ".go"
+	content := m.Contents
+	for _, codeFragments := range getValidCodeFragments(i) {
+		for _, codeFragment := range codeFragments {
+			// Imports may be shared by several resources, so instead of removing them
+			// we let goimports prune the ones that are no longer used.
+			if isGoFile && importCodeFragmentRegex.MatchString(strings.TrimSpace(codeFragment)) {
+				continue
+			}
+			content = removeCodeFragment(content, codeFragment)
+		}
+	}
+
+	// If no code fragment was removed, we are done
+	if content == m.Contents {
+		return nil
+	}
+
+	if isGoFile {
+		formattedContent, err := imports.Process(path, []byte(content), nil)
+		if err != nil {
+			return fmt.Errorf("failed to process formatted content: %w", err)
+		}
+		content = string(formattedContent)
+	}
+
+	return s.writeFile(&File{Path: path, Contents: content, IfExistsAction: OverwriteFile})
+}
+
+// importCodeFragmentRegex matches code fragments that consist of a single Go import spec
+var importCodeFragmentRegex = regexp.MustCompile(`^(\w+\s+)?"[^"]+"$`)
+
+// removeCodeFragment removes the first occurrence of codeFragment from content. Lines are compared
+// with trimmed whitespace in order to match different levels of indentation.
+func removeCodeFragment(content, codeFragment string) string {
+	if strings.TrimSpace(codeFragment) == "" {
+		return content
+	}
+
+	contentLines := strings.Split(content, "\n")
+	trimmedContentLines := make([]string, len(contentLines))
+	for i, line := range contentLines {
+		trimmedContentLines[i] = strings.TrimSpace(line)
+	}
+
+	// Try the code fragment as it was inserted first, and then without surrounding
+	// empty lines as formatters may have removed them.
+	candidates := [][]string{
+		trimmedLines(strings.TrimSuffix(codeFragment, "\n")),
+		trimmedLines(strings.TrimSpace(codeFragment)),
+	}
+	for _, fragmentLines := range candidates {
+		for i := 0; i+len(fragmentLines) <= len(trimmedContentLines); i++ {
+			if slices.Equal(trimmedContentLines[i:i+len(fragmentLines)], fragmentLines) {
+				return strings.Join(slices.Delete(contentLines, i, i+len(fragmentLines)), "\n")
+			}
+		}
+	}
+
+	return content
+}
+
+// trimmedLines splits text into lines and trims the whitespace of each of them
+func trimmedLines(text string) []string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(line)
+	}
+	return lines
+}
+
+// deleteFile removes the file at path if it exists, as well as the directories it leaves empty
+func (s Scaffold) deleteFile(path string) error {
+	exists, err := afero.Exists(s.fs, path)
+	if err != nil {
+		return ExistsFileError{err}
+	}
+	if !exists {
+		return nil
+	}
+
+	if err = s.fs.Remove(path); err != nil {
+		return RemoveFileError{err}
+	}
+	if s.manifest != nil {
+		s.manifest.Remove(path)
+	}
+
+	for dir := filepath.Dir(path); dir != "." && dir != string(filepath.Separator); dir = filepath.Dir(dir) {
+		if empty, emptyErr := afero.IsEmpty(s.fs, dir); emptyErr != nil || !empty {
+			break
+		}
+		if err = s.fs.Remove(dir); err != nil {
+			return RemoveFileError{err}
+		}
+	}
+
+	return nil
+}
+
 func (s Scaffold) writeFile(f *File) error {
 	// Check if the file to write already exists
 	exists, err := afero.Exists(s.fs, f.Path)
@@ -524,6 +694,16 @@ func (s Scaffold) writeFile(f *File) error {
 		case Error:
 			// By returning an error, the file is not written and the process will fail
 			return FileAlreadyExistsError{f.Path}
+		case OverwriteFileIfUnmodified:
+			// The file is written only if it was not modified since it was scaffolded
+			unmodified, unmodifiedErr := s.isUnmodified(f.Path)
+			if unmodifiedErr != nil {
+				return unmodifiedErr
+			}
+			if !unmodified {
+				log.Warn("skipping file modified since it was scaffolded", "file", f.Path)
+				return nil
+			}
 		}
 	}
 
@@ -547,9 +727,37 @@ func (s Scaffold) writeFile(f *File) error {
 		return WriteFileError{writeErr}
 	}
 
+	// Record the files scaffolded from templates
+	if s.manifest != nil && f.templateName != "" {
+		s.manifest.Record(f.Path, s.pluginKey, f.templateName, []byte(f.Contents))
+	}
+
 	return nil
 }
 
+// templateName returns the name of the type of the template without its package path, which does not change
+// when the template is moved to another package
+func templateName(t Template) string {
+	typ := reflect.TypeOf(t)
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	return typ.Name()
+}
+
+// isUnmodified checks if the scaffold manifest tracks the file at path as unmodified
+func (s Scaffold) isUnmodified(path string) (bool, error) {
+	if s.manifest == nil {
+		return false, nil
+	}
+
+	unmodified, err := s.manifest.IsUnmodified(s.fs, path)
+	if err != nil {
+		return false, ExistsFileError{err}
+	}
+	return unmodified, nil
+}
+
 // SubstituteYear replaces every occurrence of "YEAR" in the boilerplate string
 // with the current UTC year.
 func SubstituteYear(boilerplate string) string {
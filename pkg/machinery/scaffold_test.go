@@ -488,6 +488,96 @@ func init() {
 			})
 		})
 
+		Context("with a scaffold manifest", func() {
+			const pluginKey = "go.kubebuilder.io/v4"
+
+			BeforeEach(func() {
+				s.manifest = &Manifest{}
+				s.pluginKey = pluginKey
+			})
+
+			It("should record the files written from templates", func() {
+				Expect(s.Execute(
+					&fakeTemplate{fakeBuilder: fakeBuilder{path: path}, body: content},
+				)).To(Succeed())
+
+				entry, tracked := s.manifest.Get(path)
+				Expect(tracked).To(BeTrue())
+				Expect(entry).To(Equal(ManifestEntry{
+					Path:     path,
+					Plugin:   pluginKey,
+					Template: "fakeTemplate",
+					SHA256:   checksum([]byte(content)),
+				}))
+			})
+
+			It("should not record the files only updated by inserters", func() {
+				Expect(afero.WriteFile(s.fs, pathYaml, []byte("#+kubebuilder:scaffold:-\n"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(fakeInserter{
+					fakeBuilder: fakeBuilder{path: pathYaml},
+					codeFragments: CodeFragmentsMap{
+						NewMarkerFor(pathYaml, "-"): {"1\n"},
+					},
+				})).To(Succeed())
+
+				_, tracked := s.manifest.Get(pathYaml)
+				Expect(tracked).To(BeFalse())
+			})
+
+			It("should not record the skipped files", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte{}, 0o666)).To(Succeed())
+
+				Expect(s.Execute(
+					&fakeTemplate{fakeBuilder: fakeBuilder{path: path}, body: content},
+				)).To(Succeed())
+
+				_, tracked := s.manifest.Get(path)
+				Expect(tracked).To(BeFalse())
+			})
+
+			It("should overwrite the files that were not modified since they were scaffolded", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte("old"), 0o666)).To(Succeed())
+				s.manifest.Record(path, pluginKey, "fakeTemplate", []byte("old"))
+
+				Expect(s.Execute(&fakeTemplate{
+					fakeBuilder: fakeBuilder{path: path, ifExistsAction: OverwriteFileIfUnmodified},
+					body:        content,
+				})).To(Succeed())
+
+				b, err := afero.ReadFile(s.fs, path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(b)).To(Equal(content))
+			})
+
+			It("should skip the files that were modified since they were scaffolded", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte("modified"), 0o666)).To(Succeed())
+				s.manifest.Record(path, pluginKey, "fakeTemplate", []byte("old"))
+
+				Expect(s.Execute(&fakeTemplate{
+					fakeBuilder: fakeBuilder{path: path, ifExistsAction: OverwriteFileIfUnmodified},
+					body:        content,
+				})).To(Succeed())
+
+				b, err := afero.ReadFile(s.fs, path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(b)).To(Equal("modified"))
+			})
+
+			It("should skip the files that are not tracked", func() {
+				Expect(afero.WriteFile(s.fs, path, []byte("untracked"), 0o666)).To(Succeed())
+
+				Expect(s.Execute(&fakeTemplate{
+					fakeBuilder: fakeBuilder{path: path, ifExistsAction: OverwriteFileIfUnmodified},
+					body:        content,
+				})).To(Succeed())
+
+				b, err := afero.ReadFile(s.fs, path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(b)).To(Equal("untracked"))
+			})
+		})
+
 		Context("WithConfig option", func() {
 			It("should set repository in imports.LocalPrefix", func() {
 				cfg := cfgv3.New()
@@ -536,6 +626,210 @@ func init() {
 	})
 })
 
+var _ = Describe("Scaffold.Delete", func() {
+	const (
+		path     = "filename"
+		pathGo   = path + ".go"
+		pathYaml = path + ".yaml"
+	)
+
+	var s *Scaffold
+
+	BeforeEach(func() {
+		s = &Scaffold{fs: afero.NewMemMapFs()}
+	})
+
+	It("should remove the files of templates", func() {
+		Expect(afero.WriteFile(s.fs, path, []byte("content"), 0o666)).To(Succeed())
+
+		Expect(s.Delete(&fakeTemplate{fakeBuilder: fakeBuilder{path: path}})).To(Succeed())
+
+		exists, err := afero.Exists(s.fs, path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("should remove the directories left empty", func() {
+		nestedPath := "dir/subdir/" + path
+		Expect(afero.WriteFile(s.fs, nestedPath, []byte("content"), 0o666)).To(Succeed())
+		Expect(afero.WriteFile(s.fs, "dir/other", []byte("content"), 0o666)).To(Succeed())
+
+		Expect(s.Delete(&fakeTemplate{fakeBuilder: fakeBuilder{path: nestedPath}})).To(Succeed())
+
+		exists, err := afero.DirExists(s.fs, "dir/subdir")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		exists, err = afero.Exists(s.fs, "dir/other")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(exists).To(BeTrue())
+	})
+
+	It("should stop tracking the removed files in the scaffold manifest", func() {
+		s.manifest = &Manifest{}
+		s.manifest.Record(path, "go.kubebuilder.io/v4", "fakeTemplate", []byte("content"))
+		Expect(afero.WriteFile(s.fs, path, []byte("content"), 0o666)).To(Succeed())
+
+		Expect(s.Delete(&fakeTemplate{fakeBuilder: fakeBuilder{path: path}})).To(Succeed())
+
+		_, tracked := s.manifest.Get(path)
+		Expect(tracked).To(BeFalse())
+	})
+
+	It("should skip missing files", func() {
+		Expect(s.Delete(
+			&fakeTemplate{fakeBuilder: fakeBuilder{path: path}},
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathYaml},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathYaml, "-"): {"1\n"},
+				},
+			},
+		)).To(Succeed())
+	})
+
+	It("should fail if the template defaults cannot be set", func() {
+		err := s.Delete(&fakeTemplate{err: errors.New("error text")})
+		Expect(err).To(HaveOccurred())
+		var expectedErr SetTemplateDefaultsError
+		Expect(errors.As(err, &expectedErr)).To(BeTrue())
+	})
+
+	DescribeTable("remove strings",
+		func(path, input, expected string, files ...Builder) {
+			Expect(afero.WriteFile(s.fs, path, []byte(input), 0o666)).To(Succeed())
+
+			Expect(s.Delete(files...)).To(Succeed())
+
+			b, err := afero.ReadFile(s.fs, path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(b)).To(Equal(expected))
+		},
+		Entry("should remove lines for yaml files",
+			pathYaml,
+			`
+1
+2
+3
+# +kubebuilder:scaffold:-
+`,
+			`
+1
+3
+# +kubebuilder:scaffold:-
+`,
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathYaml},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathYaml, "-"): {"2\n"},
+				},
+			},
+		),
+		Entry("should remove multi-line fragments with a different indentation",
+			pathYaml,
+			`
+a:
+  b: 1
+  c: 2
+# +kubebuilder:scaffold:-
+`,
+			`
+a:
+# +kubebuilder:scaffold:-
+`,
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathYaml},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathYaml, "-"): {"b: 1\nc: 2\n"},
+				},
+			},
+		),
+		Entry("should keep the file untouched if the fragments are not found",
+			pathYaml,
+			`
+1
+# +kubebuilder:scaffold:-
+`,
+			`
+1
+# +kubebuilder:scaffold:-
+`,
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathYaml},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathYaml, "-"): {"2\n"},
+				},
+			},
+		),
+		Entry("should remove lines and prune unused imports for go files",
+			pathGo,
+			`package test
+
+import (
+	"fmt"
+	"strings"
+	// +kubebuilder:scaffold:imports
+)
+
+var a = fmt.Sprint()
+var b = strings.ToLower("")
+
+// +kubebuilder:scaffold:-
+`,
+			`package test
+
+import (
+	"fmt"
+	// +kubebuilder:scaffold:imports
+)
+
+var a = fmt.Sprint()
+
+// +kubebuilder:scaffold:-
+`,
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathGo},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathGo, "imports"): {"\"strings\"\n"},
+					NewMarkerFor(pathGo, "-"):       {"var b = strings.ToLower(\"\")\n"},
+				},
+			},
+		),
+		Entry("should keep imports that are still in use for go files",
+			pathGo,
+			`package test
+
+import (
+	"strings"
+	// +kubebuilder:scaffold:imports
+)
+
+var a = strings.ToUpper("")
+var b = strings.ToLower("")
+
+// +kubebuilder:scaffold:-
+`,
+			`package test
+
+import (
+	"strings"
+	// +kubebuilder:scaffold:imports
+)
+
+var a = strings.ToUpper("")
+
+// +kubebuilder:scaffold:-
+`,
+			fakeInserter{
+				fakeBuilder: fakeBuilder{path: pathGo},
+				codeFragments: CodeFragmentsMap{
+					NewMarkerFor(pathGo, "imports"): {"\"strings\"\n"},
+					NewMarkerFor(pathGo, "-"):       {"var b = strings.ToLower(\"\")\n"},
+				},
+			},
+		),
+	)
+})
+
 var _ Builder = fakeBuilder{}
 
 // fakeBuilder is used to mock a Builder
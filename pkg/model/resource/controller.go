@@ -18,6 +18,7 @@ package resource
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
@@ -29,6 +30,12 @@ type Controller struct {
 	// Name is the controller identifier, unique within a resource.
 	// Must be a valid DNS label (lowercase, alphanumeric, hyphens, max 63 chars).
 	Name string `json:"name,omitempty"`
+
+	// Owns holds the resources created and owned by the controller, which are reconciled again when they change.
+	Owns []GVK `json:"owns,omitempty"`
+
+	// Watches holds other resources watched by the controller, mapped to the objects to reconcile.
+	Watches []GVK `json:"watches,omitempty"`
 }
 
 // Validate checks that the Controller is valid.
@@ -42,9 +49,34 @@ func (c Controller) Validate() error {
 		return fmt.Errorf("invalid controller name %q: %s", c.Name, strings.Join(errors, ", "))
 	}
 
+	for _, gvk := range c.Owns {
+		if err := gvk.Validate(); err != nil {
+			return fmt.Errorf("invalid resource owned by controller %q: %w", c.Name, err)
+		}
+	}
+	for _, gvk := range c.Watches {
+		if err := gvk.Validate(); err != nil {
+			return fmt.Errorf("invalid resource watched by controller %q: %w", c.Name, err)
+		}
+	}
+
 	return nil
 }
 
+// Update adds the owned and watched resources of other that are not yet tracked by c.
+func (c *Controller) Update(other Controller) {
+	for _, gvk := range other.Owns {
+		if !slices.ContainsFunc(c.Owns, gvk.IsEqualTo) {
+			c.Owns = append(c.Owns, gvk)
+		}
+	}
+	for _, gvk := range other.Watches {
+		if !slices.ContainsFunc(c.Watches, gvk.IsEqualTo) {
+			c.Watches = append(c.Watches, gvk)
+		}
+	}
+}
+
 // Controllers holds a list of controllers for a resource.
 type Controllers []Controller
 
@@ -200,12 +232,16 @@ func (c *Controllers) Copy() Controllers {
 	}
 
 	controllers := make(Controllers, len(*c))
-	copy(controllers, *c)
+	for i, controller := range *c {
+		controller.Owns = slices.Clone(controller.Owns)
+		controller.Watches = slices.Clone(controller.Watches)
+		controllers[i] = controller
+	}
 	return controllers
 }
 
 // Update combines fields of two Controllers.
-// It adds controllers from other that don't exist in c.
+// It adds controllers from other that don't exist in c, and the owned and watched resources of the existing ones.
 func (c *Controllers) Update(other *Controllers) error {
 	if c == nil {
 		return fmt.Errorf("cannot update a nil Controllers")
@@ -216,8 +252,11 @@ func (c *Controllers) Update(other *Controllers) error {
 	}
 
 	for _, controller := range *other {
-		if !c.HasController(controller.Name) {
+		i := slices.IndexFunc(*c, func(existing Controller) bool { return existing.Name == controller.Name })
+		if i == -1 {
 			*c = append(*c, controller)
+		} else {
+			(*c)[i].Update(controller)
 		}
 	}
 
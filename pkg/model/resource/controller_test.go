@@ -51,6 +51,25 @@ func TestController_Validate(t *testing.T) {
 			ctrl:    Controller{Name: "controller-1"},
 			wantErr: false,
 		},
+		{
+			name: "valid owned and watched resources",
+			ctrl: Controller{
+				Name:    "captain",
+				Owns:    []GVK{{Group: "apps", Version: "v1", Kind: "Deployment"}},
+				Watches: []GVK{{Group: "core", Version: "v1", Kind: "ConfigMap"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid owned resource",
+			ctrl:    Controller{Name: "captain", Owns: []GVK{{Group: "apps", Kind: "Deployment"}}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid watched resource",
+			ctrl:    Controller{Name: "captain", Watches: []GVK{{Group: "core", Version: "v1"}}},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -319,6 +338,43 @@ func TestResource_Update_WithControllers(t *testing.T) {
 	}
 }
 
+func TestControllers_Update_SecondaryResources(t *testing.T) {
+	deployment := GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+	service := GVK{Group: "core", Version: "v1", Kind: "Service"}
+	configMap := GVK{Group: "core", Version: "v1", Kind: "ConfigMap"}
+
+	ctrls := &Controllers{{Name: "captain", Owns: []GVK{deployment}}}
+	other := &Controllers{
+		{Name: "captain", Owns: []GVK{deployment, service}, Watches: []GVK{configMap}},
+		{Name: "captain-backup", Watches: []GVK{configMap}},
+	}
+	if err := ctrls.Update(other); err != nil {
+		t.Fatalf("Controllers.Update() error = %v", err)
+	}
+
+	if len(*ctrls) != 2 {
+		t.Fatalf("Controllers.Update() len = %v, want 2", len(*ctrls))
+	}
+	captain := (*ctrls)[0]
+	if len(captain.Owns) != 2 || captain.Owns[0] != deployment || captain.Owns[1] != service {
+		t.Errorf("Controllers.Update() owns = %v, want [%v %v]", captain.Owns, deployment, service)
+	}
+	if len(captain.Watches) != 1 || captain.Watches[0] != configMap {
+		t.Errorf("Controllers.Update() watches = %v, want [%v]", captain.Watches, configMap)
+	}
+}
+
+func TestControllers_Copy_SecondaryResources(t *testing.T) {
+	ctrls := &Controllers{{Name: "captain", Owns: []GVK{{Group: "apps", Version: "v1", Kind: "Deployment"}}}}
+
+	copied := ctrls.Copy()
+	copied[0].Owns[0].Kind = "StatefulSet"
+
+	if (*ctrls)[0].Owns[0].Kind != "Deployment" {
+		t.Errorf("Controllers.Copy() shares the owned resources with the original")
+	}
+}
+
 func TestResource_GetControllerNames(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -160,6 +160,11 @@ func (r Resource) HasValidationWebhook() bool {
 	return r.Webhooks != nil && r.Webhooks.Validation
 }
 
+// HasValidatingAdmissionPolicy returns true if the resource is validated by a ValidatingAdmissionPolicy.
+func (r Resource) HasValidatingAdmissionPolicy() bool {
+	return r.Webhooks != nil && r.Webhooks.ValidatingAdmissionPolicy
+}
+
 // HasConversionWebhook returns true if the resource has an associated conversion webhook.
 func (r Resource) HasConversionWebhook() bool {
 	return r.Webhooks != nil && r.Webhooks.Conversion
@@ -224,6 +224,20 @@ var _ = Describe("Resource", func() {
 			)
 		})
 
+		Context("HasValidatingAdmissionPolicy", func() {
+			It("should return true if the validating admission policy is scaffolded", func() {
+				res := Resource{Webhooks: &Webhooks{ValidatingAdmissionPolicy: true}}
+				Expect(res.HasValidatingAdmissionPolicy()).To(BeTrue())
+				Expect(res.HasValidationWebhook()).To(BeFalse())
+			})
+
+			DescribeTable("should return false if the validating admission policy is not scaffolded",
+				func(res Resource) { Expect(res.HasValidatingAdmissionPolicy()).To(BeFalse()) },
+				Entry("nil webhooks", Resource{Webhooks: nil}),
+				Entry("validation webhook", Resource{Webhooks: &Webhooks{Validation: true}}),
+			)
+		})
+
 		Context("HasConversionWebhook", func() {
 			It("should return true if the conversion webhook is scaffolded", func() {
 				Expect(Resource{Webhooks: &Webhooks{Conversion: true}}.HasConversionWebhook()).To(BeTrue())
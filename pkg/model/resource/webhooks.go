@@ -19,6 +19,20 @@ package resource
 import (
 	"fmt"
 	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// FailurePolicyFail rejects the request when the webhook cannot be called
+	FailurePolicyFail = "Fail"
+	// FailurePolicyIgnore lets the request through when the webhook cannot be called
+	FailurePolicyIgnore = "Ignore"
+
+	// SideEffectsNone declares that the webhook has no side effects
+	SideEffectsNone = "None"
+	// SideEffectsNoneOnDryRun declares that the webhook skips its side effects for dry-run requests
+	SideEffectsNoneOnDryRun = "NoneOnDryRun"
 )
 
 // Webhooks contains information about scaffolded webhooks
@@ -32,6 +46,10 @@ type Webhooks struct {
 	// Validation specifies if a validation webhook is associated to the resource.
 	Validation bool `json:"validation,omitempty"`
 
+	// ValidatingAdmissionPolicy specifies if the resource is validated by a ValidatingAdmissionPolicy,
+	// whose CEL expressions are evaluated by the API server, instead of a validation webhook.
+	ValidatingAdmissionPolicy bool `json:"validatingAdmissionPolicy,omitempty"`
+
 	// Conversion specifies if a conversion webhook is associated to the resource.
 	Conversion bool `json:"conversion,omitempty"`
 
@@ -44,6 +62,21 @@ type Webhooks struct {
 	// ValidationPath holds the custom path for the validation webhook.
 	// This path is used in the +kubebuilder:webhook marker annotation.
 	ValidationPath string `json:"validationPath,omitempty"`
+
+	// FailurePolicy holds how errors calling the webhooks are handled, either Fail (default) or Ignore.
+	// It is used in the +kubebuilder:webhook marker annotations and in the ValidatingAdmissionPolicy.
+	FailurePolicy string `json:"failurePolicy,omitempty"`
+
+	// SideEffects holds the side effects of the webhooks, either None (default) or NoneOnDryRun.
+	// It is used in the +kubebuilder:webhook marker annotations.
+	SideEffects string `json:"sideEffects,omitempty"`
+
+	// NamespaceSelector holds a label selector, e.g. "env in (dev,prod)", that restricts the webhooks
+	// and the ValidatingAdmissionPolicy to the objects of the namespaces it matches.
+	NamespaceSelector string `json:"namespaceSelector,omitempty"`
+
+	// ObjectSelector holds a label selector that restricts the webhooks to the objects it matches.
+	ObjectSelector string `json:"objectSelector,omitempty"`
 }
 
 // Validate checks that the Webhooks is valid.
@@ -62,6 +95,32 @@ func (webhooks Webhooks) Validate() error {
 		seen[version] = true
 	}
 
+	if webhooks.Validation && webhooks.ValidatingAdmissionPolicy {
+		return fmt.Errorf("a resource cannot be validated by both a validation webhook " +
+			"and a ValidatingAdmissionPolicy")
+	}
+
+	switch webhooks.FailurePolicy {
+	case "", FailurePolicyFail, FailurePolicyIgnore:
+	default:
+		return fmt.Errorf("invalid failure policy %q: must be %s or %s",
+			webhooks.FailurePolicy, FailurePolicyFail, FailurePolicyIgnore)
+	}
+
+	switch webhooks.SideEffects {
+	case "", SideEffectsNone, SideEffectsNoneOnDryRun:
+	default:
+		return fmt.Errorf("invalid side effects %q: must be %s or %s",
+			webhooks.SideEffects, SideEffectsNone, SideEffectsNoneOnDryRun)
+	}
+
+	if _, err := metav1.ParseToLabelSelector(webhooks.NamespaceSelector); err != nil {
+		return fmt.Errorf("invalid namespace selector %q: %w", webhooks.NamespaceSelector, err)
+	}
+	if _, err := metav1.ParseToLabelSelector(webhooks.ObjectSelector); err != nil {
+		return fmt.Errorf("invalid object selector %q: %w", webhooks.ObjectSelector, err)
+	}
+
 	return nil
 }
 
@@ -77,13 +136,18 @@ func (webhooks Webhooks) Copy() Webhooks {
 	}
 
 	return Webhooks{
-		WebhookVersion: webhooks.WebhookVersion,
-		Defaulting:     webhooks.Defaulting,
-		Validation:     webhooks.Validation,
-		Conversion:     webhooks.Conversion,
-		Spoke:          spokeCopy,
-		DefaultingPath: webhooks.DefaultingPath,
-		ValidationPath: webhooks.ValidationPath,
+		WebhookVersion:            webhooks.WebhookVersion,
+		Defaulting:                webhooks.Defaulting,
+		Validation:                webhooks.Validation,
+		ValidatingAdmissionPolicy: webhooks.ValidatingAdmissionPolicy,
+		Conversion:                webhooks.Conversion,
+		Spoke:                     spokeCopy,
+		DefaultingPath:            webhooks.DefaultingPath,
+		ValidationPath:            webhooks.ValidationPath,
+		FailurePolicy:             webhooks.FailurePolicy,
+		SideEffects:               webhooks.SideEffects,
+		NamespaceSelector:         webhooks.NamespaceSelector,
+		ObjectSelector:            webhooks.ObjectSelector,
 	}
 }
 
@@ -109,6 +173,9 @@ func (webhooks *Webhooks) Update(other *Webhooks) error {
 	// Update validation.
 	webhooks.Validation = webhooks.Validation || other.Validation
 
+	// Update the validating admission policy.
+	webhooks.ValidatingAdmissionPolicy = webhooks.ValidatingAdmissionPolicy || other.ValidatingAdmissionPolicy
+
 	// Update conversion.
 	webhooks.Conversion = webhooks.Conversion || other.Conversion
 
@@ -133,6 +200,20 @@ func (webhooks *Webhooks) Update(other *Webhooks) error {
 		webhooks.ValidationPath = other.ValidationPath
 	}
 
+	// Update admission settings (other takes precedence if not empty)
+	if other.FailurePolicy != "" {
+		webhooks.FailurePolicy = other.FailurePolicy
+	}
+	if other.SideEffects != "" {
+		webhooks.SideEffects = other.SideEffects
+	}
+	if other.NamespaceSelector != "" {
+		webhooks.NamespaceSelector = other.NamespaceSelector
+	}
+	if other.ObjectSelector != "" {
+		webhooks.ObjectSelector = other.ObjectSelector
+	}
+
 	return nil
 }
 
@@ -140,8 +221,15 @@ func (webhooks *Webhooks) Update(other *Webhooks) error {
 func (webhooks Webhooks) IsEmpty() bool {
 	return webhooks.WebhookVersion == "" &&
 		!webhooks.Defaulting && !webhooks.Validation &&
-		!webhooks.Conversion && len(webhooks.Spoke) == 0 &&
-		webhooks.DefaultingPath == "" && webhooks.ValidationPath == ""
+		!webhooks.ValidatingAdmissionPolicy && !webhooks.Conversion && len(webhooks.Spoke) == 0 &&
+		webhooks.DefaultingPath == "" && webhooks.ValidationPath == "" &&
+		webhooks.FailurePolicy == "" && webhooks.SideEffects == "" &&
+		webhooks.NamespaceSelector == "" && webhooks.ObjectSelector == ""
+}
+
+// HasSelectors returns true if the webhooks are restricted with a namespace or an object selector.
+func (webhooks Webhooks) HasSelectors() bool {
+	return webhooks.NamespaceSelector != "" || webhooks.ObjectSelector != ""
 }
 
 // AddSpoke adds a new spoke version to the Webhooks configuration.
@@ -28,6 +28,16 @@ var _ = Describe("Webhooks", func() {
 			Expect(Webhooks{WebhookVersion: v1}.Validate()).To(Succeed())
 		})
 
+		It("should succeed for valid Webhooks with admission settings", func() {
+			Expect(Webhooks{
+				WebhookVersion:    v1,
+				FailurePolicy:     FailurePolicyIgnore,
+				SideEffects:       SideEffectsNoneOnDryRun,
+				NamespaceSelector: "kubernetes.io/metadata.name notin (kube-system)",
+				ObjectSelector:    "app in (web,api)",
+			}.Validate()).To(Succeed())
+		})
+
 		It("should succeed for valid Webhooks with unique spoke versions", func() {
 			Expect(Webhooks{WebhookVersion: v1, Spoke: []string{"v1", "v2", "v3"}}.Validate()).To(Succeed())
 		})
@@ -38,6 +48,12 @@ var _ = Describe("Webhooks", func() {
 			Entry("empty webhook version", Webhooks{}),
 			Entry("invalid webhook version", Webhooks{WebhookVersion: "1"}),
 			Entry("duplicate spoke versions", Webhooks{WebhookVersion: v1, Spoke: []string{"v1", "v2", "v1"}}),
+			Entry("invalid failure policy", Webhooks{WebhookVersion: v1, FailurePolicy: "Retry"}),
+			Entry("invalid side effects", Webhooks{WebhookVersion: v1, SideEffects: "Some"}),
+			Entry("invalid namespace selector", Webhooks{WebhookVersion: v1, NamespaceSelector: "env in prod"}),
+			Entry("invalid object selector", Webhooks{WebhookVersion: v1, ObjectSelector: "!"}),
+			Entry("validation webhook and policy",
+				Webhooks{WebhookVersion: v1, Validation: true, ValidatingAdmissionPolicy: true}),
 		)
 	})
 
@@ -167,6 +183,23 @@ var _ = Describe("Webhooks", func() {
 			})
 		})
 
+		Context("ValidatingAdmissionPolicy", func() {
+			It("should set the validating admission policy if provided and not previously set", func() {
+				webhook = Webhooks{}
+				other = Webhooks{ValidatingAdmissionPolicy: true}
+				Expect(webhook.Update(&other)).To(Succeed())
+				Expect(webhook.ValidatingAdmissionPolicy).To(BeTrue())
+			})
+
+			It("should keep the validating admission policy if previously set", func() {
+				webhook = Webhooks{ValidatingAdmissionPolicy: true}
+				other = Webhooks{Defaulting: true}
+				Expect(webhook.Update(&other)).To(Succeed())
+				Expect(webhook.ValidatingAdmissionPolicy).To(BeTrue())
+				Expect(webhook.Defaulting).To(BeTrue())
+			})
+		})
+
 		Context("Conversion", func() {
 			It("should set the conversion webhook if provided and not previously set", func() {
 				webhook = Webhooks{}
@@ -226,6 +259,22 @@ var _ = Describe("Webhooks", func() {
 				Expect(webhook.ValidationPath).To(Equal("/new-path"))
 			})
 		})
+
+		Context("Admission settings", func() {
+			It("should set the admission settings if provided", func() {
+				webhook = Webhooks{FailurePolicy: FailurePolicyFail, NamespaceSelector: "env=dev"}
+				other = Webhooks{
+					FailurePolicy:  FailurePolicyIgnore,
+					SideEffects:    SideEffectsNoneOnDryRun,
+					ObjectSelector: "app=web",
+				}
+				Expect(webhook.Update(&other)).To(Succeed())
+				Expect(webhook.FailurePolicy).To(Equal(FailurePolicyIgnore))
+				Expect(webhook.SideEffects).To(Equal(SideEffectsNoneOnDryRun))
+				Expect(webhook.NamespaceSelector).To(Equal("env=dev"))
+				Expect(webhook.ObjectSelector).To(Equal("app=web"))
+			})
+		})
 	})
 
 	Context("IsEmpty", func() {
@@ -292,6 +341,14 @@ var _ = Describe("Webhooks", func() {
 			Expect(none.IsEmpty()).To(BeTrue())
 		})
 
+		It("should return false for an object with only a validating admission policy", func() {
+			Expect(Webhooks{ValidatingAdmissionPolicy: true}.IsEmpty()).To(BeFalse())
+		})
+
+		It("should return false for an object with only a selector", func() {
+			Expect(Webhooks{ObjectSelector: "app=web"}.IsEmpty()).To(BeFalse())
+		})
+
 		DescribeTable("should return false for non-empty objects",
 			func(get func() Webhooks) {
 				Expect(get().IsEmpty()).To(BeFalse())
@@ -326,23 +383,33 @@ var _ = Describe("Webhooks", func() {
 	Context("Copy", func() {
 		It("should return an exact copy", func() {
 			webhook := Webhooks{
-				WebhookVersion: v1,
-				Defaulting:     true,
-				Validation:     true,
-				Conversion:     true,
-				Spoke:          []string{"v1", "v2"},
-				DefaultingPath: "/custom-defaulting",
-				ValidationPath: "/custom-validation",
+				WebhookVersion:            v1,
+				Defaulting:                true,
+				Validation:                true,
+				ValidatingAdmissionPolicy: true,
+				Conversion:                true,
+				Spoke:                     []string{"v1", "v2"},
+				DefaultingPath:            "/custom-defaulting",
+				ValidationPath:            "/custom-validation",
+				FailurePolicy:             FailurePolicyIgnore,
+				SideEffects:               SideEffectsNoneOnDryRun,
+				NamespaceSelector:         "env=dev",
+				ObjectSelector:            "app=web",
 			}
 			other := webhook.Copy()
 
 			Expect(other.WebhookVersion).To(Equal(webhook.WebhookVersion))
 			Expect(other.Defaulting).To(Equal(webhook.Defaulting))
 			Expect(other.Validation).To(Equal(webhook.Validation))
+			Expect(other.ValidatingAdmissionPolicy).To(Equal(webhook.ValidatingAdmissionPolicy))
 			Expect(other.Conversion).To(Equal(webhook.Conversion))
 			Expect(other.Spoke).To(Equal(webhook.Spoke))
 			Expect(other.DefaultingPath).To(Equal(webhook.DefaultingPath))
 			Expect(other.ValidationPath).To(Equal(webhook.ValidationPath))
+			Expect(other.FailurePolicy).To(Equal(webhook.FailurePolicy))
+			Expect(other.SideEffects).To(Equal(webhook.SideEffects))
+			Expect(other.NamespaceSelector).To(Equal(webhook.NamespaceSelector))
+			Expect(other.ObjectSelector).To(Equal(webhook.ObjectSelector))
 		})
 
 		It("modifying the copy should not affect the original", func() {
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// OrderChain validates the requirements and conflicts of the plugins in chain, and returns them ordered so that
+// every plugin runs after the plugins it requires, keeping the provided order otherwise.
+// The scaffolded plugins, i.e. those the project was scaffolded with, satisfy requirements and are checked for
+// conflicts, but are not ordered as they already ran.
+func OrderChain(chain, scaffolded []Plugin) ([]Plugin, error) {
+	requirements, err := checkRequirements(chain, scaffolded)
+	if err != nil {
+		return nil, err
+	}
+	if err = checkConflicts(chain, scaffolded); err != nil {
+		return nil, err
+	}
+
+	ordered := make([]Plugin, 0, len(chain))
+	placed := make([]bool, len(chain))
+	for len(ordered) < len(chain) {
+		next := -1
+		for i := range chain {
+			if !placed[i] && allPlaced(requirements[i], placed) {
+				next = i
+				break
+			}
+		}
+
+		if next == -1 {
+			cycle := make([]string, 0, len(chain))
+			for i, p := range chain {
+				if !placed[i] {
+					cycle = append(cycle, fmt.Sprintf("%q", KeyFor(p)))
+				}
+			}
+			return nil, fmt.Errorf("plugins %s have circular requirements", strings.Join(cycle, ", "))
+		}
+
+		placed[next] = true
+		ordered = append(ordered, chain[next])
+	}
+
+	return ordered, nil
+}
+
+// checkRequirements returns, for each plugin of the chain, the indexes of the other plugins of the chain it requires.
+// It fails if any requirement is satisfied neither by the chain nor by the scaffolded plugins.
+func checkRequirements(chain, scaffolded []Plugin) ([][]int, error) {
+	var errs []error
+	requirements := make([][]int, len(chain))
+	for i, p := range chain {
+		for _, member := range unbundle(p) {
+			dependent, isDependent := member.(Dependent)
+			if !isDependent {
+				continue
+			}
+
+			for _, key := range dependent.Requires() {
+				indexes, err := matchingPlugins(chain, key)
+				if err != nil {
+					return nil, fmt.Errorf("plugin %q has an invalid requirement: %w", KeyFor(member), err)
+				}
+				found, _ := matchingPlugins(scaffolded, key)
+				if len(indexes) == 0 && len(found) == 0 {
+					errs = append(errs, fmt.Errorf("plugin %q requires %q, which must be part of the plugin chain "+
+						"before it or of the one the project was scaffolded with", KeyFor(member), key))
+				}
+
+				for _, j := range indexes {
+					// Plugins in the same bundle are already ordered by the bundle
+					if j != i {
+						requirements[i] = append(requirements[i], j)
+					}
+				}
+			}
+		}
+	}
+
+	return requirements, errors.Join(errs...)
+}
+
+// checkConflicts fails if any plugin of the chain conflicts with another plugin of the chain or a scaffolded plugin.
+func checkConflicts(chain, scaffolded []Plugin) error {
+	var errs []error
+	reported := make(map[string]struct{})
+	report := func(p, other Plugin) {
+		pair := []string{KeyFor(p), KeyFor(other)}
+		if pair[0] > pair[1] {
+			pair[0], pair[1] = pair[1], pair[0]
+		}
+		if _, found := reported[pair[0]+","+pair[1]]; found {
+			return
+		}
+		reported[pair[0]+","+pair[1]] = struct{}{}
+		errs = append(errs, fmt.Errorf("plugins %q and %q cannot be used together", pair[0], pair[1]))
+	}
+
+	plugins := append(append(make([]Plugin, 0, len(chain)+len(scaffolded)), chain...), scaffolded...)
+	for i, p := range plugins {
+		for _, member := range unbundle(p) {
+			conflicting, isConflicting := member.(Conflicting)
+			if !isConflicting {
+				continue
+			}
+
+			for _, key := range conflicting.ConflictsWith() {
+				indexes, err := matchingPlugins(plugins, key)
+				if err != nil {
+					return fmt.Errorf("plugin %q has an invalid conflict: %w", KeyFor(member), err)
+				}
+				for _, j := range indexes {
+					// The chain may include the scaffolded plugins
+					if KeyFor(plugins[j]) != KeyFor(p) && (i < len(chain) || j < len(chain)) {
+						report(p, plugins[j])
+					}
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// matchingPlugins returns the indexes of the plugins that match key, or that bundle a plugin that matches it.
+func matchingPlugins(plugins []Plugin, key string) ([]int, error) {
+	var indexes []int
+	for i, p := range plugins {
+		matching, err := FilterPluginsByKey(unbundle(p), key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid plugin key %q: %w", key, err)
+		}
+		if len(matching) > 0 {
+			indexes = append(indexes, i)
+		}
+	}
+
+	return indexes, nil
+}
+
+// unbundle returns the plugins of p if it is a bundle, or p itself otherwise.
+func unbundle(p Plugin) []Plugin {
+	if bundle, isBundle := p.(Bundle); isBundle {
+		return append([]Plugin{p}, bundle.Plugins()...)
+	}
+	return []Plugin{p}
+}
+
+// allPlaced checks if the plugins at the provided indexes were placed.
+func allPlaced(indexes []int, placed []bool) bool {
+	for _, i := range indexes {
+		if !placed[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+)
+
+type mockConstrainedPlugin struct {
+	mockPlugin
+	requires      []string
+	conflictsWith []string
+}
+
+func (p mockConstrainedPlugin) Requires() []string      { return p.requires }
+func (p mockConstrainedPlugin) ConflictsWith() []string { return p.conflictsWith }
+
+var _ = Describe("OrderChain", func() {
+	newPlugin := func(name string, requires, conflictsWith []string) Plugin {
+		return mockConstrainedPlugin{
+			mockPlugin: mockPlugin{
+				name:                     name,
+				version:                  Version{Number: 1},
+				supportedProjectVersions: []config.Version{{Number: 3}},
+			},
+			requires:      requires,
+			conflictsWith: conflictsWith,
+		}
+	}
+
+	keysOf := func(plugins []Plugin) []string {
+		keys := make([]string, 0, len(plugins))
+		for _, p := range plugins {
+			keys = append(keys, KeyFor(p))
+		}
+		return keys
+	}
+
+	var (
+		base     Plugin
+		manifest Plugin
+		chart    Plugin
+	)
+
+	BeforeEach(func() {
+		base = newPlugin("base.example.com", nil, nil)
+		manifest = newPlugin("manifests.example.com", []string{"base.example.com/v1"}, nil)
+		chart = newPlugin("chart.example.com", []string{"manifests.example.com"}, []string{"legacy-chart.example.com"})
+	})
+
+	It("should keep the order of a valid chain", func() {
+		ordered, err := OrderChain([]Plugin{base, manifest, chart}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keysOf(ordered)).To(Equal(
+			[]string{"base.example.com/v1", "manifests.example.com/v1", "chart.example.com/v1"}))
+	})
+
+	It("should order the plugins after the plugins they require", func() {
+		ordered, err := OrderChain([]Plugin{chart, manifest, base}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keysOf(ordered)).To(Equal(
+			[]string{"base.example.com/v1", "manifests.example.com/v1", "chart.example.com/v1"}))
+	})
+
+	It("should match the requirements with the bundled plugins", func() {
+		bundle, err := NewBundleWithOptions(WithName("bundle.example.com"), WithVersion(Version{Number: 1}),
+			WithPlugins(base, manifest))
+		Expect(err).NotTo(HaveOccurred())
+
+		ordered, err := OrderChain([]Plugin{chart, bundle}, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keysOf(ordered)).To(Equal([]string{"bundle.example.com/v1", "chart.example.com/v1"}))
+	})
+
+	It("should accept requirements satisfied by the scaffolded plugins", func() {
+		ordered, err := OrderChain([]Plugin{chart}, []Plugin{base, manifest})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(keysOf(ordered)).To(Equal([]string{"chart.example.com/v1"}))
+	})
+
+	It("should fail if a required plugin is missing", func() {
+		_, err := OrderChain([]Plugin{chart, base}, nil)
+		Expect(err).To(MatchError(ContainSubstring(`plugin "chart.example.com/v1" requires "manifests.example.com"`)))
+	})
+
+	It("should fail if plugins have circular requirements", func() {
+		first := newPlugin("first.example.com", []string{"second.example.com"}, nil)
+		second := newPlugin("second.example.com", []string{"first.example.com"}, nil)
+
+		_, err := OrderChain([]Plugin{base, first, second}, nil)
+		Expect(err).To(MatchError(
+			`plugins "first.example.com/v1", "second.example.com/v1" have circular requirements`))
+	})
+
+	It("should fail if a plugin conflicts with another plugin of the chain", func() {
+		legacy := newPlugin("legacy-chart.example.com", nil, []string{"chart.example.com"})
+
+		_, err := OrderChain([]Plugin{base, manifest, chart, legacy}, nil)
+		Expect(err).To(MatchError(
+			`plugins "chart.example.com/v1" and "legacy-chart.example.com/v1" cannot be used together`))
+	})
+
+	It("should fail if a plugin conflicts with a scaffolded plugin", func() {
+		legacy := newPlugin("legacy-chart.example.com", nil, nil)
+
+		_, err := OrderChain([]Plugin{chart}, []Plugin{base, manifest, legacy})
+		Expect(err).To(MatchError(ContainSubstring("cannot be used together")))
+	})
+
+	It("should fail if a requirement is not a valid plugin key", func() {
+		invalid := newPlugin("invalid.example.com", []string{"base.example.com/v1.0"}, nil)
+
+		_, err := OrderChain([]Plugin{base, invalid}, nil)
+		Expect(err).To(MatchError(ContainSubstring(`plugin "invalid.example.com/v1" has an invalid requirement`)))
+	})
+})
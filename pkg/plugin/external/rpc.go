@@ -0,0 +1,214 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+const (
+	// APIVersionV1Alpha1 is the one-shot protocol: Kubebuilder runs the plugin for each request,
+	// writing a PluginRequest to its stdin and reading a PluginResponse from its stdout.
+	APIVersionV1Alpha1 = "v1alpha1"
+
+	// APIVersionRPCV1Alpha1 is the long-lived protocol: Kubebuilder runs the plugin once and calls
+	// its methods with JSON-RPC 2.0 messages, one per line, over its stdin and stdout.
+	// The params of the calls are a PluginRequest and their result a PluginResponse.
+	// It is negotiated with the one-shot metadata request, which lists it in its APIVersions:
+	// plugins supporting it answer with it as APIVersion.
+	APIVersionRPCV1Alpha1 = "rpc/v1alpha1"
+
+	// CommandPreScaffold and CommandPostScaffold are the commands of the one-shot requests of the
+	// pre-scaffold and post-scaffold phases, with the subcommand in Subcommand. They also name the
+	// phases in the Phases answered with the metadata.
+	CommandPreScaffold  = "pre-scaffold"
+	CommandPostScaffold = "post-scaffold"
+)
+
+// Methods of the APIVersionRPCV1Alpha1 protocol. Kubebuilder requests the metadata with the one-shot
+// protocol to negotiate it. PreScaffold and PostScaffold are optional: plugins that do not implement them
+// leave them out of their Phases or answer with RPCCodeMethodNotFound.
+const (
+	MethodMetadata     = "metadata"
+	MethodFlags        = "flags"
+	MethodPreScaffold  = "preScaffold"
+	MethodScaffold     = "scaffold"
+	MethodPostScaffold = "postScaffold"
+)
+
+// JSON-RPC 2.0 error codes
+const (
+	RPCCodeParseError     = -32700
+	RPCCodeInvalidRequest = -32600
+	RPCCodeMethodNotFound = -32601
+	RPCCodeInternalError  = -32603
+)
+
+// rpcVersion is the version of JSON-RPC of the messages
+const rpcVersion = "2.0"
+
+// RPCRequest is a JSON-RPC call of a method of the plugin. Requests without ID are notifications,
+// which are not answered.
+type RPCRequest struct {
+	JSONRPC string         `json:"jsonrpc"`
+	ID      *int64         `json:"id,omitempty"`
+	Method  string         `json:"method"`
+	Params  *PluginRequest `json:"params,omitempty"`
+}
+
+// RPCResponse is the answer of the plugin to a JSON-RPC call, with either a result or an error.
+type RPCResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      *int64          `json:"id"`
+	Result  *PluginResponse `json:"result,omitempty"`
+	Error   *RPCError       `json:"error,omitempty"`
+}
+
+// RPCError is the error of a JSON-RPC call
+type RPCError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Error implements error
+func (e *RPCError) Error() string {
+	return fmt.Sprintf("%s (code %d)", e.Message, e.Code)
+}
+
+// NewRPCRequest returns the call of the method with the request
+func NewRPCRequest(id int64, method string, req PluginRequest) RPCRequest {
+	return RPCRequest{JSONRPC: rpcVersion, ID: &id, Method: method, Params: &req}
+}
+
+// Handler handles a request of Kubebuilder for a method of the APIVersionRPCV1Alpha1 protocol
+type Handler func(PluginRequest) PluginResponse
+
+// Serve answers the requests read from in with the handlers keyed by method, writing the responses to out.
+// It supports both protocols, detected from the first message: with APIVersionV1Alpha1, it answers the
+// single PluginRequest using the handler of the method matching its command, i.e. MethodScaffold for
+// the subcommands; with APIVersionRPCV1Alpha1, it answers the calls until in is closed.
+// The one-shot metadata request is answered with APIVersionRPCV1Alpha1 when Kubebuilder supports it,
+// and with the phases that have a handler.
+// Plugins written in Go can call it with os.Stdin and os.Stdout from their main function.
+func Serve(in io.Reader, out io.Writer, handlers map[string]Handler) error {
+	dec := json.NewDecoder(in)
+	enc := json.NewEncoder(out)
+
+	var msg json.RawMessage
+	if err := dec.Decode(&msg); err != nil {
+		return fmt.Errorf("error reading the request: %w", err)
+	}
+
+	var probe struct {
+		JSONRPC string `json:"jsonrpc"`
+	}
+	if err := json.Unmarshal(msg, &probe); err != nil {
+		return fmt.Errorf("error unmarshalling the request: %w", err)
+	}
+	if probe.JSONRPC == "" {
+		var req PluginRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			return fmt.Errorf("error unmarshalling the request: %w", err)
+		}
+		if err := enc.Encode(serveOneShot(req, handlers)); err != nil {
+			return fmt.Errorf("error writing the response: %w", err)
+		}
+		return nil
+	}
+
+	for {
+		if res := serveRPC(msg, handlers); res != nil {
+			if err := enc.Encode(res); err != nil {
+				return fmt.Errorf("error writing the response: %w", err)
+			}
+		}
+
+		msg = nil
+		if err := dec.Decode(&msg); errors.Is(err, io.EOF) {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("error reading the request: %w", err)
+		}
+	}
+}
+
+// serveOneShot answers a request of the APIVersionV1Alpha1 protocol
+func serveOneShot(req PluginRequest, handlers map[string]Handler) PluginResponse {
+	var method string
+	switch req.Command {
+	case MethodMetadata, MethodFlags:
+		method = req.Command
+	case CommandPreScaffold:
+		method = MethodPreScaffold
+	case CommandPostScaffold:
+		method = MethodPostScaffold
+	default:
+		method = MethodScaffold
+	}
+	handler, found := handlers[method]
+	if !found {
+		return PluginResponse{
+			APIVersion: APIVersionV1Alpha1,
+			Command:    req.Command,
+			Error:      true,
+			ErrorMsgs:  []string{"unknown command: " + req.Command},
+		}
+	}
+	res := handler(req)
+	if req.Command == MethodMetadata {
+		res.APIVersion = APIVersionV1Alpha1
+		if slices.Contains(req.APIVersions, APIVersionRPCV1Alpha1) {
+			res.APIVersion = APIVersionRPCV1Alpha1
+		}
+		if _, found := handlers[MethodPreScaffold]; found {
+			res.Phases = append(res.Phases, CommandPreScaffold)
+		}
+		if _, found := handlers[MethodPostScaffold]; found {
+			res.Phases = append(res.Phases, CommandPostScaffold)
+		}
+	}
+	return res
+}
+
+// serveRPC answers a call of the APIVersionRPCV1Alpha1 protocol, returning nil for the notifications
+func serveRPC(msg json.RawMessage, handlers map[string]Handler) *RPCResponse {
+	var req RPCRequest
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return &RPCResponse{JSONRPC: rpcVersion, Error: &RPCError{Code: RPCCodeParseError, Message: err.Error()}}
+	}
+
+	res := &RPCResponse{JSONRPC: rpcVersion, ID: req.ID}
+	handler, found := handlers[req.Method]
+	switch {
+	case req.JSONRPC != rpcVersion || req.Params == nil:
+		res.Error = &RPCError{Code: RPCCodeInvalidRequest, Message: "invalid request"}
+	case !found:
+		res.Error = &RPCError{Code: RPCCodeMethodNotFound, Message: "method not found: " + req.Method}
+	default:
+		result := handler(*req.Params)
+		res.Result = &result
+	}
+
+	if req.ID == nil {
+		return nil
+	}
+	return res
+}
@@ -16,7 +16,10 @@ limitations under the License.
 
 package external
 
-import "sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
 
 // PluginRequest contains all information kubebuilder received from the CLI
 // and plugins executed before it.
@@ -32,6 +35,10 @@ type PluginRequest struct {
 	// Command contains the command to be executed by the plugin such as init, create api, etc.
 	Command string `json:"command"`
 
+	// Subcommand contains the subcommand being run, such as create api, for the pre-scaffold
+	// and post-scaffold commands.
+	Subcommand string `json:"subcommand,omitempty"`
+
 	// Universe represents the modified file contents that gets updated over a series of plugin runs
 	// across the plugin chain. Initially, it starts out as empty.
 	Universe map[string]string `json:"universe"`
@@ -44,6 +51,14 @@ type PluginRequest struct {
 	// Config contains the PROJECT file config. This field may be empty if the
 	// project is being initialized and the PROJECT file has not been created yet.
 	Config map[string]any `json:"config,omitempty"`
+
+	// Resource contains the resource of the create api, create webhook and delete webhook subcommands,
+	// resolved and validated by Kubebuilder and the plugins that ran before, e.g. with its plural and path.
+	Resource *resource.Resource `json:"resource,omitempty"`
+
+	// APIVersions lists the API versions supported by Kubebuilder. It is only sent with the one-shot
+	// metadata request, to which the plugin answers with the APIVersion of the protocol it chooses.
+	APIVersions []string `json:"apiVersions,omitempty"`
 }
 
 // PluginResponse is returned to kubebuilder by the plugin and contains all files
@@ -63,6 +78,10 @@ type PluginResponse struct {
 	// Universe in the PluginResponse represents the updated file contents that was written by the plugin.
 	Universe map[string]string `json:"universe"`
 
+	// Files contains the file operations of the plugin, applied in order after the files of Universe are
+	// written. Unlike Universe, they can delete and rename files, set their mode and write binary content.
+	Files []FileOperation `json:"files,omitempty"`
+
 	// Error is a boolean type that indicates whether there were any errors due to plugin failures.
 	Error bool `json:"error,omitempty"`
 
@@ -72,6 +91,10 @@ type PluginResponse struct {
 	// Flags contains the plugin specific flags that the plugin returns to Kubebuilder when it receives
 	// a request for a list of supported flags from Kubebuilder
 	Flags []Flag `json:"flags,omitempty"`
+
+	// Phases lists the optional phases implemented by the plugin, pre-scaffold and post-scaffold,
+	// which the plugin returns to Kubebuilder with the metadata.
+	Phases []string `json:"phases,omitempty"`
 }
 
 // Flag is meant to represent a CLI flag that is used by Kubebuilder to define flags that are parsed
@@ -94,3 +117,40 @@ type Flag struct {
 	// Usage is a description of the flag and when/why/what it is used for.
 	Usage string
 }
+
+// Operations of a FileOperation
+const (
+	// FileOpWrite writes Content to Path, creating its directory if needed. It is the default operation.
+	FileOpWrite = "write"
+	// FileOpDelete deletes the file or directory at Path, if it exists.
+	FileOpDelete = "delete"
+	// FileOpRename moves the file or directory at Path to NewPath.
+	FileOpRename = "rename"
+	// FileOpChmod sets the Mode of the file at Path.
+	FileOpChmod = "chmod"
+)
+
+// EncodingBase64 is the encoding of the Content of a FileOperation holding binary data
+const EncodingBase64 = "base64"
+
+// FileOperation is a change to a file of the project returned by an external plugin
+type FileOperation struct {
+	// Op is the operation: write (default), delete, rename or chmod.
+	Op string `json:"op,omitempty"`
+
+	// Path is the path of the file, relative to the root of the project.
+	Path string `json:"path"`
+
+	// NewPath is the path the file is moved to by the rename operation.
+	NewPath string `json:"newPath,omitempty"`
+
+	// Content is the content written by the write operation.
+	Content string `json:"content,omitempty"`
+
+	// Encoding is the encoding of Content: empty for text, or base64 for binary data.
+	Encoding string `json:"encoding,omitempty"`
+
+	// Mode is the octal permission of the file set by the write, rename and chmod operations,
+	// e.g. "0755" for executables. The write operation defaults to "0644" for new files.
+	Mode string `json:"mode,omitempty"`
+}
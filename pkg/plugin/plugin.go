@@ -48,31 +48,72 @@ type Describable interface {
 	Description() string
 }
 
+// Dependent is an optional interface for plugins that require other plugins in the plugin chain.
+type Dependent interface {
+	// Requires returns the keys of the plugins that must run before this plugin, either earlier in the plugin chain
+	// or when the project was scaffolded. Keys may be not fully qualified, e.g. "base.go.kubebuilder.io".
+	Requires() []string
+}
+
+// Conflicting is an optional interface for plugins that cannot be used together with other plugins.
+type Conflicting interface {
+	// ConflictsWith returns the keys of the plugins that cannot be part of the same plugin chain nor of the chain
+	// the project was scaffolded with. Keys may be not fully qualified, e.g. "helm.kubebuilder.io".
+	ConflictsWith() []string
+}
+
+// Configurable is an optional interface for plugins that store a configuration object in the project configuration.
+type Configurable interface {
+	// PluginConfig returns a zero value of the configuration object stored by the plugin under its key,
+	// which describes the object in the schema of the project configuration.
+	PluginConfig() any
+}
+
 // Init is an interface for plugins that provide an `init` subcommand.
 type Init interface {
 	Plugin
-	// GetInitSubcommand returns the underlying InitSubcommand interface.
+	// GetInitSubcommand returns the underlying InitSubcommand interface,
+	// or nil if the plugin does not support the subcommand.
 	GetInitSubcommand() InitSubcommand
 }
 
 // CreateAPI is an interface for plugins that provide a `create api` subcommand.
 type CreateAPI interface {
 	Plugin
-	// GetCreateAPISubcommand returns the underlying CreateAPISubcommand interface.
+	// GetCreateAPISubcommand returns the underlying CreateAPISubcommand interface,
+	// or nil if the plugin does not support the subcommand.
 	GetCreateAPISubcommand() CreateAPISubcommand
 }
 
 // CreateWebhook is an interface for plugins that provide a `create webhook` subcommand.
 type CreateWebhook interface {
 	Plugin
-	// GetCreateWebhookSubcommand returns the underlying CreateWebhookSubcommand interface.
+	// GetCreateWebhookSubcommand returns the underlying CreateWebhookSubcommand interface,
+	// or nil if the plugin does not support the subcommand.
 	GetCreateWebhookSubcommand() CreateWebhookSubcommand
 }
 
+// DeleteAPI is an interface for plugins that provide a `delete api` subcommand.
+type DeleteAPI interface {
+	Plugin
+	// GetDeleteAPISubcommand returns the underlying DeleteAPISubcommand interface,
+	// or nil if the plugin does not support the subcommand.
+	GetDeleteAPISubcommand() DeleteAPISubcommand
+}
+
+// DeleteWebhook is an interface for plugins that provide a `delete webhook` subcommand.
+type DeleteWebhook interface {
+	Plugin
+	// GetDeleteWebhookSubcommand returns the underlying DeleteWebhookSubcommand interface,
+	// or nil if the plugin does not support the subcommand.
+	GetDeleteWebhookSubcommand() DeleteWebhookSubcommand
+}
+
 // Edit is an interface for plugins that provide a `edit` subcommand.
 type Edit interface {
 	Plugin
-	// GetEditSubcommand returns the underlying EditSubcommand interface.
+	// GetEditSubcommand returns the underlying EditSubcommand interface,
+	// or nil if the plugin does not support the subcommand.
 	GetEditSubcommand() EditSubcommand
 }
 
@@ -0,0 +1,195 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugintest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// RunTest runs the script of the test directory into a temporary directory and compares the result to
+// the golden directory of the test, or replaces the golden directory with the result if update is set.
+// It returns the differences from the golden directory, where the golden files are the original content.
+func RunTest(runner Runner, testDir string, update bool) ([]machinery.FileChange, error) {
+	testDir, err := filepath.Abs(testDir)
+	if err != nil {
+		return nil, fmt.Errorf("error getting the absolute path of %q: %w", testDir, err)
+	}
+
+	fsys := machinery.Filesystem{FS: afero.NewOsFs()}
+	script, err := LoadScript(fsys.FS, filepath.Join(testDir, ScriptFileName))
+	if err != nil {
+		return nil, err
+	}
+
+	dir, err := os.MkdirTemp("", "kubebuilder-plugin-test-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	if err = Run(runner, *script, dir); err != nil {
+		return nil, err
+	}
+
+	golden := filepath.Join(testDir, GoldenDirName)
+	if update {
+		return nil, UpdateGolden(fsys, dir, golden, script.Ignore)
+	}
+	return Compare(fsys, dir, golden, script.Ignore)
+}
+
+// Test runs the test directory like RunTest, reporting each difference from the golden directory as a test error
+func Test(t testing.TB, runner Runner, testDir string, update bool) {
+	t.Helper()
+
+	changes, err := RunTest(runner, testDir, update)
+	if err != nil {
+		t.Fatalf("error running test %q: %v", testDir, err)
+	}
+	for _, change := range changes {
+		t.Errorf("%q differs from the golden directory:\n%s", change.Path, change.UnifiedDiff())
+	}
+}
+
+// Compare returns the differences between the files of dir and the golden directory, sorted by path.
+// The files matching the ignore patterns are skipped.
+func Compare(fsys machinery.Filesystem, dir, golden string, ignore []string) ([]machinery.FileChange, error) {
+	actual, err := readTree(fsys.FS, dir, ignore)
+	if err != nil {
+		return nil, err
+	}
+	expected, err := readTree(fsys.FS, golden, ignore)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(actual)+len(expected))
+	for p := range actual {
+		paths = append(paths, p)
+	}
+	for p := range expected {
+		if _, found := actual[p]; !found {
+			paths = append(paths, p)
+		}
+	}
+	slices.Sort(paths)
+
+	var changes []machinery.FileChange
+	for _, p := range paths {
+		before, inGolden := expected[p]
+		after, inDir := actual[p]
+		switch {
+		case !inGolden:
+			changes = append(changes, machinery.FileChange{Path: p, After: after, Created: true})
+		case !inDir:
+			changes = append(changes, machinery.FileChange{Path: p, Before: before, Removed: true})
+		case !bytes.Equal(before, after):
+			changes = append(changes, machinery.FileChange{Path: p, Before: before, After: after})
+		}
+	}
+
+	return changes, nil
+}
+
+// UpdateGolden replaces the content of the golden directory with the files of dir.
+// The files matching the ignore patterns are skipped.
+func UpdateGolden(fsys machinery.Filesystem, dir, golden string, ignore []string) error {
+	files, err := readTree(fsys.FS, dir, ignore)
+	if err != nil {
+		return err
+	}
+
+	if err = fsys.FS.RemoveAll(golden); err != nil {
+		return fmt.Errorf("error removing golden directory %q: %w", golden, err)
+	}
+	for p, content := range files {
+		target := filepath.Join(golden, filepath.FromSlash(p))
+		if err = fsys.FS.MkdirAll(filepath.Dir(target), machinery.DefaultDirectoryPermission); err != nil {
+			return fmt.Errorf("error creating directory for %q: %w", target, err)
+		}
+		if err = afero.WriteFile(fsys.FS, target, content, machinery.DefaultFilePermission); err != nil {
+			return fmt.Errorf("error writing %q: %w", target, err)
+		}
+	}
+
+	return nil
+}
+
+// readTree returns the content of the files in root keyed by their slash-separated path relative to root,
+// skipping the ones matching the ignore patterns. A missing root has no files.
+func readTree(fsys afero.Fs, root string, ignore []string) (map[string][]byte, error) {
+	files := map[string][]byte{}
+	if _, err := fsys.Stat(root); errors.Is(err, os.ErrNotExist) {
+		return files, nil
+	}
+
+	err := afero.Walk(fsys, root, func(p string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return fmt.Errorf("error walking %q: %w", p, err)
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return fmt.Errorf("error getting the path of %q relative to %q: %w", p, root, err)
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == "." {
+			return nil
+		}
+		if isIgnored(rel, ignore) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		content, err := afero.ReadFile(fsys, p)
+		if err != nil {
+			return fmt.Errorf("error reading %q: %w", p, err)
+		}
+		files[rel] = content
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %q: %w", root, err)
+	}
+
+	return files, nil
+}
+
+// isIgnored checks if the slash-separated path matches one of the ignore patterns
+func isIgnored(p string, ignore []string) bool {
+	return slices.ContainsFunc(ignore, func(pattern string) bool {
+		matched, _ := path.Match(strings.TrimSuffix(pattern, "/"), p)
+		return matched
+	})
+}
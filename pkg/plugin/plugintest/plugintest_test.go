@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugintest
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/cli"
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// samplePlugin writes a README on init and a file per API on create api
+type samplePlugin struct{}
+
+func (samplePlugin) Name() string            { return "sample.kubebuilder.io" }
+func (samplePlugin) Version() plugin.Version { return plugin.Version{Number: 1} }
+func (samplePlugin) SupportedProjectVersions() []config.Version {
+	return []config.Version{cfgv3.Version}
+}
+
+func (samplePlugin) GetInitSubcommand() plugin.InitSubcommand { return &sampleInitSubcommand{} }
+
+func (samplePlugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand {
+	return &sampleCreateAPISubcommand{}
+}
+
+type sampleInitSubcommand struct{}
+
+func (s *sampleInitSubcommand) Scaffold(fs machinery.Filesystem) error {
+	return afero.WriteFile(fs.FS, "README.md", []byte("# Sample\n"), 0o644)
+}
+
+type sampleCreateAPISubcommand struct {
+	resource *resource.Resource
+}
+
+func (s *sampleCreateAPISubcommand) InjectResource(res *resource.Resource) error {
+	s.resource = res
+	return nil
+}
+
+func (s *sampleCreateAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	path := filepath.Join("api", s.resource.Version, s.resource.Kind+".txt")
+	if err := fs.FS.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return afero.WriteFile(fs.FS, path, []byte(s.resource.Kind+"\n"), 0o644)
+}
+
+var _ = Describe("Plugin tests", func() {
+	const script = `plugins: ["sample/v1"]
+steps:
+- args: ["init"]
+- args: ["create", "api", "--group", "crew", "--version", "v1", "--kind", "Captain"]
+ignore: [".kubebuilder"]
+`
+
+	var (
+		testDir string
+		runner  Runner
+	)
+
+	BeforeEach(func() {
+		testDir = GinkgoT().TempDir()
+		Expect(os.WriteFile(filepath.Join(testDir, ScriptFileName), []byte(script), 0o644)).To(Succeed())
+
+		p := samplePlugin{}
+		runner = NewCLIRunner(
+			cli.WithPlugins(p),
+			cli.WithDefaultPlugins(cfgv3.Version, p),
+			cli.WithDefaultProjectVersion(cfgv3.Version),
+		)
+	})
+
+	It("should write the golden directory when updating and then match it", func() {
+		changes, err := RunTest(runner, testDir, true)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		golden := filepath.Join(testDir, GoldenDirName)
+		Expect(filepath.Join(golden, "README.md")).To(BeARegularFile())
+		Expect(filepath.Join(golden, "PROJECT")).To(BeARegularFile())
+		Expect(os.ReadFile(filepath.Join(golden, "api", "v1", "Captain.txt"))).To(BeEquivalentTo("Captain\n"))
+		Expect(filepath.Join(golden, ".kubebuilder")).NotTo(BeADirectory())
+
+		changes, err = RunTest(runner, testDir, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+	})
+
+	It("should report the differences from the golden directory", func() {
+		_, err := RunTest(runner, testDir, true)
+		Expect(err).NotTo(HaveOccurred())
+
+		golden := filepath.Join(testDir, GoldenDirName)
+		Expect(os.WriteFile(filepath.Join(golden, "README.md"), []byte("# Other\n"), 0o644)).To(Succeed())
+		Expect(os.Remove(filepath.Join(golden, "api", "v1", "Captain.txt"))).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(golden, "extra.txt"), []byte("extra\n"), 0o644)).To(Succeed())
+
+		changes, err := RunTest(runner, testDir, false)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(HaveLen(3))
+		Expect(changes[0].Path).To(Equal("README.md"))
+		Expect(changes[0].UnifiedDiff()).To(ContainSubstring("-# Other\n+# Sample\n"))
+		Expect(changes[1].Path).To(Equal("api/v1/Captain.txt"))
+		Expect(changes[1].Created).To(BeTrue())
+		Expect(changes[2].Path).To(Equal("extra.txt"))
+		Expect(changes[2].Removed).To(BeTrue())
+	})
+
+	It("should report the failing step", func() {
+		Expect(os.WriteFile(filepath.Join(testDir, ScriptFileName),
+			[]byte("steps:\n- args: [\"create\", \"api\"]\n"), 0o644)).To(Succeed())
+
+		_, err := RunTest(runner, testDir, false)
+		Expect(err).To(MatchError(ContainSubstring("step 1 failed")))
+	})
+
+	It("should return the output of a failed binary", func() {
+		err := NewExecRunner("/bin/sh").Run([]string{"-c", "echo some output; exit 1"})
+		Expect(err).To(MatchError(ContainSubstring("some output")))
+	})
+
+	DescribeTable("should reject invalid scripts",
+		func(content, message string) {
+			fs := afero.NewMemMapFs()
+			Expect(afero.WriteFile(fs, ScriptFileName, []byte(content), 0o644)).To(Succeed())
+
+			_, err := LoadScript(fs, ScriptFileName)
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("without steps", "plugins: [\"go/v4\"]\n", "no steps defined"),
+		Entry("with an empty step", "steps:\n- args: []\n", "step 1 has no arguments"),
+		Entry("with unknown fields", "step:\n- args: [\"init\"]\n", "failed to unmarshal script"),
+	)
+
+	It("should pass the plugin chain to the init steps", func() {
+		s := Script{Plugins: []string{"go/v4", "sample/v1"}}
+		Expect(s.args(Step{Args: []string{"init"}})).To(Equal([]string{"init", "--plugins", "go/v4,sample/v1"}))
+		Expect(s.args(Step{Args: []string{"init", "--plugins=go/v4"}})).To(Equal([]string{"init", "--plugins=go/v4"}))
+		Expect(s.args(Step{Args: []string{"edit"}})).To(Equal([]string{"edit"}))
+	})
+})
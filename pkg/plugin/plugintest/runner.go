@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugintest
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/cli"
+)
+
+// Runner runs a CLI invocation in the current directory
+type Runner interface {
+	// Run runs the CLI with the arguments
+	Run(args []string) error
+}
+
+type cliRunner struct {
+	options []cli.Option
+}
+
+// NewCLIRunner returns a Runner building a CLI with the options for each invocation.
+//
+// The invocations set os.Args, as the CLI reads them, so tests using this runner must not run in parallel.
+// As external plugins also read the arguments when discovered, use NewExecRunner to test them instead.
+func NewCLIRunner(options ...cli.Option) Runner {
+	return cliRunner{options: options}
+}
+
+func (r cliRunner) Run(args []string) error {
+	osArgs := os.Args
+	os.Args = append([]string{"kubebuilder"}, args...)
+	defer func() { os.Args = osArgs }()
+
+	c, err := cli.New(r.options...)
+	if err != nil {
+		return fmt.Errorf("error creating the CLI: %w", err)
+	}
+	if err = c.Run(); err != nil {
+		return fmt.Errorf("error running %q: %w", args, err)
+	}
+	return nil
+}
+
+type execRunner struct {
+	path string
+	env  []string
+}
+
+// NewExecRunner returns a Runner running the CLI binary at path, with the additional environment variables.
+// The output of a failed invocation is returned in its error.
+func NewExecRunner(path string, env ...string) Runner {
+	return execRunner{path: path, env: env}
+}
+
+func (r execRunner) Run(args []string) error {
+	cmd := exec.Command(r.path, args...) //nolint:gosec
+	cmd.Env = append(os.Environ(), r.env...)
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error running %q: %w\n%s", args, err, out.String())
+	}
+	return nil
+}
+
+// Run runs the steps of the script in dir, which is created if it does not exist
+func Run(runner Runner, script Script, dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating directory %q: %w", dir, err)
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("error getting current directory: %w", err)
+	}
+	if err = os.Chdir(dir); err != nil {
+		return fmt.Errorf("error changing to directory %q: %w", dir, err)
+	}
+	defer func() { _ = os.Chdir(wd) }()
+
+	for i, step := range script.Steps {
+		if err = runner.Run(script.args(step)); err != nil {
+			return fmt.Errorf("step %d failed: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
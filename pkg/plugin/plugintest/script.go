@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugintest provides helpers to test plugins by running a script of CLI invocations
+// into a directory and comparing the result to a golden directory.
+//
+// It serves both the plugins written in Go, run in-process by a CLI built with NewCLIRunner,
+// and the external plugins, run by a CLI binary with NewExecRunner.
+package plugintest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"sigs.k8s.io/yaml"
+)
+
+// ScriptFileName is the name of the script of a test directory
+const ScriptFileName = "script.yaml"
+
+// GoldenDirName is the name of the golden directory of a test directory
+const GoldenDirName = "golden"
+
+// Script is a sequence of CLI invocations run against a plugin chain
+type Script struct {
+	// Plugins is the plugin chain used to initialize the project, passed as --plugins to the init steps
+	Plugins []string `json:"plugins,omitempty"`
+	// Steps are the CLI invocations, run in order
+	Steps []Step `json:"steps"`
+	// Ignore are the patterns of the paths that are not compared to the golden directory, e.g. "bin".
+	// A pattern matching a directory ignores all its files.
+	Ignore []string `json:"ignore,omitempty"`
+}
+
+// Step is a CLI invocation
+type Step struct {
+	// Args are the arguments of the invocation, e.g. ["create", "api", "--group", "crew", "--kind", "Captain"]
+	Args []string `json:"args"`
+}
+
+// LoadScript reads the script at path
+func LoadScript(fs afero.Fs, path string) (*Script, error) {
+	in, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q file: %w", path, err)
+	}
+
+	s := &Script{}
+	if err = yaml.UnmarshalStrict(in, s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal script at %q: %w", path, err)
+	}
+	if err = s.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid script at %q: %w", path, err)
+	}
+
+	return s, nil
+}
+
+// Validate checks that the script can be run
+func (s Script) Validate() error {
+	if len(s.Steps) == 0 {
+		return errors.New("no steps defined")
+	}
+	for i, step := range s.Steps {
+		if len(step.Args) == 0 {
+			return fmt.Errorf("step %d has no arguments", i+1)
+		}
+	}
+	return nil
+}
+
+// args returns the arguments of the step, adding the plugin chain of the script to the init steps
+func (s Script) args(step Step) []string {
+	args := slices.Clone(step.Args)
+	if len(s.Plugins) == 0 || args[0] != "init" || slices.ContainsFunc(args, isPluginsFlag) {
+		return args
+	}
+	return append(args, "--plugins", strings.Join(s.Plugins, ","))
+}
+
+// isPluginsFlag checks if arg sets the --plugins flag
+func isPluginsFlag(arg string) bool {
+	return arg == "--plugins" || strings.HasPrefix(arg, "--plugins=")
+}
@@ -26,6 +26,10 @@ import (
 	"os"
 	"regexp"
 	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 )
 
 const (
@@ -33,6 +37,11 @@ const (
 	KubebuilderBinName = "kubebuilder"
 )
 
+// osFilesystem returns the filesystem of the disk
+func osFilesystem() machinery.Filesystem {
+	return machinery.Filesystem{FS: afero.NewOsFs()}
+}
+
 // RandomSuffix returns a 4-letter string.
 func RandomSuffix() (string, error) {
 	source := []rune("abcdefghijklmnopqrstuvwxyz")
@@ -62,10 +71,14 @@ func GetNonEmptyLines(output string) []string {
 	return res
 }
 
-// InsertCode searches target content in the file and insert `toInsert` after the target.
+// InsertCode calls InsertCodeInFS with the filesystem of the disk.
 func InsertCode(filename, target, code string) error {
-	//nolint:gosec // false positive
-	contents, err := os.ReadFile(filename)
+	return InsertCodeInFS(osFilesystem(), filename, target, code)
+}
+
+// InsertCodeInFS searches target content in the file and insert `toInsert` after the target.
+func InsertCodeInFS(fs machinery.Filesystem, filename, target, code string) error {
+	contents, err := afero.ReadFile(fs.FS, filename)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", filename, err)
 	}
@@ -74,18 +87,21 @@ func InsertCode(filename, target, code string) error {
 		return fmt.Errorf("string %s not found in %s", target, string(contents))
 	}
 	out := string(contents[:idx+len(target)]) + code + string(contents[idx+len(target):])
-	//nolint:gosec // false positive
-	if errWriteFile := os.WriteFile(filename, []byte(out), 0o644); errWriteFile != nil {
+	if errWriteFile := afero.WriteFile(fs.FS, filename, []byte(out), 0o644); errWriteFile != nil {
 		return fmt.Errorf("failed to write file %q: %w", filename, errWriteFile)
 	}
 
 	return nil
 }
 
-// InsertCodeIfNotExist insert code if it does not already exist
+// InsertCodeIfNotExist calls InsertCodeIfNotExistInFS with the filesystem of the disk.
 func InsertCodeIfNotExist(filename, target, code string) error {
-	//nolint:gosec // false positive
-	contents, err := os.ReadFile(filename)
+	return InsertCodeIfNotExistInFS(osFilesystem(), filename, target, code)
+}
+
+// InsertCodeIfNotExistInFS insert code if it does not already exist
+func InsertCodeIfNotExistInFS(fs machinery.Filesystem, filename, target, code string) error {
+	contents, err := afero.ReadFile(fs.FS, filename)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", filename, err)
 	}
@@ -95,12 +111,17 @@ func InsertCodeIfNotExist(filename, target, code string) error {
 		return nil
 	}
 
-	return InsertCode(filename, target, code)
+	return InsertCodeInFS(fs, filename, target, code)
 }
 
-// AppendCodeIfNotExist checks if the code does not already exist in the file, and if not, appends it to the end.
+// AppendCodeIfNotExist calls AppendCodeIfNotExistInFS with the filesystem of the disk.
 func AppendCodeIfNotExist(filename, code string) error {
-	contents, err := os.ReadFile(filename)
+	return AppendCodeIfNotExistInFS(osFilesystem(), filename, code)
+}
+
+// AppendCodeIfNotExistInFS checks if the code does not already exist in the file, and if not, appends it to the end.
+func AppendCodeIfNotExistInFS(fs machinery.Filesystem, filename, code string) error {
+	contents, err := afero.ReadFile(fs.FS, filename)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", filename, err)
 	}
@@ -109,12 +130,17 @@ func AppendCodeIfNotExist(filename, code string) error {
 		return nil // Code already exists, no need to append.
 	}
 
-	return AppendCodeAtTheEnd(filename, code)
+	return AppendCodeAtTheEndInFS(fs, filename, code)
 }
 
-// AppendCodeAtTheEnd appends the given code at the end of the file.
+// AppendCodeAtTheEnd calls AppendCodeAtTheEndInFS with the filesystem of the disk.
 func AppendCodeAtTheEnd(filename, code string) error {
-	f, err := os.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o644)
+	return AppendCodeAtTheEndInFS(osFilesystem(), filename, code)
+}
+
+// AppendCodeAtTheEndInFS appends the given code at the end of the file.
+func AppendCodeAtTheEndInFS(fs machinery.Filesystem, filename, code string) error {
+	f, err := fs.FS.OpenFile(filename, os.O_APPEND|os.O_WRONLY, 0o644)
 	if err != nil {
 		return fmt.Errorf("failed to open file %q: %w", filename, err)
 	}
@@ -131,11 +157,15 @@ func AppendCodeAtTheEnd(filename, code string) error {
 	return nil
 }
 
-// UncommentCode searches for target in the file and remove the comment prefix
-// of the target content. The target content may span multiple lines.
+// UncommentCode calls UncommentCodeInFS with the filesystem of the disk.
 func UncommentCode(filename, target, prefix string) error {
-	//nolint:gosec // false positive
-	content, err := os.ReadFile(filename)
+	return UncommentCodeInFS(osFilesystem(), filename, target, prefix)
+}
+
+// UncommentCodeInFS searches for target in the file and remove the comment prefix
+// of the target content. The target content may span multiple lines.
+func UncommentCodeInFS(fs machinery.Filesystem, filename, target, prefix string) error {
+	content, err := afero.ReadFile(fs.FS, filename)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", filename, err)
 	}
@@ -172,19 +202,23 @@ func UncommentCode(filename, target, prefix string) error {
 	if _, err = out.Write(content[idx+len(target):]); err != nil {
 		return fmt.Errorf("failed to write to file %q: %w", filename, err)
 	}
-	//nolint:gosec // false positive
-	if err = os.WriteFile(filename, out.Bytes(), 0o644); err != nil {
+	if err = afero.WriteFile(fs.FS, filename, out.Bytes(), 0o644); err != nil {
 		return fmt.Errorf("failed to write file %q: %w", filename, err)
 	}
 
 	return nil
 }
 
-// CommentCode searches for target in the file and adds the comment prefix
-// to the target content. The target content may span multiple lines.
+// CommentCode calls CommentCodeInFS with the filesystem of the disk.
 func CommentCode(filename, target, prefix string) error {
+	return CommentCodeInFS(osFilesystem(), filename, target, prefix)
+}
+
+// CommentCodeInFS searches for target in the file and adds the comment prefix
+// to the target content. The target content may span multiple lines.
+func CommentCodeInFS(fs machinery.Filesystem, filename, target, prefix string) error {
 	// Read the file content
-	content, err := os.ReadFile(filename)
+	content, err := afero.ReadFile(fs.FS, filename)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", filename, err)
 	}
@@ -216,7 +250,7 @@ func CommentCode(filename, target, prefix string) error {
 	}
 
 	// Write the modified content back to the file
-	if err = os.WriteFile(filename, out.Bytes(), 0o644); err != nil {
+	if err = afero.WriteFile(fs.FS, filename, out.Bytes(), 0o644); err != nil {
 		return fmt.Errorf("failed to write file %q: %w", filename, err)
 	}
 
@@ -231,14 +265,18 @@ func EnsureExistAndReplace(input, match, replace string) (string, error) {
 	return strings.ReplaceAll(input, match, replace), nil
 }
 
-// ReplaceInFile replaces all instances of old with new in the file at path.
+// ReplaceInFile calls ReplaceInFileInFS with the filesystem of the disk.
 func ReplaceInFile(path, oldValue, newValue string) error {
-	info, err := os.Stat(path)
+	return ReplaceInFileInFS(osFilesystem(), path, oldValue, newValue)
+}
+
+// ReplaceInFileInFS replaces all instances of old with new in the file at path.
+func ReplaceInFileInFS(fs machinery.Filesystem, path, oldValue, newValue string) error {
+	info, err := fs.FS.Stat(path)
 	if err != nil {
 		return fmt.Errorf("failed to stat file %q: %w", path, err)
 	}
-	//nolint:gosec // false positive
-	b, err := os.ReadFile(path)
+	b, err := afero.ReadFile(fs.FS, path)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", path, err)
 	}
@@ -246,28 +284,32 @@ func ReplaceInFile(path, oldValue, newValue string) error {
 		return errors.New("unable to find the content to be replaced")
 	}
 	s := strings.ReplaceAll(string(b), oldValue, newValue)
-	if err = os.WriteFile(path, []byte(s), info.Mode()); err != nil {
+	if err = afero.WriteFile(fs.FS, path, []byte(s), info.Mode()); err != nil {
 		return fmt.Errorf("failed to write file %q: %w", path, err)
 	}
 	return nil
 }
 
-// ReplaceRegexInFile finds all strings that match `match` and replaces them
+// ReplaceRegexInFile calls ReplaceRegexInFileInFS with the filesystem of the disk.
+func ReplaceRegexInFile(path, match, replace string) error {
+	return ReplaceRegexInFileInFS(osFilesystem(), path, match, replace)
+}
+
+// ReplaceRegexInFileInFS finds all strings that match `match` and replaces them
 // with `replace` in the file at path.
 //
 // This function is currently unused in the Kubebuilder codebase,
 // but is used by other projects and may be used in Kubebuilder in the future.
-func ReplaceRegexInFile(path, match, replace string) error {
+func ReplaceRegexInFileInFS(fs machinery.Filesystem, path, match, replace string) error {
 	matcher, err := regexp.Compile(match)
 	if err != nil {
 		return fmt.Errorf("failed to compile regular expression %q: %w", match, err)
 	}
-	info, err := os.Stat(path)
+	info, err := fs.FS.Stat(path)
 	if err != nil {
 		return fmt.Errorf("failed to stat file %q: %w", path, err)
 	}
-	//nolint:gosec // false positive
-	b, err := os.ReadFile(path)
+	b, err := afero.ReadFile(fs.FS, path)
 	if err != nil {
 		return fmt.Errorf("failed to read file %q: %w", path, err)
 	}
@@ -276,17 +318,21 @@ func ReplaceRegexInFile(path, match, replace string) error {
 		return errors.New("unable to find the content to be replaced")
 	}
 
-	if err = os.WriteFile(path, []byte(s), info.Mode()); err != nil {
+	if err = afero.WriteFile(fs.FS, path, []byte(s), info.Mode()); err != nil {
 		return fmt.Errorf("failed to write file %q: %w", path, err)
 	}
 
 	return nil
 }
 
-// HasFileContentWith check if given `text` can be found in file
+// HasFileContentWith calls HasFileContentWithInFS with the filesystem of the disk.
 func HasFileContentWith(path, text string) (bool, error) {
-	//nolint:gosec
-	contents, err := os.ReadFile(path)
+	return HasFileContentWithInFS(osFilesystem(), path, text)
+}
+
+// HasFileContentWithInFS check if given `text` can be found in file
+func HasFileContentWithInFS(fs machinery.Filesystem, path, text string) (bool, error) {
+	contents, err := afero.ReadFile(fs.FS, path)
 	if err != nil {
 		return false, fmt.Errorf("failed to read file %q: %w", path, err)
 	}
@@ -0,0 +1,73 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("deleteSubcommand", func() {
+	var (
+		subCmd *deleteSubcommand
+		cfg    config.Config
+		res    *resource.Resource
+	)
+
+	BeforeEach(func() {
+		subCmd = &deleteSubcommand{}
+		cfg = cfgv3.New()
+		res = &resource.Resource{
+			GVK: resource.GVK{
+				Group:   "crew",
+				Domain:  "test.io",
+				Version: "v1",
+				Kind:    "Captain",
+			},
+			API:      &resource.API{},
+			Webhooks: &resource.Webhooks{},
+		}
+
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+	})
+
+	It("should fail if the resource is not tracked", func() {
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to find resource crew/v1/Captain"))
+	})
+
+	It("should inject the tracked resource", func() {
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:    res.GVK,
+			Plural: "captains",
+			Path:   "github.com/example/test/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+		})).To(Succeed())
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(subCmd.resource).To(Equal(res))
+		Expect(res.HasAPI()).To(BeTrue())
+		Expect(res.Path).To(Equal("github.com/example/test/api/v1"))
+		Expect(res.Webhooks).NotTo(BeNil())
+	})
+})
@@ -0,0 +1,58 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v2
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds"
+)
+
+var _ plugin.DeleteWebhookSubcommand = &deleteWebhookSubcommand{}
+
+type deleteWebhookSubcommand struct {
+	deleteSubcommand
+
+	// doDefaulting, doValidation and doConversion indicate which webhook types to remove
+	doDefaulting bool
+	doValidation bool
+	doConversion bool
+}
+
+func (p *deleteWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.doDefaulting, "defaulting", false,
+		"If set, remove the defaulting webhook")
+	fs.BoolVar(&p.doValidation, "programmatic-validation", false,
+		"If set, remove the validating webhook or the ValidatingAdmissionPolicy")
+	fs.BoolVar(&p.doConversion, "conversion", false,
+		"If set, remove the conversion webhook")
+}
+
+func (p *deleteWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewDeleteWebhookScaffolder(p.config, *p.resource,
+		p.doDefaulting, p.doValidation, p.doConversion)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("failed to scaffold delete webhook subcommand: %w", err)
+	}
+
+	return nil
+}
@@ -19,6 +19,7 @@ package v2
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
@@ -31,13 +32,15 @@ const pluginName = "kustomize.common." + plugins.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 2, Stage: stage.Stable}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
 var (
 	_ plugin.Init          = Plugin{}
 	_ plugin.CreateAPI     = Plugin{}
 	_ plugin.CreateWebhook = Plugin{}
+	_ plugin.DeleteAPI     = Plugin{}
+	_ plugin.DeleteWebhook = Plugin{}
 )
 
 // Plugin implements the plugin.Full interface
@@ -45,6 +48,8 @@ type Plugin struct {
 	initSubcommand
 	createAPISubcommand
 	createWebhookSubcommand
+	deleteAPISubcommand
+	deleteWebhookSubcommand
 }
 
 // Name returns the name of the plugin
@@ -67,6 +72,14 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 	return &p.createWebhookSubcommand
 }
 
+// GetDeleteAPISubcommand will return the subcommand which is responsible for removing the manifests of apis
+func (p Plugin) GetDeleteAPISubcommand() plugin.DeleteAPISubcommand { return &p.deleteAPISubcommand }
+
+// GetDeleteWebhookSubcommand will return the subcommand which is responsible for removing the manifests of webhooks
+func (p Plugin) GetDeleteWebhookSubcommand() plugin.DeleteWebhookSubcommand {
+	return &p.deleteWebhookSubcommand
+}
+
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
 	return "Scaffolds base Kustomize configuration"
@@ -90,9 +90,9 @@ func (s *apiScaffolder) Scaffold() error {
 			}
 		}
 
-		err := pluginutil.UncommentCode(kustomizeFilePath, "#- ../crd", `#`)
+		err := pluginutil.UncommentCodeInFS(s.fs, kustomizeFilePath, "#- ../crd", `#`)
 		if err != nil {
-			hasCRUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "- ../crd")
+			hasCRUncommented, errCheck := pluginutil.HasFileContentWithInFS(s.fs, kustomizeFilePath, "- ../crd")
 			if !hasCRUncommented || errCheck != nil {
 				log.Error("unable to find the target #- ../crd to uncomment in the file",
 					"file_path", kustomizeFilePath)
@@ -103,7 +103,7 @@ func (s *apiScaffolder) Scaffold() error {
 
 		// Add scaffolded CRD Admin, Editor and Viewer roles in config/rbac/kustomization.yaml
 		rbacKustomizeFilePath := "config/rbac/kustomization.yaml"
-		err = pluginutil.AppendCodeIfNotExist(rbacKustomizeFilePath,
+		err = pluginutil.AppendCodeIfNotExistInFS(s.fs, rbacKustomizeFilePath,
 			comment)
 		if err != nil {
 			log.Error("failed to append the admin/edit/view roles comment in the file",
@@ -113,14 +113,14 @@ func (s *apiScaffolder) Scaffold() error {
 		if s.config.IsMultiGroup() && s.resource.Group != "" {
 			crdName = strings.ToLower(s.resource.Group) + "_" + crdName
 		}
-		err = pluginutil.InsertCodeIfNotExist(rbacKustomizeFilePath, comment,
+		err = pluginutil.InsertCodeIfNotExistInFS(s.fs, rbacKustomizeFilePath, comment,
 			fmt.Sprintf("\n- %[1]s_admin_role.yaml\n- %[1]s_editor_role.yaml\n- %[1]s_viewer_role.yaml", crdName))
 		if err != nil {
 			log.Error("failed to add admin, editor and viewer roles in the file",
 				"file_path", rbacKustomizeFilePath)
 		}
 		// Add an empty line at the end of the file
-		err = pluginutil.AppendCodeIfNotExist(rbacKustomizeFilePath,
+		err = pluginutil.AppendCodeIfNotExistInFS(s.fs, rbacKustomizeFilePath,
 			`
 
 `)
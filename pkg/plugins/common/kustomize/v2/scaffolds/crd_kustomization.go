@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
+)
+
+var _ plugins.Scaffolder = &crdKustomizationScaffolder{}
+
+// crdKustomizationScaffolder contains configuration for listing the CRD of an already
+// scaffolded API in config/crd/kustomization.yaml
+type crdKustomizationScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewCRDKustomizationScaffolder returns a new Scaffolder that lists the CRD of an already scaffolded
+// API in config/crd/kustomization.yaml. Entries already present are not duplicated.
+func NewCRDKustomizationScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &crdKustomizationScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *crdKustomizationScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *crdKustomizationScaffolder) Scaffold() error {
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := scaffold.Execute(&crd.Kustomization{}); err != nil {
+		return fmt.Errorf("error updating config/crd/kustomization.yaml: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// removeFile removes the file at path if it exists.
+func removeFile(fs machinery.Filesystem, path string) error {
+	exists, err := afero.Exists(fs.FS, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	if err = fs.FS.Remove(path); err != nil {
+		return fmt.Errorf("error removing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// removeLines removes the lines of the file at path that match any of the provided ones,
+// ignoring leading and trailing whitespaces.
+func removeLines(fs machinery.Filesystem, path string, lines ...string) error {
+	return updateLines(fs, path, func(original []string) []string {
+		return slices.DeleteFunc(slices.Clone(original), func(line string) bool {
+			return slices.Contains(lines, strings.TrimSpace(line))
+		})
+	})
+}
+
+// removeBlock removes the first occurrence of the consecutive lines of block from the file at path,
+// ignoring leading and trailing whitespaces.
+func removeBlock(fs machinery.Filesystem, path, block string) error {
+	blockLines := strings.Split(strings.TrimSpace(block), "\n")
+	for i, line := range blockLines {
+		blockLines[i] = strings.TrimSpace(line)
+	}
+
+	return updateLines(fs, path, func(original []string) []string {
+		for i := 0; i+len(blockLines) <= len(original); i++ {
+			matches := true
+			for j, line := range blockLines {
+				if strings.TrimSpace(original[i+j]) != line {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return slices.Delete(slices.Clone(original), i, i+len(blockLines))
+			}
+		}
+		return original
+	})
+}
+
+// commentBlock comments again the first occurrence of the lines of block, written with their leading '#',
+// that were uncommented in the file at path. The lines must match exactly, so commented ones are left as is.
+func commentBlock(fs machinery.Filesystem, path, block string) error {
+	commented := strings.Split(block, "\n")
+
+	return updateLines(fs, path, func(original []string) []string {
+		for i := 0; i+len(commented) <= len(original); i++ {
+			matches := true
+			for j, line := range commented {
+				if original[i+j] != strings.TrimPrefix(line, "#") {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				updated := slices.Clone(original)
+				copy(updated[i:], commented)
+				return updated
+			}
+		}
+		return original
+	})
+}
+
+// updateLines replaces the lines of the file at path with the ones returned by update.
+// Missing files are skipped, as users may have removed them.
+func updateLines(fs machinery.Filesystem, path string, update func([]string) []string) error {
+	content, err := afero.ReadFile(fs.FS, path)
+	if err != nil {
+		log.Warn("unable to read file, skipping removal of lines", "file_path", path, "error", err)
+		return nil
+	}
+
+	original := strings.Split(string(content), "\n")
+	updated := update(original)
+	if slices.Equal(updated, original) {
+		return nil
+	}
+
+	if err = afero.WriteFile(fs.FS, path, []byte(strings.Join(updated, "\n")), 0o644); err != nil {
+		return fmt.Errorf("error updating %s: %w", path, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/rbac"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/samples"
+)
+
+var _ plugins.Scaffolder = &deleteAPIScaffolder{}
+
+// deleteAPIScaffolder contains configuration for removing the kustomize manifests of an API.
+type deleteAPIScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteAPIScaffolder returns a new Scaffolder for API deletion operations
+func NewDeleteAPIScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteAPIScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) Scaffold() error {
+	if !s.resource.HasAPI() {
+		return nil
+	}
+
+	log.Info("Removing kustomize manifests of the API...")
+
+	// Initialize the machinery.Scaffold that will remove the files from disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if err := scaffold.Delete(
+		&samples.CRDSample{},
+		&samples.Kustomization{},
+	); err != nil {
+		return fmt.Errorf("error removing kustomize API manifests: %w", err)
+	}
+
+	// The CRD and its roles are shared by all the versions of the kind,
+	// so they are kept while any other version of the API remains.
+	shared, err := s.isKindShared()
+	if err != nil {
+		return err
+	}
+	if shared {
+		return nil
+	}
+
+	if err = scaffold.Delete(
+		&rbac.CRDAdminRole{},
+		&rbac.CRDEditorRole{},
+		&rbac.CRDViewerRole{},
+		&crd.Kustomization{},
+	); err != nil {
+		return fmt.Errorf("error removing kustomize API manifests: %w", err)
+	}
+
+	// The CRD is generated by controller-gen, so it is not tracked by any template
+	crdPath := filepath.Join("config", "crd", "bases",
+		fmt.Sprintf("%s_%s.yaml", s.resource.QualifiedGroup(), s.resource.Plural))
+	if err = removeFile(s.fs, crdPath); err != nil {
+		return err
+	}
+
+	// Remove the CRD Admin, Editor and Viewer roles from config/rbac/kustomization.yaml
+	crdName := strings.ToLower(s.resource.Kind)
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		crdName = strings.ToLower(s.resource.Group) + "_" + crdName
+	}
+	rbacKustomizeFilePath := filepath.Join("config", "rbac", "kustomization.yaml")
+	return removeLines(s.fs, rbacKustomizeFilePath,
+		fmt.Sprintf("- %s_admin_role.yaml", crdName),
+		fmt.Sprintf("- %s_editor_role.yaml", crdName),
+		fmt.Sprintf("- %s_viewer_role.yaml", crdName),
+	)
+}
+
+// isKindShared checks if another version of the kind has an API scaffolded.
+func (s *deleteAPIScaffolder) isKindShared() (bool, error) {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return false, fmt.Errorf("error getting resources: %w", err)
+	}
+
+	for _, res := range resources {
+		if res.IsEqualTo(s.resource.GVK) || !res.HasAPI() {
+			continue
+		}
+		if res.QualifiedGroup() == s.resource.QualifiedGroup() && res.Kind == s.resource.Kind {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,331 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	admissionpolicy "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/admission-policy"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd/patches"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/kdefault"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/webhook"
+)
+
+var _ plugins.Scaffolder = &deleteWebhookScaffolder{}
+
+// deleteWebhookScaffolder contains configuration for removing the kustomize manifests of webhooks.
+type deleteWebhookScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+
+	// defaulting, validation and conversion indicate which webhook types are removed
+	defaulting bool
+	validation bool
+	conversion bool
+}
+
+// NewDeleteWebhookScaffolder returns a new Scaffolder for webhook deletion operations
+func NewDeleteWebhookScaffolder(
+	cfg config.Config,
+	res resource.Resource,
+	defaulting, validation, conversion bool,
+) plugins.Scaffolder {
+	return &deleteWebhookScaffolder{
+		config:     cfg,
+		resource:   res,
+		defaulting: defaulting,
+		validation: validation,
+		conversion: conversion,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) InjectFS(fs machinery.Filesystem) { s.fs = fs }
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) Scaffold() error {
+	// The manifests of the defaulting and validating webhooks are generated by controller-gen
+	// from the markers, so only their selectors, the ValidatingAdmissionPolicy and the conversion
+	// webhook have manifests tracked by the scaffold. The sections of config/default enabled for
+	// them are disabled again once they are not needed.
+	if err := s.deleteSelectorsPatches(); err != nil {
+		return err
+	}
+	if err := s.deleteAdmissionPolicy(); err != nil {
+		return err
+	}
+	if s.conversion {
+		if err := s.deleteConversionWebhook(); err != nil {
+			return err
+		}
+	}
+
+	return s.disableWebhookDefaults()
+}
+
+// deleteConversionWebhook removes the CA injection and the CRD patch of the conversion webhook
+func (s *deleteWebhookScaffolder) deleteConversionWebhook() error {
+	log.Info("Removing kustomize manifests of the conversion webhook...")
+
+	// Initialize the machinery.Scaffold that will remove the files from disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	// Remove the commented CA injection targets of the CRD, and the ones that were uncommented
+	caUpdater := &kdefault.KustomizationCAConversionUpdater{}
+	caUpdater.InjectResource(&s.resource)
+	if err := caUpdater.SetTemplateDefaults(); err != nil {
+		return fmt.Errorf("error removing CA injection for the conversion webhook: %w", err)
+	}
+	for _, fragments := range caUpdater.GetCodeFragments() {
+		for _, fragment := range fragments {
+			if err := removeBlock(s.fs, kustomizeFilePath, uncomment(fragment)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := scaffold.Delete(
+		&patches.EnableWebhookPatch{},
+		&kdefault.KustomizationCAConversionUpdater{},
+	); err != nil {
+		return fmt.Errorf("error removing kustomize conversion webhook manifests: %w", err)
+	}
+
+	suffix := s.resource.Plural
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		suffix = s.resource.Group + "_" + s.resource.Plural
+	}
+	if err := removeLines(s.fs, filepath.Join("config", "crd", "kustomization.yaml"),
+		fmt.Sprintf("- path: patches/webhook_in_%s.yaml", suffix),
+	); err != nil {
+		return err
+	}
+
+	if !s.hasOtherConversionWebhooks() {
+		log.Warn("No conversion webhook is left in the project. The replacements that inject the CA "+
+			"into the CRDs can be commented out again.", "file", kustomizeFilePath)
+	}
+
+	return nil
+}
+
+// disableWebhookDefaults comments again the sections of config/default/kustomization.yaml that were
+// uncommented for the removed webhook types once no resource of the project has them, and disables the
+// webhook and cert-manager manifests once the manager serves no webhook.
+func (s *deleteWebhookScaffolder) disableWebhookDefaults() error {
+	removesServed := (s.defaulting && s.resource.HasDefaultingWebhook()) ||
+		(s.validation && s.resource.HasValidationWebhook()) ||
+		(s.conversion && s.resource.HasConversionWebhook())
+	if !removesServed {
+		return nil
+	}
+
+	// The configuration is updated after the kustomize manifests are removed, so the webhook types
+	// that are left for this resource are the ones that are not removed
+	defaulting := s.resource.HasDefaultingWebhook() && !s.defaulting
+	validation := s.resource.HasValidationWebhook() && !s.validation
+	conversion := s.resource.HasConversionWebhook() && !s.conversion
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, res := range resources {
+		if res.IsEqualTo(s.resource.GVK) {
+			continue
+		}
+		defaulting = defaulting || res.HasDefaultingWebhook()
+		validation = validation || res.HasValidationWebhook()
+		conversion = conversion || res.HasConversionWebhook()
+	}
+
+	if s.defaulting && !defaulting {
+		if err = commentBlock(s.fs, kustomizeFilePath, defaultingWebhookReplacementsFragment); err != nil {
+			return err
+		}
+	}
+	if s.validation && !validation {
+		if err = commentBlock(s.fs, kustomizeFilePath, validationWebhookReplacementsFragment); err != nil {
+			return err
+		}
+	}
+	if defaulting || validation || conversion {
+		return nil
+	}
+
+	log.Info("No webhook is served by the manager anymore, disabling the webhook and cert-manager manifests...")
+	blocks := []string{webhookResourceFragment, managerWebhookPatchFragment, webhookServiceReplacementsFragment}
+	content, err := afero.ReadFile(s.fs.FS, kustomizeFilePath)
+	if err == nil && !slices.Contains(strings.Split(string(content), "\n"), certMetricsPatchEntry) {
+		// cert-manager is still required when it issues the certificates of the metrics endpoint
+		blocks = append(blocks, certManagerResourceFragment)
+	}
+	for _, block := range blocks {
+		if err = commentBlock(s.fs, kustomizeFilePath, block); err != nil {
+			return err
+		}
+	}
+	if !s.hasUncommentedReplacements() {
+		if err = commentBlock(s.fs, kustomizeFilePath, replacementsFragment); err != nil {
+			return err
+		}
+	}
+
+	// The manifests generated by controller-gen are not removed once the markers are gone
+	if err = removeFile(s.fs, filepath.Join("config", "webhook", "manifests.yaml")); err != nil {
+		return err
+	}
+	if err = removeLines(s.fs, filepath.Join("config", "network-policy", "kustomization.yaml"),
+		strings.TrimSpace(allowWebhookTrafficFragment)); err != nil {
+		return err
+	}
+
+	log.Info("The manifests under config/webhook and config/certmanager are no longer deployed "+
+		"and can be removed", "file", kustomizeFilePath)
+	return nil
+}
+
+// hasUncommentedReplacements checks if config/default/kustomization.yaml has replacements that are enabled
+func (s *deleteWebhookScaffolder) hasUncommentedReplacements() bool {
+	content, err := afero.ReadFile(s.fs.FS, kustomizeFilePath)
+	if err != nil {
+		return true
+	}
+	return slices.ContainsFunc(strings.Split(string(content), "\n"), func(line string) bool {
+		return strings.HasPrefix(strings.TrimSpace(line), "- source:")
+	})
+}
+
+// deleteSelectorsPatches removes the patches with the selectors of the removed webhook types
+func (s *deleteWebhookScaffolder) deleteSelectorsPatches() error {
+	if s.resource.Webhooks == nil || !s.resource.Webhooks.HasSelectors() {
+		return nil
+	}
+
+	var paths []string
+	if s.defaulting {
+		paths = append(paths, webhook.SelectorsPatchPath(s.resource.Kind, s.resource.Version, true))
+	}
+	if s.validation {
+		paths = append(paths, webhook.SelectorsPatchPath(s.resource.Kind, s.resource.Version, false))
+	}
+	if len(paths) == 0 {
+		return nil
+	}
+
+	log.Info("Removing kustomize patches with the selectors of the webhooks...")
+	for _, path := range paths {
+		if err := removeFile(s.fs, filepath.Join("config", "webhook", path)); err != nil {
+			return err
+		}
+		if err := removeLines(s.fs, kustomizeWebhookFilePath, "- path: "+path); err != nil {
+			return err
+		}
+	}
+
+	// Drop the patches field once its last patch is removed
+	return updateLines(s.fs, kustomizeWebhookFilePath, func(lines []string) []string {
+		end := len(lines)
+		for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+			end--
+		}
+		if end == 0 || strings.TrimSpace(lines[end-1]) != "patches:" {
+			return lines
+		}
+		start := end - 1
+		for start > 0 && strings.TrimSpace(lines[start-1]) == "" {
+			start--
+		}
+		return append(slices.Clone(lines[:start]), "")
+	})
+}
+
+// deleteAdmissionPolicy removes the ValidatingAdmissionPolicy of the resource, and the admission-policy
+// kustomization once no other resource has one.
+func (s *deleteWebhookScaffolder) deleteAdmissionPolicy() error {
+	if !s.validation || !s.resource.HasValidatingAdmissionPolicy() {
+		return nil
+	}
+
+	log.Info("Removing kustomize manifests of the ValidatingAdmissionPolicy...",
+		"name", admissionpolicy.PolicyName(s.resource.Group, s.resource.Version, s.resource.Kind))
+	dir := filepath.Join("config", "admission-policy")
+	fileName := admissionpolicy.PolicyFileName(s.resource.Group, s.resource.Version, s.resource.Kind)
+	if err := removeFile(s.fs, filepath.Join(dir, fileName)); err != nil {
+		return err
+	}
+	if err := removeLines(s.fs, filepath.Join(dir, "kustomization.yaml"), "- "+fileName); err != nil {
+		return err
+	}
+
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	if slices.ContainsFunc(resources, func(res resource.Resource) bool {
+		return !res.IsEqualTo(s.resource.GVK) && res.HasValidatingAdmissionPolicy()
+	}) {
+		return nil
+	}
+
+	for _, file := range []string{"kustomization.yaml", "kustomizeconfig.yaml"} {
+		if err := removeFile(s.fs, filepath.Join(dir, file)); err != nil {
+			return err
+		}
+	}
+	return removeLines(s.fs, kustomizeFilePath, strings.Split(strings.TrimSpace(admissionPoliciesFragment), "\n")...)
+}
+
+// hasOtherConversionWebhooks checks if another resource has a conversion webhook scaffolded.
+func (s *deleteWebhookScaffolder) hasOtherConversionWebhooks() bool {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return true
+	}
+
+	for _, res := range resources {
+		if !res.IsEqualTo(s.resource.GVK) && res.HasConversionWebhook() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// uncomment removes the leading '#' of each line of text
+func uncomment(text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimPrefix(line, "#")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -17,11 +17,20 @@ limitations under the License.
 package scaffolds
 
 import (
+	"errors"
 	"fmt"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/kdefault"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/manager"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/rbac"
 )
@@ -60,14 +69,24 @@ func (s *editScaffolder) Scaffold() error {
 
 	var templates []machinery.Builder
 
-	if s.namespaced {
+	watchNamespaces := s.namespaced && len(s.config.GetWatchNamespaces()) > 0
+	switch {
+	case watchNamespaces:
+		// Scaffold a RoleBinding for the manager ClusterRole in each watched namespace and manager config
+		templates = []machinery.Builder{
+			&rbac.ClusterRole{},
+			&rbac.WatchNamespacesRoleBinding{},
+			&kdefault.WatchNamespacesReplacements{},
+			&manager.Config{Image: imageName, Force: s.force},
+		}
+	case s.namespaced:
 		// Scaffold namespace-scoped RBAC and manager config
 		templates = []machinery.Builder{
 			&rbac.NamespacedRole{},
 			&rbac.NamespacedRoleBinding{},
 			&manager.Config{Image: imageName, Force: s.force},
 		}
-	} else {
+	default:
 		// Scaffold cluster-scoped RBAC and manager config
 		templates = []machinery.Builder{
 			&rbac.ClusterRole{},
@@ -80,6 +99,21 @@ func (s *editScaffolder) Scaffold() error {
 		return fmt.Errorf("failed to scaffold: %w", err)
 	}
 
+	if watchNamespaces {
+		if err := s.enableWatchNamespacesReplacements(); err != nil {
+			return err
+		}
+	} else if err := s.disableWatchNamespacesReplacements(); err != nil {
+		return err
+	}
+
+	// Without --force the manager config is kept, so only its WATCH_NAMESPACE environment variable is updated
+	if s.namespaced && !s.force {
+		if err := s.updateWatchNamespaceEnv(); err != nil {
+			return err
+		}
+	}
+
 	// Regenerate CRD admin/editor/viewer roles for all existing resources
 	// to match the new namespaced/cluster-scoped configuration
 	resources, err := s.config.GetResources()
@@ -107,3 +141,117 @@ func (s *editScaffolder) Scaffold() error {
 
 	return nil
 }
+
+// enableWatchNamespacesReplacements references the replacements that deploy the RoleBindings of the manager
+// to the watched namespaces from config/default/kustomization.yaml, enabling its replacements field if needed
+func (s *editScaffolder) enableWatchNamespacesReplacements() error {
+	content, err := afero.ReadFile(s.fs.FS, kustomizeFilePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", kustomizeFilePath, err)
+	}
+	str := string(content)
+
+	switch {
+	case strings.Contains(str, kdefault.WatchNamespacesReplacementsEntry):
+		return nil
+	case strings.Contains(str, "\nreplacements:\n"):
+		str = strings.Replace(str, "\nreplacements:\n", "\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry, 1)
+	case strings.Contains(str, "\n#replacements:\n"):
+		str = strings.Replace(str, "\n#replacements:\n", "\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry, 1)
+	default:
+		str = strings.TrimSuffix(str, "\n") + "\n\nreplacements:\n" + kdefault.WatchNamespacesReplacementsEntry
+	}
+
+	if err = afero.WriteFile(s.fs.FS, kustomizeFilePath, []byte(str), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write %q: %w", kustomizeFilePath, err)
+	}
+	return nil
+}
+
+// disableWatchNamespacesReplacements removes the replacements that deploy the RoleBindings of the manager
+// to the watched namespaces, commenting the replacements field of config/default/kustomization.yaml back
+// if no other replacement is enabled
+func (s *editScaffolder) disableWatchNamespacesReplacements() error {
+	replacementsPath := filepath.Join("config", "default", kdefault.WatchNamespacesReplacementsFileName)
+	if err := s.fs.FS.Remove(replacementsPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove %q: %w", replacementsPath, err)
+	}
+
+	content, err := afero.ReadFile(s.fs.FS, kustomizeFilePath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to read %q: %w", kustomizeFilePath, err)
+	}
+	if !strings.Contains(string(content), kdefault.WatchNamespacesReplacementsEntry) {
+		return nil
+	}
+
+	lines := strings.Split(strings.Replace(string(content), kdefault.WatchNamespacesReplacementsEntry, "", 1), "\n")
+	for i, line := range lines {
+		if line == "replacements:" && !hasListItems(lines[i+1:]) {
+			lines[i] = "#replacements:"
+			break
+		}
+	}
+
+	str := strings.Join(lines, "\n")
+	if err = afero.WriteFile(s.fs.FS, kustomizeFilePath, []byte(str), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write %q: %w", kustomizeFilePath, err)
+	}
+	return nil
+}
+
+// hasListItems checks if the value of a YAML field, whose following lines are provided, has any uncommented item
+func hasListItems(lines []string) bool {
+	for _, line := range lines {
+		switch {
+		case strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#"):
+			continue
+		case strings.HasPrefix(line, " ") || strings.HasPrefix(line, "-"):
+			return true
+		default:
+			return false
+		}
+	}
+	return false
+}
+
+// watchNamespaceEnvRegexp matches the value of the WATCH_NAMESPACE environment variable of the manager,
+// either set to the watched namespaces or read from the namespace the manager is deployed to
+var watchNamespaceEnvRegexp = regexp.MustCompile(
+	`(?m)^( +)- name: WATCH_NAMESPACE\n +(?:value: .*|valueFrom:\n +fieldRef:\n +fieldPath: metadata\.namespace)$`)
+
+// updateWatchNamespaceEnv sets the WATCH_NAMESPACE environment variable of the manager in
+// config/manager/manager.yaml to the watched namespaces, or to the namespace of the manager if there are none
+func (s *editScaffolder) updateWatchNamespaceEnv() error {
+	managerPath := filepath.Join("config", "manager", "manager.yaml")
+	content, err := afero.ReadFile(s.fs.FS, managerPath)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", managerPath, err)
+	}
+
+	namespaces := s.config.GetWatchNamespaces()
+	match := watchNamespaceEnvRegexp.FindSubmatchIndex(content)
+	if match == nil {
+		if len(namespaces) > 0 {
+			log.Warn("unable to find the WATCH_NAMESPACE environment variable of the manager to set the watched "+
+				"namespaces, run with --force to regenerate the file", "file", managerPath)
+		}
+		return nil
+	}
+
+	indent := string(content[match[2]:match[3]])
+	env := indent + "- name: WATCH_NAMESPACE\n" + indent + "  "
+	if len(namespaces) > 0 {
+		env += "value: " + strings.Join(namespaces, ",")
+	} else {
+		env += "valueFrom:\n" + indent + "    fieldRef:\n" + indent + "      fieldPath: metadata.namespace"
+	}
+
+	updated := string(content[:match[0]]) + env + string(content[match[1]:])
+	if err = afero.WriteFile(s.fs.FS, managerPath, []byte(updated), machinery.DefaultFilePermission); err != nil {
+		return fmt.Errorf("failed to write %q: %w", managerPath, err)
+	}
+	return nil
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/kdefault"
+)
+
+var _ = Describe("Edit scaffolder", func() {
+	const (
+		resources = "resources:\n- ../crd\n- ../rbac\n- ../manager\n"
+		// certManagerReplacements are the commented cert-manager replacements of config/default/kustomization.yaml
+		certManagerReplacements = "# - source: # Uncomment the following block to enable certificates for metrics\n" +
+			"#     kind: Service\n" +
+			"#     version: v1\n"
+		// enabledCertManagerReplacements are the same replacements once uncommented by the users
+		enabledCertManagerReplacements = " - source: # Uncomment the following block to enable certificates for metrics\n" +
+			"     kind: Service\n" +
+			"     version: v1\n"
+	)
+
+	var (
+		managerPath      = filepath.Join("config", "manager", "manager.yaml")
+		replacementsPath = filepath.Join("config", "default", kdefault.WatchNamespacesReplacementsFileName)
+
+		cfg config.Config
+		fs  machinery.Filesystem
+		s   *editScaffolder
+	)
+
+	BeforeEach(func() {
+		cfg = cfgv3.New()
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		s = &editScaffolder{config: cfg, namespaced: true, fs: fs}
+	})
+
+	readFile := func(path string) string {
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	DescribeTable("enabling the replacements of the watched namespaces",
+		func(kustomization, expected string) {
+			Expect(afero.WriteFile(fs.FS, kustomizeFilePath, []byte(kustomization), 0o644)).To(Succeed())
+
+			Expect(s.enableWatchNamespacesReplacements()).To(Succeed())
+			Expect(readFile(kustomizeFilePath)).To(Equal(expected))
+		},
+		Entry("should add the entry below the uncommented replacements field",
+			resources+"\nreplacements:\n"+certManagerReplacements,
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+certManagerReplacements,
+		),
+		Entry("should uncomment the commented replacements field",
+			resources+"\n#replacements:\n"+certManagerReplacements,
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+certManagerReplacements,
+		),
+		Entry("should add the replacements field when it is missing",
+			resources,
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry,
+		),
+		Entry("should keep the entry when it is already enabled",
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+certManagerReplacements,
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+certManagerReplacements,
+		),
+	)
+
+	DescribeTable("disabling the replacements of the watched namespaces",
+		func(kustomization, expected string) {
+			Expect(afero.WriteFile(fs.FS, kustomizeFilePath, []byte(kustomization), 0o644)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, replacementsPath, []byte("- source: {}\n"), 0o644)).To(Succeed())
+
+			Expect(s.disableWatchNamespacesReplacements()).To(Succeed())
+			Expect(readFile(kustomizeFilePath)).To(Equal(expected))
+			Expect(afero.Exists(fs.FS, replacementsPath)).To(BeFalse())
+		},
+		Entry("should comment the replacements field when no other replacement is enabled",
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+certManagerReplacements,
+			resources+"\n#replacements:\n"+certManagerReplacements,
+		),
+		Entry("should keep the replacements field while the cert-manager replacements are enabled",
+			resources+"\nreplacements:\n"+kdefault.WatchNamespacesReplacementsEntry+enabledCertManagerReplacements,
+			resources+"\nreplacements:\n"+enabledCertManagerReplacements,
+		),
+		Entry("should not change the file without the entry",
+			resources+"\nreplacements:\n"+enabledCertManagerReplacements,
+			resources+"\nreplacements:\n"+enabledCertManagerReplacements,
+		),
+	)
+
+	It("should not fail to disable the replacements without config/default/kustomization.yaml", func() {
+		Expect(s.disableWatchNamespacesReplacements()).To(Succeed())
+	})
+
+	DescribeTable("updating the WATCH_NAMESPACE environment variable of the manager",
+		func(env string, namespaces []string, expected string) {
+			const (
+				container = "      containers:\n      - name: manager\n        env:\n"
+				ports     = "        ports:\n        - containerPort: 8081\n"
+			)
+			Expect(cfg.SetWatchNamespaces(namespaces)).To(Succeed())
+			Expect(afero.WriteFile(fs.FS, managerPath, []byte(container+env+ports), 0o644)).To(Succeed())
+
+			Expect(s.updateWatchNamespaceEnv()).To(Succeed())
+			Expect(readFile(managerPath)).To(Equal(container + expected + ports))
+		},
+		Entry("should set the watched namespaces in place of the namespace of the manager",
+			"        - name: WATCH_NAMESPACE\n"+
+				"          valueFrom:\n"+
+				"            fieldRef:\n"+
+				"              fieldPath: metadata.namespace\n",
+			[]string{"tenant-a", "tenant-b"},
+			"        - name: WATCH_NAMESPACE\n"+
+				"          value: tenant-a,tenant-b\n",
+		),
+		Entry("should replace the previously watched namespaces",
+			"        - name: WATCH_NAMESPACE\n"+
+				"          value: tenant-a\n",
+			[]string{"tenant-b", "tenant-c"},
+			"        - name: WATCH_NAMESPACE\n"+
+				"          value: tenant-b,tenant-c\n",
+		),
+		Entry("should read the namespace of the manager once no namespace is watched",
+			"        - name: WATCH_NAMESPACE\n"+
+				"          value: tenant-a,tenant-b\n",
+			nil,
+			"        - name: WATCH_NAMESPACE\n"+
+				"          valueFrom:\n"+
+				"            fieldRef:\n"+
+				"              fieldPath: metadata.namespace\n",
+		),
+		Entry("should keep the file without the environment variable",
+			"        - name: OTHER\n"+
+				"          value: other\n",
+			[]string{"tenant-a"},
+			"        - name: OTHER\n"+
+				"          value: other\n",
+		),
+	)
+})
@@ -88,7 +88,15 @@ func (s *initScaffolder) Scaffold() error {
 	// Scaffold appropriate RBAC based on scope
 	// We need to create a Role/ClusterRole because if the project
 	// has no CRDs defined, controller-gen will not generate this file
-	if s.config.IsNamespaced() {
+	if s.config.IsNamespaced() && len(s.config.GetWatchNamespaces()) > 0 {
+		// The rules live in a ClusterRole that is bound in each watched namespace,
+		// as controller-gen only generates one set of rules for the manager
+		templates = append(templates,
+			&rbac.WatchNamespacesRoleBinding{},
+			&rbac.ClusterRole{},
+			&kdefault.WatchNamespacesReplacements{},
+		)
+	} else if s.config.IsNamespaced() {
 		templates = append(templates,
 			&rbac.NamespacedRoleBinding{},
 			&rbac.NamespacedRole{},
@@ -0,0 +1,74 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissionpolicy
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var (
+	_ machinery.Template = &Kustomization{}
+	_ machinery.Inserter = &Kustomization{}
+)
+
+// Kustomization scaffolds the kustomization.yaml listing the ValidatingAdmissionPolicies of the project
+type Kustomization struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Kustomization) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "admission-policy", "kustomization.yaml")
+	}
+	f.TemplateBody = fmt.Sprintf(kustomizationTemplate, machinery.NewMarkerFor(f.Path, resourceMarker))
+
+	return nil
+}
+
+const resourceMarker = "admissionpolicykustomizeresource"
+
+// GetMarkers implements machinery.Inserter
+func (f *Kustomization) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{machinery.NewMarkerFor(f.Path, resourceMarker)}
+}
+
+const resourceCodeFragment = `- %s
+`
+
+// GetCodeFragments implements machinery.Inserter
+func (f *Kustomization) GetCodeFragments() machinery.CodeFragmentsMap {
+	return machinery.CodeFragmentsMap{
+		machinery.NewMarkerFor(f.Path, resourceMarker): []string{
+			fmt.Sprintf(resourceCodeFragment, PolicyFileName(f.Resource.Group, f.Resource.Version, f.Resource.Kind)),
+		},
+	}
+}
+
+//nolint:lll
+const kustomizationTemplate = `# This kustomization.yaml lists the ValidatingAdmissionPolicies of the project and their bindings,
+# scaffolded with 'create webhook --programmatic-validation --backend=cel'.
+resources:
+%s
+
+configurations:
+- kustomizeconfig.yaml
+`
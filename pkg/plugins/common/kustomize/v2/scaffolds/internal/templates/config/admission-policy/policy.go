@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissionpolicy
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/webhook"
+)
+
+var _ machinery.Template = &Policy{}
+
+// Policy scaffolds a ValidatingAdmissionPolicy for a resource, with a stub CEL expression to be replaced,
+// and the binding that enforces it
+type Policy struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+	machinery.ProjectNameMixin
+
+	// Name is the name of the policy, the binding is named after it
+	Name string
+
+	// APIGroup is the API group of the resource as matched by the policy, empty for the core group
+	APIGroup string
+
+	// FailurePolicy is how the API server handles errors evaluating the expressions of the policy
+	FailurePolicy string
+
+	// NamespaceSelector and ObjectSelector hold the selectors of the binding rendered as YAML
+	NamespaceSelector string
+	ObjectSelector    string
+
+	Force bool
+}
+
+// PolicyName returns the name of the ValidatingAdmissionPolicy of a resource. Policies are cluster-scoped,
+// so the name includes the group to tell apart the kinds of different groups.
+func PolicyName(group, version, kind string) string {
+	if group == "" {
+		return fmt.Sprintf("%s-%s-policy", version, strings.ToLower(kind))
+	}
+	return fmt.Sprintf("%s-%s-%s-policy", group, version, strings.ToLower(kind))
+}
+
+// PolicyFileName returns the name of the file, in config/admission-policy, with the policy of a resource
+func PolicyFileName(group, version, kind string) string {
+	if group == "" {
+		return fmt.Sprintf("%s_%s.yaml", version, strings.ToLower(kind))
+	}
+	return fmt.Sprintf("%s_%s_%s.yaml", group, version, strings.ToLower(kind))
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *Policy) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "admission-policy",
+			PolicyFileName(f.Resource.Group, f.Resource.Version, f.Resource.Kind))
+	}
+
+	f.Name = PolicyName(f.Resource.Group, f.Resource.Version, f.Resource.Kind)
+
+	f.APIGroup = f.Resource.QualifiedGroup()
+	if f.Resource.Core && f.APIGroup == "core" {
+		f.APIGroup = ""
+	}
+
+	f.FailurePolicy = f.Resource.Webhooks.FailurePolicy
+	if f.FailurePolicy == "" {
+		f.FailurePolicy = resource.FailurePolicyFail
+	}
+
+	var err error
+	if f.NamespaceSelector, err = webhook.SelectorYAML(f.Resource.Webhooks.NamespaceSelector, "      "); err != nil {
+		return fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	if f.ObjectSelector, err = webhook.SelectorYAML(f.Resource.Webhooks.ObjectSelector, "      "); err != nil {
+		return fmt.Errorf("invalid object selector: %w", err)
+	}
+
+	f.TemplateBody = policyTemplate
+
+	if f.Force {
+		f.IfExistsAction = machinery.OverwriteFile
+	} else {
+		f.IfExistsAction = machinery.Error
+	}
+
+	return nil
+}
+
+const policyTemplate = `# The following policy validates the {{ .Resource.Kind }} objects with CEL expressions evaluated
+# by the API server, without a webhook.
+# More info: https://kubernetes.io/docs/reference/access-authn-authz/validating-admission-policy/
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}
+spec:
+  failurePolicy: {{ .FailurePolicy }}
+  matchConstraints:
+    resourceRules:
+    - apiGroups:
+      - "{{ .APIGroup }}"
+      apiVersions:
+      - {{ .Resource.Version }}
+      operations:
+      - CREATE
+      - UPDATE
+      resources:
+      - {{ .Resource.Plural }}
+  # TODO(user): Replace the following example with the rules of the {{ .Resource.Kind }} objects.
+  # The admitted object is available as 'object', and the existing one as 'oldObject' on updates.
+  # More info: https://kubernetes.io/docs/reference/using-api/cel/
+  validations:
+  - expression: "object.metadata.name.size() <= 63"
+    message: "the name of a {{ .Resource.Kind }} must be no more than 63 characters"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ .ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: {{ .Name }}-binding
+spec:
+  policyName: {{ .Name }}
+  validationActions:
+  - Deny
+{{- if or .NamespaceSelector .ObjectSelector }}
+  matchResources:
+{{- if .NamespaceSelector }}
+    namespaceSelector:
+{{ .NamespaceSelector }}
+{{- end }}
+{{- if .ObjectSelector }}
+    objectSelector:
+{{ .ObjectSelector }}
+{{- end }}
+{{- end }}
+`
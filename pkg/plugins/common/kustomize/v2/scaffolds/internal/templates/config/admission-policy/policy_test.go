@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package admissionpolicy
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("Policy", func() {
+	var (
+		fs  machinery.Filesystem
+		cfg config.Config
+	)
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		cfg = cfgv3.New()
+		Expect(cfg.SetProjectName("test-project")).To(Succeed())
+	})
+
+	render := func(res *resource.Resource) string {
+		scaffold := machinery.NewScaffold(fs, machinery.WithConfig(cfg), machinery.WithResource(res))
+		Expect(scaffold.Execute(&Policy{})).To(Succeed())
+
+		path := filepath.Join("config", "admission-policy", PolicyFileName(res.Group, res.Version, res.Kind))
+		content, err := afero.ReadFile(fs.FS, path)
+		Expect(err).NotTo(HaveOccurred())
+		return string(content)
+	}
+
+	It("should render the policy and the binding of a core type", func() {
+		content := render(&resource.Resource{
+			GVK:      resource.GVK{Group: "core", Version: "v1", Kind: "Pod"},
+			Plural:   "pods",
+			Core:     true,
+			Webhooks: &resource.Webhooks{ValidatingAdmissionPolicy: true, NamespaceSelector: "policy=enforced"},
+		})
+
+		Expect(content).To(ContainSubstring("    app.kubernetes.io/name: test-project\n"))
+		Expect(content).To(ContainSubstring("  name: core-v1-pod-policy\n"))
+		Expect(content).To(ContainSubstring("  name: core-v1-pod-policy-binding\n"))
+		Expect(content).To(ContainSubstring("  policyName: core-v1-pod-policy\n"))
+		Expect(content).To(ContainSubstring("    - apiGroups:\n      - \"\"\n"))
+		Expect(content).To(ContainSubstring("      - pods\n"))
+		Expect(content).To(ContainSubstring("  failurePolicy: Fail\n"))
+		Expect(content).To(ContainSubstring("    namespaceSelector:\n      matchLabels:\n        policy: enforced\n"))
+		Expect(content).NotTo(ContainSubstring("objectSelector"))
+	})
+
+	It("should render the policy and the binding of a grouped type", func() {
+		content := render(&resource.Resource{
+			GVK:    resource.GVK{Group: "crew", Domain: "testproject.org", Version: "v1", Kind: "Captain"},
+			Plural: "captains",
+			Webhooks: &resource.Webhooks{
+				ValidatingAdmissionPolicy: true,
+				FailurePolicy:             resource.FailurePolicyIgnore,
+			},
+		})
+
+		Expect(content).To(ContainSubstring("  name: crew-v1-captain-policy\n"))
+		Expect(content).To(ContainSubstring("  name: crew-v1-captain-policy-binding\n"))
+		Expect(content).To(ContainSubstring("  policyName: crew-v1-captain-policy\n"))
+		Expect(content).To(ContainSubstring("    - apiGroups:\n      - \"crew.testproject.org\"\n"))
+		Expect(content).To(ContainSubstring("      - captains\n"))
+		Expect(content).To(ContainSubstring("  failurePolicy: Ignore\n"))
+		Expect(content).NotTo(ContainSubstring("matchResources"))
+	})
+
+	It("should tell apart the kinds of different groups with the same version", func() {
+		Expect(PolicyName("crew", "v1", "Captain")).NotTo(Equal(PolicyName("ship", "v1", "Captain")))
+	})
+})
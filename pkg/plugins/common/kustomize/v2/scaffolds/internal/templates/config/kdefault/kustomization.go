@@ -29,6 +29,7 @@ type Kustomization struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -102,7 +103,9 @@ patches:
 
 # [CERTMANAGER] To enable cert-manager, uncomment all sections with 'CERTMANAGER' prefix.
 # Uncomment the following replacements to add the cert-manager CA injection annotations
-#replacements:
+{{ if .WatchNamespaces }}replacements:
+` + WatchNamespacesReplacementsEntry + `{{ else }}#replacements:
+{{ end -}}
 # - source: # Uncomment the following block to enable certificates for metrics
 #     kind: Service
 #     version: v1
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kdefault
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+// WatchNamespacesReplacementsFileName is the name of the file that holds the replacements which deploy
+// the RoleBindings of the manager to the namespaces it watches
+const WatchNamespacesReplacementsFileName = "watch_namespaces_replacements.yaml"
+
+// WatchNamespacesReplacementsEntry is the item of the replacements field of config/default/kustomization.yaml
+// that references the replacements file. It is indented like the commented cert-manager replacements so that
+// they can still be uncommented below it.
+const WatchNamespacesReplacementsEntry = "# [WATCH NAMESPACES] Deploys the RoleBindings of the manager " +
+	"to the namespaces it watches.\n - path: " + WatchNamespacesReplacementsFileName + "\n"
+
+var _ machinery.Template = &WatchNamespacesReplacements{}
+
+// WatchNamespacesReplacements scaffolds the kustomize replacements that set the namespace of each RoleBinding
+// of the manager to one of the namespaces listed in its WATCH_NAMESPACE environment variable
+type WatchNamespacesReplacements struct {
+	machinery.TemplateMixin
+	machinery.WatchNamespacesMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *WatchNamespacesReplacements) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "default", WatchNamespacesReplacementsFileName)
+	}
+
+	f.TemplateBody = watchNamespacesReplacementsTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+//nolint:lll
+const watchNamespacesReplacementsTemplate = `# The namespace field of config/default/kustomization.yaml moves every resource to the namespace of the
+# manager. These replacements run afterwards and move each RoleBinding of the manager back to one of the
+# namespaces it watches, as listed in the WATCH_NAMESPACE environment variable of config/manager/manager.yaml.
+# This file is regenerated by 'kubebuilder edit --watch-namespaces'.
+{{- range $i, $namespace := .WatchNamespaces }}
+- source:
+    kind: Deployment
+    name: controller-manager
+    fieldPath: spec.template.spec.containers.[name=manager].env.[name=WATCH_NAMESPACE].value
+    options:
+      delimiter: ','
+      index: {{ $i }}
+  targets:
+  - select:
+      kind: RoleBinding
+      name: manager-rolebinding-{{ $namespace }}
+    fieldPaths:
+    - metadata.namespace
+{{- end }}
+`
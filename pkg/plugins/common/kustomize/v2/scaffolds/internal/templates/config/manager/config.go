@@ -29,6 +29,7 @@ type Config struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	// Image is controller manager image name
 	Image string
@@ -124,9 +125,13 @@ spec:
 {{- if .Namespaced }}
         env:
         - name: WATCH_NAMESPACE
+{{- if .WatchNamespaces }}
+          value: {{ range $i, $namespace := .WatchNamespaces }}{{ if $i }},{{ end }}{{ $namespace }}{{ end }}
+{{- else }}
           valueFrom:
             fieldRef:
               fieldPath: metadata.namespace
+{{- end }}
 {{- end }}
         ports:
         - containerPort: 8081
@@ -34,6 +34,7 @@ type CRDEditorRole struct {
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	RoleName string
 }
@@ -75,7 +76,7 @@ const crdRoleEditorTemplate = `# This rule is not used by the project {{ .Projec
 # but should not control RBAC or manage permissions for others.
 
 apiVersion: rbac.authorization.k8s.io/v1
-kind: {{ if .Namespaced }}Role{{ else }}ClusterRole{{ end }}
+kind: {{ if and .Namespaced (not .WatchNamespaces) }}Role{{ else }}ClusterRole{{ end }}
 metadata:
   labels:
     app.kubernetes.io/name: {{ .ProjectName }}
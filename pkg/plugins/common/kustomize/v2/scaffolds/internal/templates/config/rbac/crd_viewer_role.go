@@ -34,6 +34,7 @@ type CRDViewerRole struct {
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	RoleName string
 }
@@ -75,7 +76,7 @@ const crdRoleViewerTemplate = `# This rule is not used by the project {{ .Projec
 # without permissions to modify them. It is ideal for monitoring purposes and limited-access viewing.
 
 apiVersion: rbac.authorization.k8s.io/v1
-kind: {{ if .Namespaced }}Role{{ else }}ClusterRole{{ end }}
+kind: {{ if and .Namespaced (not .WatchNamespaces) }}Role{{ else }}ClusterRole{{ end }}
 metadata:
   labels:
     app.kubernetes.io/name: {{ .ProjectName }}
@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rbac
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &WatchNamespacesRoleBinding{}
+
+// WatchNamespacesRoleBinding scaffolds a RoleBinding for the manager in each of the namespaces it watches,
+// which grants the rules of the manager ClusterRole within that namespace only
+type WatchNamespacesRoleBinding struct {
+	machinery.TemplateMixin
+	machinery.ProjectNameMixin
+	machinery.WatchNamespacesMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *WatchNamespacesRoleBinding) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "rbac", "role_binding.yaml")
+	}
+
+	f.TemplateBody = watchNamespacesRoleBindingTemplate
+
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const watchNamespacesRoleBindingTemplate = `{{ range $i, $namespace := .WatchNamespaces -}}
+{{ if $i }}---
+{{ end -}}
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  labels:
+    app.kubernetes.io/name: {{ $.ProjectName }}
+    app.kubernetes.io/managed-by: kustomize
+  name: manager-rolebinding-{{ $namespace }}
+  namespace: {{ $namespace }}
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: ClusterRole
+  name: manager-role
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: system
+{{ end -}}
+`
@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &SelectorsPatch{}
+
+// SelectorsPatch scaffolds a patch that restricts the defaulting or the validation webhook of a resource
+// to the objects matching the namespace and object selectors of its webhooks
+type SelectorsPatch struct {
+	machinery.TemplateMixin
+	machinery.ResourceMixin
+
+	// Mutating selects the defaulting webhook instead of the validation one
+	Mutating bool
+
+	// NamespaceSelector and ObjectSelector hold the selectors of the webhooks rendered as YAML
+	NamespaceSelector string
+	ObjectSelector    string
+}
+
+// SelectorsPatchPath returns the path, relative to config/webhook, of the patch with the selectors
+// of the defaulting (mutating) or validation webhook of the resource
+func SelectorsPatchPath(kind, version string, mutating bool) string {
+	webhookType := "validating"
+	if mutating {
+		webhookType = "mutating"
+	}
+	return filepath.Join("patches", fmt.Sprintf("%s_selectors_in_%s_%s.yaml", webhookType, strings.ToLower(kind), version))
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *SelectorsPatch) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join("config", "webhook",
+			SelectorsPatchPath(f.Resource.Kind, f.Resource.Version, f.Mutating))
+	}
+
+	var err error
+	if f.NamespaceSelector, err = SelectorYAML(f.Resource.Webhooks.NamespaceSelector, "    "); err != nil {
+		return fmt.Errorf("invalid namespace selector: %w", err)
+	}
+	if f.ObjectSelector, err = SelectorYAML(f.Resource.Webhooks.ObjectSelector, "    "); err != nil {
+		return fmt.Errorf("invalid object selector: %w", err)
+	}
+
+	f.TemplateBody = selectorsPatchTemplate
+
+	// The selectors can be changed by scaffolding the webhooks again with --force
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+// SelectorYAML converts a label selector, e.g. "env in (dev,prod)", into the YAML of a LabelSelector
+// with each line prefixed by indent, to be the value of a field of the manifest
+func SelectorYAML(selector, indent string) (string, error) {
+	if selector == "" {
+		return "", nil
+	}
+
+	labelSelector, err := metav1.ParseToLabelSelector(selector)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse %q: %w", selector, err)
+	}
+	out, err := yaml.Marshal(labelSelector)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal %q: %w", selector, err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	for i, line := range lines {
+		lines[i] = indent + line
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+//nolint:lll
+const selectorsPatchTemplate = `# The following patch restricts the {{ if .Mutating }}defaulting{{ else }}validation{{ end }} webhook of {{ .Resource.Kind }}
+# to the objects matching the selectors set with 'kubebuilder create webhook'.
+apiVersion: admissionregistration.k8s.io/v1
+kind: {{ if .Mutating }}Mutating{{ else }}Validating{{ end }}WebhookConfiguration
+metadata:
+  name: {{ if .Mutating }}mutating{{ else }}validating{{ end }}-webhook-configuration
+webhooks:
+- name: {{ if .Mutating }}m{{ else }}v{{ end }}{{ lower .Resource.Kind }}-{{ .Resource.Version }}.kb.io
+{{- if .NamespaceSelector }}
+  namespaceSelector:
+{{ .NamespaceSelector }}
+{{- end }}
+{{- if .ObjectSelector }}
+  objectSelector:
+{{ .ObjectSelector }}
+{{- end }}
+`
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	pluginutil "sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	admissionpolicy "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/admission-policy"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/certmanager"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2/scaffolds/internal/templates/config/crd/patches"
@@ -37,8 +38,9 @@ import (
 var _ plugins.Scaffolder = &webhookScaffolder{}
 
 const (
-	kustomizeFilePath    = "config/default/kustomization.yaml"
-	kustomizeCRDFilePath = "config/crd/kustomization.yaml"
+	kustomizeFilePath        = "config/default/kustomization.yaml"
+	kustomizeCRDFilePath     = "config/crd/kustomization.yaml"
+	kustomizeWebhookFilePath = "config/webhook/kustomization.yaml"
 )
 
 type webhookScaffolder struct {
@@ -72,7 +74,7 @@ func (s *webhookScaffolder) Scaffold() error {
 	// Users that scaffolded the project previously
 	// with the bugs will receive a message to help
 	// them out fix their scaffold.
-	validateScaffoldedProject()
+	validateScaffoldedProject(s.fs)
 
 	// Initialize the machinery.Scaffold that will write the files to disk
 	scaffold := machinery.NewScaffold(s.fs,
@@ -84,6 +86,23 @@ func (s *webhookScaffolder) Scaffold() error {
 		return fmt.Errorf("error updating resource: %w", err)
 	}
 
+	if s.resource.HasValidatingAdmissionPolicy() {
+		if err := scaffold.Execute(
+			&admissionpolicy.Policy{Force: s.force},
+			&admissionpolicy.Kustomization{},
+			&admissionpolicy.KustomizeConfig{},
+		); err != nil {
+			return fmt.Errorf("error scaffolding kustomize validating admission policy manifests: %w", err)
+		}
+		addAdmissionPolicies(s.fs)
+	}
+
+	// The remaining manifests are only needed for the webhooks served by the manager
+	if !s.resource.HasDefaultingWebhook() && !s.resource.HasValidationWebhook() &&
+		!s.resource.HasConversionWebhook() {
+		return nil
+	}
+
 	buildScaffold := []machinery.Builder{
 		&kdefault.ManagerWebhookPatch{},
 		&webhook.Kustomization{Force: s.force},
@@ -106,12 +125,26 @@ func (s *webhookScaffolder) Scaffold() error {
 		buildScaffold = append(buildScaffold, &crd.Kustomization{})
 	}
 
+	// controller-gen has no marker for the selectors of the webhooks, so they are set with patches
+	if s.resource.Webhooks.HasSelectors() {
+		if s.resource.HasDefaultingWebhook() {
+			buildScaffold = append(buildScaffold, &webhook.SelectorsPatch{Mutating: true})
+		}
+		if s.resource.HasValidationWebhook() {
+			buildScaffold = append(buildScaffold, &webhook.SelectorsPatch{})
+		}
+	}
+
 	if err := scaffold.Execute(buildScaffold...); err != nil {
 		return fmt.Errorf("error scaffolding kustomize webhook manifests: %w", err)
 	}
 
+	if err := s.addSelectorsPatches(); err != nil {
+		return err
+	}
+
 	// Warn users about potential bootstrap problem for core type webhooks
-	if s.resource.Core {
+	if s.resource.Core && !s.resource.Webhooks.HasSelectors() {
 		log.Warn("Webhooks for core types may cause circular dependencies during deployment. " +
 			"More info: https://book.kubebuilder.io/reference/webhook-bootstrap-problem")
 	}
@@ -119,21 +152,21 @@ func (s *webhookScaffolder) Scaffold() error {
 	// Apply project-specific customizations:
 	// - Add reference to allow-webhook-traffic.yaml in network policy configuration.
 	// - Enable all webhook-related sections in config/default/kustomization.yaml.
-	addNetworkPoliciesForWebhooks()
+	addNetworkPoliciesForWebhooks(s.fs)
 	// enableWebhookDefaults ensures all necessary components for webhook functionality
 	// are enabled in config/default/kustomization.yaml, including:
 	// - webhook and cert-manager directories
 	// - manager patches
 	// - replacements for certificate injection
-	enableWebhookDefaults()
+	enableWebhookDefaults(s.fs)
 	if s.resource.HasValidationWebhook() {
-		uncommentCodeForValidationWebhooks()
+		uncommentCodeForValidationWebhooks(s.fs)
 	}
 	if s.resource.HasDefaultingWebhook() {
-		uncommentCodeForDefaultWebhooks()
+		uncommentCodeForDefaultWebhooks(s.fs)
 	}
 	if s.resource.HasConversionWebhook() {
-		uncommentCodeForConversionWebhooks(s.resource)
+		uncommentCodeForConversionWebhooks(s.fs, s.resource)
 	}
 
 	const helmPluginKey = "helm.kubebuilder.io/v1-alpha"
@@ -142,8 +175,8 @@ func (s *webhookScaffolder) Scaffold() error {
 	if !errors.As(err, &config.PluginKeyNotFoundError{}) {
 		testChartPath := ".github/workflows/test-chart.yml"
 		//nolint:lll
-		_ = pluginutil.UncommentCode(
-			testChartPath, `#      - name: Install cert-manager via Helm
+		_ = pluginutil.UncommentCodeInFS(
+			s.fs, testChartPath, `#      - name: Install cert-manager via Helm
 #        run: |
 #          helm repo add jetstack https://charts.jetstack.io
 #          helm repo update
@@ -157,19 +190,65 @@ func (s *webhookScaffolder) Scaffold() error {
 `, "#",
 		)
 
-		_ = pluginutil.ReplaceInFile(testChartPath, "# TODO: Uncomment if cert-manager is enabled", "")
+		_ = pluginutil.ReplaceInFileInFS(s.fs, testChartPath, "# TODO: Uncomment if cert-manager is enabled", "")
 	}
 
 	return nil
 }
 
+// addSelectorsPatches references the patches with the selectors of the webhooks of every resource
+// in config/webhook/kustomization.yaml, including the ones dropped if the file was scaffolded again.
+func (s *webhookScaffolder) addSelectorsPatches() error {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+
+	for _, res := range resources {
+		if res.Webhooks == nil || !res.Webhooks.HasSelectors() {
+			continue
+		}
+		if res.HasDefaultingWebhook() {
+			addWebhookKustomizationPatch(s.fs, webhook.SelectorsPatchPath(res.Kind, res.Version, true))
+		}
+		if res.HasValidationWebhook() {
+			addWebhookKustomizationPatch(s.fs, webhook.SelectorsPatchPath(res.Kind, res.Version, false))
+		}
+	}
+	return nil
+}
+
+// addWebhookKustomizationPatch adds the patch to the patches of config/webhook/kustomization.yaml
+func addWebhookKustomizationPatch(fs machinery.Filesystem, path string) {
+	entry := fmt.Sprintf("- path: %s\n", path)
+	hasEntry, err := pluginutil.HasFileContentWithInFS(fs, kustomizeWebhookFilePath, entry)
+	if err != nil {
+		log.Warn("unable to read the file to add the patch with the selectors of the webhooks",
+			"file", kustomizeWebhookFilePath)
+		return
+	}
+	if hasEntry {
+		return
+	}
+
+	if hasPatches, _ := pluginutil.HasFileContentWithInFS(fs, kustomizeWebhookFilePath, "\npatches:\n"); hasPatches {
+		err = pluginutil.InsertCodeInFS(fs, kustomizeWebhookFilePath, "\npatches:\n", entry)
+	} else {
+		err = pluginutil.AppendCodeAtTheEndInFS(fs, kustomizeWebhookFilePath, "\npatches:\n"+entry)
+	}
+	if err != nil {
+		log.Warn("unable to add the patch with the selectors of the webhooks, add it to the patches of the file",
+			"file", kustomizeWebhookFilePath, "patch", path)
+	}
+}
+
 // uncommentCodeForConversionWebhooks enables CA injection logic in Kustomize manifests
 // for ConversionWebhooks by uncommenting certificate sources and CRD annotation targets.
 // This is required to make cert-manager correctly inject the CA bundle into CRDs.
-func uncommentCodeForConversionWebhooks(r resource.Resource) {
+func uncommentCodeForConversionWebhooks(fs machinery.Filesystem, r resource.Resource) {
 	crdName := fmt.Sprintf("%s.%s", r.Plural, r.QualifiedGroup())
-	err := pluginutil.UncommentCode(
-		kustomizeFilePath,
+	err := pluginutil.UncommentCodeInFS(
+		fs, kustomizeFilePath,
 		fmt.Sprintf(`# - source: # Uncomment the following block if you have a ConversionWebhook (--conversion)
 #     kind: Certificate
 #     group: cert-manager.io
@@ -194,8 +273,8 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 			"to inject the CA properly.",
 			"crdName", crdName, "file", kustomizeFilePath)
 	}
-	err = pluginutil.UncommentCode(
-		kustomizeFilePath,
+	err = pluginutil.UncommentCodeInFS(
+		fs, kustomizeFilePath,
 		fmt.Sprintf(`# - source:
 #     kind: Certificate
 #     group: cert-manager.io
@@ -221,10 +300,10 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 			"crdName", crdName, "file", kustomizeFilePath)
 	}
 
-	err = pluginutil.UncommentCode(kustomizeCRDFilePath, `#configurations:
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeCRDFilePath, `#configurations:
 #- kustomizeconfig.yaml`, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeCRDFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeCRDFilePath,
 			`configurations:
 - kustomizeconfig.yaml`)
 		if !hasWebHookUncommented || errCheck != nil {
@@ -235,43 +314,10 @@ func uncommentCodeForConversionWebhooks(r resource.Resource) {
 	}
 }
 
-func uncommentCodeForDefaultWebhooks() {
-	err := pluginutil.UncommentCode(
-		kustomizeFilePath,
-		`# - source: # Uncomment the following block if you have a DefaultingWebhook (--defaulting )
-#     kind: Certificate
-#     group: cert-manager.io
-#     version: v1
-#     name: serving-cert
-#     fieldPath: .metadata.namespace # Namespace of the certificate CR
-#   targets:
-#     - select:
-#         kind: MutatingWebhookConfiguration
-#       fieldPaths:
-#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
-#       options:
-#         delimiter: '/'
-#         index: 0
-#         create: true
-# - source:
-#     kind: Certificate
-#     group: cert-manager.io
-#     version: v1
-#     name: serving-cert
-#     fieldPath: .metadata.name
-#   targets:
-#     - select:
-#         kind: MutatingWebhookConfiguration
-#       fieldPaths:
-#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
-#       options:
-#         delimiter: '/'
-#         index: 1
-#         create: true`,
-		"#",
-	)
+func uncommentCodeForDefaultWebhooks(fs machinery.Filesystem) {
+	err := pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, defaultingWebhookReplacementsFragment, "#")
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			`   targets:
      - select:
          kind: MutatingWebhookConfiguration`)
@@ -284,43 +330,10 @@ func uncommentCodeForDefaultWebhooks() {
 	}
 }
 
-func uncommentCodeForValidationWebhooks() {
-	err := pluginutil.UncommentCode(
-		kustomizeFilePath,
-		`# - source: # Uncomment the following block if you have a ValidatingWebhook (--programmatic-validation)
-#     kind: Certificate
-#     group: cert-manager.io
-#     version: v1
-#     name: serving-cert # This name should match the one in certificate.yaml
-#     fieldPath: .metadata.namespace # Namespace of the certificate CR
-#   targets:
-#     - select:
-#         kind: ValidatingWebhookConfiguration
-#       fieldPaths:
-#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
-#       options:
-#         delimiter: '/'
-#         index: 0
-#         create: true
-# - source:
-#     kind: Certificate
-#     group: cert-manager.io
-#     version: v1
-#     name: serving-cert
-#     fieldPath: .metadata.name
-#   targets:
-#     - select:
-#         kind: ValidatingWebhookConfiguration
-#       fieldPaths:
-#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
-#       options:
-#         delimiter: '/'
-#         index: 1
-#         create: true`,
-		"#",
-	)
+func uncommentCodeForValidationWebhooks(fs machinery.Filesystem) {
+	err := pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, validationWebhookReplacementsFragment, "#")
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			`   targets:
      - select:
          kind: ValidatingWebhookConfiguration`)
@@ -333,30 +346,28 @@ func uncommentCodeForValidationWebhooks() {
 	}
 }
 
-func enableWebhookDefaults() {
-	err := pluginutil.UncommentCode(kustomizeFilePath, "#- ../webhook", `#`)
+func enableWebhookDefaults(fs machinery.Filesystem) {
+	err := pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, webhookResourceFragment, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "- ../webhook")
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath, "- ../webhook")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the target #- ../webhook to uncomment in the file",
 				"file", kustomizeFilePath)
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, "#patches:", `#`)
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, "#patches:", `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath, "patches:")
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath, "patches:")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the line '#patches:' to uncomment in the file",
 				"file", kustomizeFilePath)
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#- path: manager_webhook_patch.yaml
-#  target:
-#    kind: Deployment`, `#`)
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, managerWebhookPatchFragment, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			"- path: manager_webhook_patch.yaml")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the target #- path: manager_webhook_patch.yaml to uncomment in the file",
@@ -364,9 +375,9 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#- ../certmanager`, `#`)
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, certManagerResourceFragment, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			"../certmanager")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("unable to find the '../certmanager' section to uncomment in the file. "+
@@ -376,9 +387,9 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(kustomizeFilePath, `#replacements:`, `#`)
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, replacementsFragment, `#`)
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			"replacements:")
 		if !hasWebHookUncommented || errCheck != nil {
 			log.Warn("Unable to find the '#replacements:' section to uncomment in the file"+
@@ -388,48 +399,9 @@ func enableWebhookDefaults() {
 		}
 	}
 
-	err = pluginutil.UncommentCode(
-		kustomizeFilePath,
-		`# - source: # Uncomment the following block if you have any webhook
-#     kind: Service
-#     version: v1
-#     name: webhook-service
-#     fieldPath: .metadata.name # Name of the service
-#   targets:
-#     - select:
-#         kind: Certificate
-#         group: cert-manager.io
-#         version: v1
-#         name: serving-cert
-#       fieldPaths:
-#         - .spec.dnsNames.0
-#         - .spec.dnsNames.1
-#       options:
-#         delimiter: '.'
-#         index: 0
-#         create: true
-# - source:
-#     kind: Service
-#     version: v1
-#     name: webhook-service
-#     fieldPath: .metadata.namespace # Namespace of the service
-#   targets:
-#     - select:
-#         kind: Certificate
-#         group: cert-manager.io
-#         version: v1
-#         name: serving-cert
-#       fieldPaths:
-#         - .spec.dnsNames.0
-#         - .spec.dnsNames.1
-#       options:
-#         delimiter: '.'
-#         index: 1
-#         create: true`,
-		"#",
-	)
+	err = pluginutil.UncommentCodeInFS(fs, kustomizeFilePath, webhookServiceReplacementsFragment, "#")
 	if err != nil {
-		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWith(kustomizeFilePath,
+		hasWebHookUncommented, errCheck := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 			`     kind: Service
      version: v1
      name: webhook-service
@@ -443,9 +415,21 @@ func enableWebhookDefaults() {
 	}
 }
 
-func addNetworkPoliciesForWebhooks() {
+// addAdmissionPolicies adds the ValidatingAdmissionPolicies to the resources of config/default/kustomization.yaml
+func addAdmissionPolicies(fs machinery.Filesystem) {
+	hasPolicies, err := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath, "- ../admission-policy")
+	if err == nil && !hasPolicies {
+		err = pluginutil.InsertCodeInFS(fs, kustomizeFilePath, "- ../manager", admissionPoliciesFragment)
+	}
+	if err != nil {
+		log.Warn("unable to add '- ../admission-policy' to the resources of the file",
+			"file", kustomizeFilePath)
+	}
+}
+
+func addNetworkPoliciesForWebhooks(fs machinery.Filesystem) {
 	policyKustomizeFilePath := "config/network-policy/kustomization.yaml"
-	err := pluginutil.InsertCodeIfNotExist(policyKustomizeFilePath,
+	err := pluginutil.InsertCodeIfNotExistInFS(fs, policyKustomizeFilePath,
 		"resources:", allowWebhookTrafficFragment)
 	if err != nil {
 		log.Error("failed to add the line '- allow-webhook-traffic.yaml' at the end of the file "+
@@ -455,8 +439,8 @@ func addNetworkPoliciesForWebhooks() {
 
 // Deprecated: remove it when go/v4 and/or kustomize/v2 be removed
 // validateScaffoldedProject will output a message to help users fix their scaffold
-func validateScaffoldedProject() {
-	hasCertManagerPatch, _ := pluginutil.HasFileContentWith(kustomizeFilePath,
+func validateScaffoldedProject(fs machinery.Filesystem) {
+	hasCertManagerPatch, _ := pluginutil.HasFileContentWithInFS(fs, kustomizeFilePath,
 		"crdkustomizecainjectionpatch")
 
 	if hasCertManagerPatch {
@@ -516,3 +500,123 @@ the latest bug fixes and enhancements.
 
 const allowWebhookTrafficFragment = `
 - allow-webhook-traffic.yaml`
+
+const admissionPoliciesFragment = `
+# [ADMISSION POLICY] ValidatingAdmissionPolicies scaffolded with 'create webhook --backend=cel'.
+- ../admission-policy`
+
+// The following fragments of config/default/kustomization.yaml are uncommented for the webhooks served
+// by the manager, and commented again once the project has none.
+const (
+	webhookResourceFragment     = "#- ../webhook"
+	certManagerResourceFragment = "#- ../certmanager"
+	replacementsFragment        = "#replacements:"
+
+	// certMetricsPatchEntry is the line of the patch that makes the metrics endpoint require cert-manager
+	certMetricsPatchEntry = "- path: cert_metrics_manager_patch.yaml"
+)
+
+const managerWebhookPatchFragment = `#- path: manager_webhook_patch.yaml
+#  target:
+#    kind: Deployment`
+
+const webhookServiceReplacementsFragment = `# - source: # Uncomment the following block if you have any webhook
+#     kind: Service
+#     version: v1
+#     name: webhook-service
+#     fieldPath: .metadata.name # Name of the service
+#   targets:
+#     - select:
+#         kind: Certificate
+#         group: cert-manager.io
+#         version: v1
+#         name: serving-cert
+#       fieldPaths:
+#         - .spec.dnsNames.0
+#         - .spec.dnsNames.1
+#       options:
+#         delimiter: '.'
+#         index: 0
+#         create: true
+# - source:
+#     kind: Service
+#     version: v1
+#     name: webhook-service
+#     fieldPath: .metadata.namespace # Namespace of the service
+#   targets:
+#     - select:
+#         kind: Certificate
+#         group: cert-manager.io
+#         version: v1
+#         name: serving-cert
+#       fieldPaths:
+#         - .spec.dnsNames.0
+#         - .spec.dnsNames.1
+#       options:
+#         delimiter: '.'
+#         index: 1
+#         create: true`
+
+//nolint:lll
+const defaultingWebhookReplacementsFragment = `# - source: # Uncomment the following block if you have a DefaultingWebhook (--defaulting )
+#     kind: Certificate
+#     group: cert-manager.io
+#     version: v1
+#     name: serving-cert
+#     fieldPath: .metadata.namespace # Namespace of the certificate CR
+#   targets:
+#     - select:
+#         kind: MutatingWebhookConfiguration
+#       fieldPaths:
+#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
+#       options:
+#         delimiter: '/'
+#         index: 0
+#         create: true
+# - source:
+#     kind: Certificate
+#     group: cert-manager.io
+#     version: v1
+#     name: serving-cert
+#     fieldPath: .metadata.name
+#   targets:
+#     - select:
+#         kind: MutatingWebhookConfiguration
+#       fieldPaths:
+#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
+#       options:
+#         delimiter: '/'
+#         index: 1
+#         create: true`
+
+//nolint:lll
+const validationWebhookReplacementsFragment = `# - source: # Uncomment the following block if you have a ValidatingWebhook (--programmatic-validation)
+#     kind: Certificate
+#     group: cert-manager.io
+#     version: v1
+#     name: serving-cert # This name should match the one in certificate.yaml
+#     fieldPath: .metadata.namespace # Namespace of the certificate CR
+#   targets:
+#     - select:
+#         kind: ValidatingWebhookConfiguration
+#       fieldPaths:
+#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
+#       options:
+#         delimiter: '/'
+#         index: 0
+#         create: true
+# - source:
+#     kind: Certificate
+#     group: cert-manager.io
+#     version: v1
+#     name: serving-cert
+#     fieldPath: .metadata.name
+#   targets:
+#     - select:
+#         kind: ValidatingWebhookConfiguration
+#       fieldPaths:
+#         - .metadata.annotations.[cert-manager.io/inject-ca-from]
+#       options:
+#         delimiter: '/'
+#         index: 1
+#         create: true`
@@ -29,7 +29,7 @@ import (
 var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
 
 const (
-	defaultAPIVersion = "v1alpha1"
+	defaultAPIVersion = external.APIVersionV1Alpha1
 )
 
 type createAPISubcommand struct {
@@ -37,6 +37,7 @@ type createAPISubcommand struct {
 	Args        []string
 	pluginChain []string
 	config      config.Config
+	resource    *resource.Resource
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
@@ -63,8 +64,9 @@ func (p *createAPISubcommand) SetPluginChain(chain []string) {
 	p.pluginChain = append([]string(nil), chain...)
 }
 
-func (p *createAPISubcommand) InjectResource(*resource.Resource) error {
-	// Do nothing since resource flags are passed to the external plugin directly.
+func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
+	// The resource is shared with the plugins of the chain, so it is resolved when the request is sent
+	p.resource = res
 	return nil
 }
 
@@ -76,18 +78,25 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "api", p.Path, p.Args)
 }
 
+func (p *createAPISubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return handlePreScaffold(fs, p.request(), p.Path, p.config)
+}
+
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config)
+}
+
+func (p *createAPISubcommand) PostScaffold() error {
+	return handlePostScaffold(p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the external plugin for each phase of the subcommand
+func (p *createAPISubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create api",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
+		Resource:    p.resource,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
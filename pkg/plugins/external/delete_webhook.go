@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var _ plugin.DeleteWebhookSubcommand = &deleteWebhookSubcommand{}
+
+type deleteWebhookSubcommand struct {
+	Path        string
+	Args        []string
+	pluginChain []string
+	config      config.Config
+	resource    *resource.Resource
+}
+
+// InjectConfig injects the project configuration so external plugins can read the PROJECT file.
+func (p *deleteWebhookSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+
+	if c == nil {
+		return nil
+	}
+
+	if chain := c.GetPluginChain(); len(chain) > 0 {
+		p.pluginChain = append([]string(nil), chain...)
+	}
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) SetPluginChain(chain []string) {
+	if len(chain) == 0 {
+		p.pluginChain = nil
+		return
+	}
+
+	p.pluginChain = append([]string(nil), chain...)
+}
+
+func (p *deleteWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	// The resource is shared with the plugins of the chain, so it is resolved when the request is sent
+	p.resource = res
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) UpdateMetadata(_ plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	setExternalPluginMetadata("delete-webhook", p.Path, subcmdMeta)
+}
+
+func (p *deleteWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	bindExternalPluginFlags(fs, "delete-webhook", p.Path, p.Args)
+}
+
+func (p *deleteWebhookSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return handlePreScaffold(fs, p.request(), p.Path, p.config)
+}
+
+func (p *deleteWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	return handlePluginResponse(fs, p.request(), p.Path, p.config)
+}
+
+func (p *deleteWebhookSubcommand) PostScaffold() error {
+	return handlePostScaffold(p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the external plugin for each phase of the subcommand
+func (p *deleteWebhookSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
+		APIVersion:  defaultAPIVersion,
+		Command:     "delete webhook",
+		Args:        p.Args,
+		PluginChain: p.pluginChain,
+		Resource:    p.resource,
+	}
+}
@@ -68,18 +68,24 @@ func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "edit", p.Path, p.Args)
 }
 
+func (p *editSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return handlePreScaffold(fs, p.request(), p.Path, p.config)
+}
+
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config)
+}
+
+func (p *editSubcommand) PostScaffold() error {
+	return handlePostScaffold(p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the external plugin for each phase of the subcommand
+func (p *editSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "edit",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
@@ -0,0 +1,234 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// DefaultTimeout is the time external plugins have to answer a request, unless configured otherwise
+	DefaultTimeout = 10 * time.Minute
+
+	// stderrLines is the number of lines of the standard error of a failed plugin shown to the user
+	stderrLines = 20
+
+	// waitDelay is the time given to the children of a killed plugin to release its output before giving up
+	waitDelay = 2 * time.Second
+)
+
+// defaultEnv are the environment variables passed to the external plugins, in addition to the ones
+// allowed by each plugin. A trailing "*" matches any suffix.
+var defaultEnv = []string{
+	"PATH", "HOME", "USER", "LOGNAME", "SHELL", "TERM", "TZ", "LANG", "LC_*",
+	"TMPDIR", "TMP", "TEMP", "XDG_*",
+	"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy",
+	"GOPATH", "GOROOT", "GOBIN", "GOCACHE", "GOMODCACHE", "GOENV", "GOFLAGS", "GOTOOLCHAIN", "GOWORK",
+	"GOPROXY", "GOPRIVATE", "GONOPROXY", "GONOSUMDB", "GOSUMDB", "GOINSECURE", "GOOS", "GOARCH", "CGO_ENABLED",
+	"EXTERNAL_PLUGINS_PATH", "KUBEBUILDER_*",
+}
+
+// PluginError describes a failed execution of an external plugin
+type PluginError struct {
+	// Plugin is the name of the plugin
+	Plugin string
+	// Command is the command or method the plugin was called with
+	Command string
+	// ExitCode is the exit code of the plugin, or -1 if it did not exit by itself
+	ExitCode int
+	// Stderr holds the last lines written by the plugin to its standard error
+	Stderr []string
+	// Err is the cause of the failure
+	Err error
+}
+
+func (e *PluginError) Error() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "external plugin %q failed", e.Plugin)
+	if e.Command != "" {
+		_, _ = fmt.Fprintf(&b, " running %q", e.Command)
+	}
+	if e.ExitCode >= 0 {
+		_, _ = fmt.Fprintf(&b, " with exit code %d", e.ExitCode)
+	}
+	_, _ = fmt.Fprintf(&b, ": %v", e.Err)
+	if len(e.Stderr) > 0 {
+		_, _ = fmt.Fprintf(&b, "\nlast lines of its standard error:\n%s", strings.Join(e.Stderr, "\n"))
+	}
+	return b.String()
+}
+
+func (e *PluginError) Unwrap() error {
+	return e.Err
+}
+
+// execOptions are the options used to run an external plugin
+type execOptions struct {
+	name    string
+	timeout time.Duration
+	// env are the names of the environment variables passed to the plugin in addition to defaultEnv
+	env []string
+}
+
+// pluginExecOptions holds the options of the external plugins, keyed by path
+var pluginExecOptions = struct {
+	sync.Mutex
+	options map[string]execOptions
+}{options: map[string]execOptions{}}
+
+// registerExecOptions sets the options used to run the plugin at path
+func registerExecOptions(path string, opts execOptions) {
+	if opts.timeout <= 0 {
+		opts.timeout = DefaultTimeout
+	}
+
+	pluginExecOptions.Lock()
+	defer pluginExecOptions.Unlock()
+	pluginExecOptions.options[path] = opts
+}
+
+// execOptionsFor returns the options used to run the plugin at path
+func execOptionsFor(path string) execOptions {
+	pluginExecOptions.Lock()
+	defer pluginExecOptions.Unlock()
+
+	if opts, found := pluginExecOptions.options[path]; found {
+		return opts
+	}
+	return execOptions{name: filepath.Base(path), timeout: DefaultTimeout}
+}
+
+// context returns a context canceled once the timeout elapses or the user interrupts the CLI.
+// The interruption signals are only caught until the returned function is called.
+func (o execOptions) context() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	ctx, cancel := context.WithTimeout(ctx, o.timeout)
+	return ctx, func() {
+		cancel()
+		stop()
+	}
+}
+
+// contextError describes why ctx was canceled
+func (o execOptions) contextError(ctx context.Context) error {
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s", o.timeout)
+	}
+	return errors.New("interrupted")
+}
+
+// environ returns the environment variables of the CLI that can be passed to the plugin
+func (o execOptions) environ() []string {
+	allowed := append(slices.Clone(defaultEnv), o.env...)
+
+	env := make([]string, 0, len(allowed))
+	for _, kv := range os.Environ() {
+		name, _, _ := strings.Cut(kv, "=")
+		if slices.ContainsFunc(allowed, func(pattern string) bool { return matchEnv(pattern, name) }) {
+			env = append(env, kv)
+		}
+	}
+	return env
+}
+
+// matchEnv checks if the name of an environment variable matches pattern
+func matchEnv(pattern, name string) bool {
+	if prefix, found := strings.CutSuffix(pattern, "*"); found {
+		return strings.HasPrefix(name, prefix)
+	}
+	return pattern == name
+}
+
+// command returns the command running the plugin at path with ctx
+func (o execOptions) command(ctx context.Context, path string, stderr *tailBuffer) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, path) //nolint:gosec
+	cmd.Env = o.environ()
+	cmd.Stderr = stderr
+	cmd.WaitDelay = waitDelay
+	return cmd
+}
+
+// pluginError returns the error describing a failed execution of the plugin
+func (o execOptions) pluginError(err error, state *os.ProcessState, stderr *tailBuffer) *PluginError {
+	exitCode := -1
+	if state != nil {
+		exitCode = state.ExitCode()
+	}
+	return &PluginError{Plugin: o.name, ExitCode: exitCode, Stderr: stderr.Lines(), Err: err}
+}
+
+// tailBuffer is a writer holding the last lines written to it
+type tailBuffer struct {
+	mu      sync.Mutex
+	max     int
+	lines   []string
+	partial []byte
+}
+
+func newTailBuffer(maxLines int) *tailBuffer {
+	return &tailBuffer{max: maxLines}
+}
+
+func (b *tailBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.partial = append(b.partial, p...)
+	for {
+		i := bytes.IndexByte(b.partial, '\n')
+		if i < 0 {
+			break
+		}
+		b.add(string(b.partial[:i]))
+		b.partial = b.partial[i+1:]
+	}
+	return len(p), nil
+}
+
+func (b *tailBuffer) add(line string) {
+	b.lines = append(b.lines, strings.TrimSuffix(line, "\r"))
+	if len(b.lines) > b.max {
+		b.lines = b.lines[len(b.lines)-b.max:]
+	}
+}
+
+// Lines returns the last lines written, including the last one even if it is not terminated
+func (b *tailBuffer) Lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	lines := slices.Clone(b.lines)
+	if len(b.partial) > 0 {
+		lines = append(lines, string(b.partial))
+		if len(lines) > b.max {
+			lines = lines[1:]
+		}
+	}
+	return lines
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+var _ = Describe("Execution of the external plugins", func() {
+	var dir string
+
+	// writePlugin writes an executable shell script with the body and registers the options to run it
+	writePlugin := func(body string, opts execOptions) string {
+		path := filepath.Join(dir, opts.name)
+		Expect(os.WriteFile(path, []byte("#!/bin/sh\n"+body+"\n"), 0o755)).To(Succeed())
+		registerExecOptions(path, opts)
+		DeferCleanup(func() {
+			pluginExecOptions.Lock()
+			defer pluginExecOptions.Unlock()
+			delete(pluginExecOptions.options, path)
+		})
+		return path
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+
+		previous := outputGetter
+		outputGetter = &execOutputGetter{}
+		DeferCleanup(func() { outputGetter = previous })
+	})
+
+	It("should return the output of the plugin", func() {
+		path := writePlugin(`cat; echo "some warning" >&2`, execOptions{name: "echo"})
+
+		out, err := outputGetter.GetExecOutput([]byte("request"), path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(out)).To(Equal("request"))
+	})
+
+	It("should return the exit code and last lines of the standard error of a failed plugin", func() {
+		path := writePlugin(`for i in $(seq 1 30); do echo "line $i" >&2; done; exit 3`, execOptions{name: "failing"})
+
+		_, err := execPluginRequest(external.PluginRequest{Command: "create api"}, path)
+		var pluginErr *PluginError
+		Expect(err).To(BeAssignableToTypeOf(pluginErr))
+		pluginErr = err.(*PluginError)
+		Expect(pluginErr.Plugin).To(Equal("failing"))
+		Expect(pluginErr.Command).To(Equal("create api"))
+		Expect(pluginErr.ExitCode).To(Equal(3))
+		Expect(pluginErr.Stderr).To(HaveLen(stderrLines))
+		Expect(pluginErr.Stderr[0]).To(Equal("line 11"))
+		Expect(pluginErr.Stderr[stderrLines-1]).To(Equal("line 30"))
+		Expect(err.Error()).To(HavePrefix(`external plugin "failing" failed running "create api" with exit code 3: `))
+	})
+
+	It("should kill a plugin that does not answer in time", func() {
+		path := writePlugin("sleep 10", execOptions{name: "hanging", timeout: 100 * time.Millisecond})
+
+		start := time.Now()
+		_, err := outputGetter.GetExecOutput([]byte("request"), path)
+		Expect(time.Since(start)).To(BeNumerically("<", 5*time.Second))
+		Expect(err).To(MatchError(ContainSubstring("timed out after 100ms")))
+		Expect(err.(*PluginError).ExitCode).To(Equal(-1))
+	})
+
+	It("should kill a long-lived plugin that does not answer a call in time", func() {
+		path := writePlugin("cat > /dev/null", execOptions{name: "hanging", timeout: 100 * time.Millisecond})
+		DeferCleanup(func() { _ = closePlugin(path) })
+
+		_, err := callPlugin(path, external.MethodScaffold, external.PluginRequest{})
+		Expect(err).To(MatchError(ContainSubstring(`external plugin "hanging" failed running "scaffold"`)))
+		Expect(err).To(MatchError(ContainSubstring("timed out after 100ms")))
+	})
+
+	It("should only pass the allowed environment variables to the plugin", func() {
+		for name, value := range map[string]string{
+			"SECRET_TOKEN":    "secret",
+			"KUBEBUILDER_FOO": "foo",
+			"PLUGIN_VAR":      "bar",
+		} {
+			Expect(os.Setenv(name, value)).To(Succeed())
+			DeferCleanup(os.Unsetenv, name)
+		}
+		path := writePlugin(`echo "$SECRET_TOKEN|$KUBEBUILDER_FOO|$PLUGIN_VAR"`,
+			execOptions{name: "env", env: []string{"PLUGIN_*"}})
+
+		out, err := outputGetter.GetExecOutput(nil, path)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(strings.TrimSpace(string(out))).To(Equal("|foo|bar"))
+	})
+
+	It("should keep the last lines written to the standard error", func() {
+		b := newTailBuffer(2)
+		for i := range 3 {
+			_, _ = fmt.Fprintf(b, "line %d\n", i)
+		}
+		_, _ = b.Write([]byte("partial"))
+		Expect(b.Lines()).To(Equal([]string{"line 2", "partial"}))
+	})
+})
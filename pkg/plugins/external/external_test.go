@@ -65,6 +65,11 @@ var pluginChainTestCases = []struct {
 		new:  func() chainAwareSubcommand { return &createWebhookSubcommand{} },
 		get:  func(sub chainAwareSubcommand) []string { return sub.(*createWebhookSubcommand).pluginChain },
 	},
+	{
+		name: "delete webhook",
+		new:  func() chainAwareSubcommand { return &deleteWebhookSubcommand{} },
+		get:  func(sub chainAwareSubcommand) []string { return sub.(*deleteWebhookSubcommand).pluginChain },
+	},
 }
 
 func TestExternalPlugin(t *testing.T) {
@@ -276,6 +281,16 @@ var _ = Describe("Run external plugin using Scaffold", func() {
 			err = c.Scaffold(fs)
 			Expect(err).ToNot(HaveOccurred())
 		})
+
+		It("should successfully run delete webhook subcommand on the external plugin", func() {
+			c := deleteWebhookSubcommand{
+				Path: pluginFileName,
+				Args: args,
+			}
+
+			err = c.Scaffold(fs)
+			Expect(err).ToNot(HaveOccurred())
+		})
 	})
 
 	Context("with invalid mock values of GetExecOutput() and GetCurrentDir()", func() {
@@ -367,6 +382,24 @@ var _ = Describe("Run external plugin using Scaffold", func() {
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("error getting current directory"))
 		})
+
+		It("should return error upon running delete webhook subcommand on the external plugin", func() {
+			c := deleteWebhookSubcommand{
+				Path: pluginFileName,
+				Args: args,
+			}
+
+			err = c.Scaffold(fs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("error getting exec command output"))
+
+			outputGetter = &mockValidOutputGetter{}
+			currentDirGetter = &mockInValidOsWdGetter{}
+
+			err = c.Scaffold(fs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("error getting current directory"))
+		})
 	})
 
 	Context("with successfully getting flags from external plugin", func() {
@@ -441,6 +474,17 @@ var _ = Describe("Run external plugin using Scaffold", func() {
 			checkFlagset()
 		})
 
+		It("should successfully bind external plugin specified flags for `delete webhook` subcommand", func() {
+			sc := deleteWebhookSubcommand{
+				Path: pluginFileName,
+				Args: args,
+			}
+
+			sc.BindFlags(flagset)
+
+			checkFlagset()
+		})
+
 		It("should successfully bind external plugin specified flags for `edit` subcommand", func() {
 			sc := editSubcommand{
 				Path: pluginFileName,
@@ -0,0 +1,152 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// applyFileOperations applies the file operations returned by an external plugin, in order,
+// to the files of the project at root
+func applyFileOperations(fs afero.Fs, root string, ops []external.FileOperation) error {
+	for _, op := range ops {
+		path, err := operationPath(root, op.Path)
+		if err != nil {
+			return err
+		}
+		mode, err := parseFileMode(op.Mode)
+		if err != nil {
+			return fmt.Errorf("invalid mode of %q: %w", op.Path, err)
+		}
+
+		switch op.Op {
+		case "", external.FileOpWrite:
+			err = writeFile(fs, path, op, mode)
+		case external.FileOpDelete:
+			err = fs.RemoveAll(path)
+		case external.FileOpRename:
+			err = renameFile(fs, root, path, op, mode)
+		case external.FileOpChmod:
+			if mode == 0 {
+				return fmt.Errorf("missing mode to chmod %q", op.Path)
+			}
+			err = fs.Chmod(path, mode)
+		default:
+			return fmt.Errorf("unknown operation %q on %q", op.Op, op.Path)
+		}
+		if err != nil {
+			return fmt.Errorf("error applying %s operation on %q: %w", op.Op, op.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// writeFile writes the content of the operation to path, with the mode if not zero
+func writeFile(fs afero.Fs, path string, op external.FileOperation, mode os.FileMode) error {
+	content := []byte(op.Content)
+	switch op.Encoding {
+	case "":
+	case external.EncodingBase64:
+		var err error
+		if content, err = base64.StdEncoding.DecodeString(op.Content); err != nil {
+			return fmt.Errorf("error decoding content: %w", err)
+		}
+	default:
+		return fmt.Errorf("unknown encoding %q", op.Encoding)
+	}
+
+	if err := fs.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("error creating the directory: %w", err)
+	}
+	perm := mode
+	if perm == 0 {
+		perm = machinery.DefaultFilePermission
+	}
+	if err := afero.WriteFile(fs, path, content, perm); err != nil {
+		return fmt.Errorf("error writing file: %w", err)
+	}
+	// The mode of existing files is not changed by the write
+	if mode != 0 {
+		if err := fs.Chmod(path, mode); err != nil {
+			return fmt.Errorf("error setting mode: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// renameFile moves the file at path to the new path of the operation under root, with the mode if not zero
+func renameFile(fs afero.Fs, root, path string, op external.FileOperation, mode os.FileMode) error {
+	newPath, err := operationPath(root, op.NewPath)
+	if err != nil {
+		return err
+	}
+
+	if err = fs.MkdirAll(filepath.Dir(newPath), 0o750); err != nil {
+		return fmt.Errorf("error creating the directory: %w", err)
+	}
+	if err = fs.Rename(path, newPath); err != nil {
+		return fmt.Errorf("error renaming to %q: %w", op.NewPath, err)
+	}
+	if mode != 0 {
+		if err = fs.Chmod(newPath, mode); err != nil {
+			return fmt.Errorf("error setting mode: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// operationPath returns the path of a file of an operation under root, rejecting the paths out of it,
+// the root itself and the paths of the git repository
+func operationPath(root, path string) (string, error) {
+	if !filepath.IsLocal(path) {
+		return "", fmt.Errorf("path %q must be relative to the root of the project", path)
+	}
+	cleaned := filepath.Clean(path)
+	if cleaned == "." {
+		return "", fmt.Errorf("path %q must not be the root of the project", path)
+	}
+	// Case-insensitive filesystems resolve any casing to the git directory
+	if first, _, _ := strings.Cut(filepath.ToSlash(cleaned), "/"); strings.EqualFold(first, ".git") {
+		return "", fmt.Errorf("path %q must not be in the .git directory", path)
+	}
+	return filepath.Join(root, cleaned), nil
+}
+
+// parseFileMode parses an octal permission, returning zero if empty
+func parseFileMode(mode string) (os.FileMode, error) {
+	if mode == "" {
+		return 0, nil
+	}
+	perm, err := strconv.ParseUint(mode, 8, 32)
+	if err != nil || perm > 0o777 {
+		return 0, fmt.Errorf("%q is not an octal permission", mode)
+	}
+	return os.FileMode(perm), nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// mockResponseOutputGetter answers all the requests with the response
+type mockResponseOutputGetter struct {
+	response external.PluginResponse
+}
+
+func (m *mockResponseOutputGetter) GetExecOutput(_ []byte, _ string) ([]byte, error) {
+	return json.Marshal(m.response)
+}
+
+var _ = Describe("File operations of the external plugins", func() {
+	const root = "tmp/externalPlugin"
+
+	var (
+		fs     machinery.Filesystem
+		getter *mockResponseOutputGetter
+		sub    *editSubcommand
+	)
+
+	BeforeEach(func() {
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		Expect(afero.WriteFile(fs.FS, root+"/hack/old.sh", []byte("#!/bin/sh\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, root+"/hack/obsolete.txt", []byte("obsolete\n"), 0o644)).To(Succeed())
+		Expect(afero.WriteFile(fs.FS, root+"/bin/tool", []byte("tool"), 0o644)).To(Succeed())
+
+		getter = &mockResponseOutputGetter{}
+		outputGetter = getter
+		currentDirGetter = &mockValidOsWdGetter{}
+		sub = &editSubcommand{Path: "files-plugin"}
+	})
+
+	It("should write, delete, rename and chmod the files in order", func() {
+		getter.response = external.PluginResponse{
+			Universe: map[string]string{"README.md": "readme\n"},
+			Files: []external.FileOperation{
+				{Path: "README.md", Content: "updated readme\n"},
+				{Path: "assets/logo.png", Content: base64.StdEncoding.EncodeToString([]byte{0x89, 'P', 'N', 'G', 0x00}),
+					Encoding: external.EncodingBase64},
+				{Op: external.FileOpRename, Path: "hack/old.sh", NewPath: "scripts/new.sh", Mode: "0755"},
+				{Op: external.FileOpDelete, Path: "hack/obsolete.txt"},
+				{Op: external.FileOpChmod, Path: "bin/tool", Mode: "0700"},
+				{Op: external.FileOpWrite, Path: "hack/run.sh", Content: "#!/bin/sh\n", Mode: "0755"},
+			},
+		}
+
+		Expect(sub.Scaffold(fs)).To(Succeed())
+
+		content, err := afero.ReadFile(fs.FS, root+"/README.md")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("updated readme\n"))
+
+		content, err = afero.ReadFile(fs.FS, root+"/assets/logo.png")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(content).To(Equal([]byte{0x89, 'P', 'N', 'G', 0x00}))
+
+		Expect(afero.Exists(fs.FS, root+"/hack/old.sh")).To(BeFalse())
+		Expect(afero.Exists(fs.FS, root+"/hack/obsolete.txt")).To(BeFalse())
+		for path, mode := range map[string]os.FileMode{
+			root + "/scripts/new.sh": 0o755,
+			root + "/bin/tool":       0o700,
+			root + "/hack/run.sh":    0o755,
+		} {
+			info, err := fs.FS.Stat(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(mode), path)
+		}
+	})
+
+	DescribeTable("should reject invalid operations",
+		func(op external.FileOperation, message string) {
+			getter.response = external.PluginResponse{Files: []external.FileOperation{op}}
+
+			err := sub.Scaffold(fs)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(message))
+		},
+		Entry("with a path out of the project", external.FileOperation{Op: external.FileOpDelete, Path: "../secret"},
+			"must be relative to the root of the project"),
+		Entry("with an absolute path", external.FileOperation{Path: "/etc/passwd", Content: "x"},
+			"must be relative to the root of the project"),
+		Entry("with a new path out of the project",
+			external.FileOperation{Op: external.FileOpRename, Path: "hack/old.sh", NewPath: "../old.sh"},
+			"must be relative to the root of the project"),
+		Entry("with a delete of the root of the project", external.FileOperation{Op: external.FileOpDelete, Path: "."},
+			"must not be the root of the project"),
+		Entry("with a delete of a path cleaned to the root of the project",
+			external.FileOperation{Op: external.FileOpDelete, Path: "hack/.."},
+			"must not be the root of the project"),
+		Entry("with a rename of the root of the project",
+			external.FileOperation{Op: external.FileOpRename, Path: ".", NewPath: "old"},
+			"must not be the root of the project"),
+		Entry("with a chmod of the root of the project",
+			external.FileOperation{Op: external.FileOpChmod, Path: "./", Mode: "0700"},
+			"must not be the root of the project"),
+		Entry("with a delete of the git directory", external.FileOperation{Op: external.FileOpDelete, Path: ".git"},
+			"must not be in the .git directory"),
+		Entry("with a write in the git directory",
+			external.FileOperation{Path: "hack/../.git/hooks/pre-commit", Content: "x"},
+			"must not be in the .git directory"),
+		Entry("with a rename into the git directory",
+			external.FileOperation{Op: external.FileOpRename, Path: "hack/old.sh", NewPath: ".GIT/config"},
+			"must not be in the .git directory"),
+		Entry("with a chmod in the git directory",
+			external.FileOperation{Op: external.FileOpChmod, Path: ".git/config", Mode: "0777"},
+			"must not be in the .git directory"),
+		Entry("with an unknown operation", external.FileOperation{Op: "copy", Path: "hack/old.sh"},
+			`unknown operation "copy"`),
+		Entry("with an invalid mode", external.FileOperation{Op: external.FileOpChmod, Path: "bin/tool", Mode: "rwx"},
+			"is not an octal permission"),
+		Entry("with chmod without mode", external.FileOperation{Op: external.FileOpChmod, Path: "bin/tool"},
+			"missing mode"),
+		Entry("with an unknown encoding", external.FileOperation{Path: "a.txt", Content: "x", Encoding: "hex"},
+			`unknown encoding "hex"`),
+	)
+})
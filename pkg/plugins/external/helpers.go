@@ -19,11 +19,12 @@ package external
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	iofs "io/fs"
+	log "log/slog"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"slices"
 	"strconv"
@@ -55,15 +56,27 @@ type ExecOutputGetter interface {
 
 type execOutputGetter struct{}
 
+// GetExecOutput runs the plugin at path with the request. Its standard error is captured and
+// returned in a *PluginError if it fails, times out or is interrupted.
 func (e *execOutputGetter) GetExecOutput(request []byte, path string) ([]byte, error) {
-	cmd := exec.Command(path) //nolint:gosec
+	opts := execOptionsFor(path)
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	stderr := newTailBuffer(stderrLines)
+	cmd := opts.command(ctx, path, stderr)
 	cmd.Stdin = bytes.NewBuffer(request)
-	cmd.Stderr = os.Stderr
 	out, err := cmd.Output()
+	if ctx.Err() != nil {
+		return nil, opts.pluginError(opts.contextError(ctx), cmd.ProcessState, stderr)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error getting output for cmd %q: %w", cmd, err)
+		return nil, opts.pluginError(err, cmd.ProcessState, stderr)
 	}
 
+	if lines := stderr.Lines(); len(lines) > 0 {
+		log.Debug("External plugin standard error", "output", strings.Join(lines, "\n"))
+	}
 	return out, nil
 }
 
@@ -85,7 +98,36 @@ func (o *osWdGetter) GetCurrentDir() (string, error) {
 	return currentDir, nil
 }
 
+// makePluginRequest sends the request to the plugin at path with its protocol.
 func makePluginRequest(req external.PluginRequest, path string) (*external.PluginResponse, error) {
+	return makePluginCall(methodForCommand(req.Command), req, path)
+}
+
+// makePluginCall calls the method of the plugin at path with the request, running it once with the
+// request if it only supports the one-shot protocol.
+func makePluginCall(method string, req external.PluginRequest, path string) (*external.PluginResponse, error) {
+	var res *external.PluginResponse
+	var err error
+	if protocolFor(path).apiVersion == external.APIVersionRPCV1Alpha1 {
+		req.APIVersion = external.APIVersionRPCV1Alpha1
+		res, err = callPlugin(path, method, req)
+	} else {
+		res, err = execPluginRequest(req, path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error executing plugin request: %w", err)
+	}
+
+	// Error if the plugin failed.
+	if res.Error {
+		return nil, fmt.Errorf("%s", strings.Join(res.ErrorMsgs, "\n"))
+	}
+
+	return res, nil
+}
+
+// execPluginRequest runs the plugin at path with the request, using the one-shot protocol.
+func execPluginRequest(req external.PluginRequest, path string) (*external.PluginResponse, error) {
 	reqBytes, err := json.Marshal(req)
 	if err != nil {
 		return nil, fmt.Errorf("error marshalling plugin request: %w", err)
@@ -93,7 +135,11 @@ func makePluginRequest(req external.PluginRequest, path string) (*external.Plugi
 
 	out, err := outputGetter.GetExecOutput(reqBytes, path)
 	if err != nil {
-		return nil, fmt.Errorf("error executing plugin request: %w", err)
+		var pluginErr *PluginError
+		if errors.As(err, &pluginErr) && pluginErr.Command == "" {
+			pluginErr.Command = req.Command
+		}
+		return nil, err
 	}
 
 	res := external.PluginResponse{}
@@ -101,11 +147,6 @@ func makePluginRequest(req external.PluginRequest, path string) (*external.Plugi
 		return nil, fmt.Errorf("error unmarshalling plugin response: %w", err)
 	}
 
-	// Error if the plugin failed.
-	if res.Error {
-		return nil, fmt.Errorf("%s", strings.Join(res.ErrorMsgs, "\n"))
-	}
-
 	return &res, nil
 }
 
@@ -152,7 +193,92 @@ func getUniverseMap(fs machinery.Filesystem) (map[string]string, error) {
 	return universe, nil
 }
 
+// handlePluginResponse calls the plugin at path to scaffold, writing the files it returns.
+// Long-lived plugins are stopped if it fails, as the post-scaffold phase is not run.
 func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, path string, cfg config.Config) error {
+	if err := handlePluginCall(fs, methodForCommand(req.Command), req, path, cfg); err != nil {
+		return errors.Join(err, closePlugin(path))
+	}
+	return nil
+}
+
+// handlePreScaffold calls the pre-scaffold phase of the plugin at path, if it implements it, writing the
+// files it returns. With the one-shot protocol, the command of the request is pre-scaffold.
+// Long-lived plugins are stopped if it fails, as the post-scaffold phase is not run.
+func handlePreScaffold(fs machinery.Filesystem, req external.PluginRequest, path string, cfg config.Config) error {
+	protocol := protocolFor(path)
+	if !protocol.implements(external.CommandPreScaffold) {
+		return nil
+	}
+
+	if protocol.apiVersion != external.APIVersionRPCV1Alpha1 {
+		req.Subcommand, req.Command = req.Command, external.CommandPreScaffold
+	}
+	if err := handlePluginCall(fs, external.MethodPreScaffold, req, path, cfg); err != nil && !isMethodNotFound(err) {
+		return errors.Join(err, closePlugin(path))
+	}
+	return nil
+}
+
+// handlePostScaffold calls the post-scaffold phase of the plugin at path, if it implements it, once the files
+// are written. With the one-shot protocol, the command of the request is post-scaffold. Long-lived plugins
+// are stopped, even if the phase fails, as it is the last phase of the subcommand.
+func handlePostScaffold(req external.PluginRequest, path string, cfg config.Config) (err error) {
+	defer func() {
+		if closeErr := closePlugin(path); closeErr != nil {
+			err = errors.Join(err, closeErr)
+		}
+	}()
+
+	protocol := protocolFor(path)
+	if !protocol.implements(external.CommandPostScaffold) {
+		return nil
+	}
+
+	req.Universe = map[string]string{}
+	if req.Config, err = configMap(cfg); err != nil {
+		return err
+	}
+	if protocol.apiVersion != external.APIVersionRPCV1Alpha1 {
+		req.Subcommand, req.Command = req.Command, external.CommandPostScaffold
+	}
+
+	if _, err = makePluginCall(external.MethodPostScaffold, req, path); err != nil && !isMethodNotFound(err) {
+		return fmt.Errorf("error making request to external plugin: %w", err)
+	}
+	return nil
+}
+
+// isMethodNotFound returns true if the error is the answer of a plugin to a call of a method it does not implement
+func isMethodNotFound(err error) bool {
+	var rpcErr *external.RPCError
+	return errors.As(err, &rpcErr) && rpcErr.Code == external.RPCCodeMethodNotFound
+}
+
+// configMap returns the config as a map to include in the requests, or nil if there is none
+func configMap(cfg config.Config) (map[string]any, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	configData, err := cfg.MarshalYAML()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling config: %w", err)
+	}
+
+	var m map[string]any
+	if err = yaml.Unmarshal(configData, &m); err != nil {
+		return nil, fmt.Errorf("error unmarshaling config to map: %w", err)
+	}
+
+	return m, nil
+}
+
+// handlePluginCall calls the method of the plugin at path with the files of the filesystem and the config,
+// writing the files it returns.
+func handlePluginCall(
+	fs machinery.Filesystem, method string, req external.PluginRequest, path string, cfg config.Config,
+) error {
 	var err error
 
 	req.Universe, err = getUniverseMap(fs)
@@ -160,23 +286,11 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 		return fmt.Errorf("error getting universe map: %w", err)
 	}
 
-	// Marshal config to include in the request if config is provided
-	if cfg != nil {
-		var configData []byte
-		configData, err = cfg.MarshalYAML()
-		if err != nil {
-			return fmt.Errorf("error marshaling config: %w", err)
-		}
-
-		var configMap map[string]any
-		if err = yaml.Unmarshal(configData, &configMap); err != nil {
-			return fmt.Errorf("error unmarshaling config to map: %w", err)
-		}
-
-		req.Config = configMap
+	if req.Config, err = configMap(cfg); err != nil {
+		return err
 	}
 
-	res, err := makePluginRequest(req, path)
+	res, err := makePluginCall(method, req, path)
 	if err != nil {
 		return fmt.Errorf("error making request to external plugin: %w", err)
 	}
@@ -191,7 +305,7 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 		dir := filepath.Dir(file)
 
 		// create the directory if it does not exist
-		if err = os.MkdirAll(dir, 0o750); err != nil {
+		if err = fs.FS.MkdirAll(dir, 0o750); err != nil {
 			return fmt.Errorf("error creating the directory: %w", err)
 		}
 
@@ -200,17 +314,20 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 			return fmt.Errorf("error creating file %q: %w", file, createErr)
 		}
 
-		defer func() {
-			if err = f.Close(); err != nil {
-				return
-			}
-		}()
-
-		if _, err = f.Write([]byte(data)); err != nil {
+		// Closed before the file operations, which can rename it
+		_, err = f.Write([]byte(data))
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
 			return fmt.Errorf("error writing file %q: %w", file, err)
 		}
 	}
 
+	if err = applyFileOperations(fs.FS, currentDir, res.Files); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -219,7 +336,7 @@ func handlePluginResponse(fs machinery.Filesystem, req external.PluginRequest, p
 func getExternalPluginFlags(req external.PluginRequest, path string) ([]external.Flag, error) {
 	req.Universe = map[string]string{}
 
-	res, err := makePluginRequest(req, path)
+	res, err := makeStandalonePluginRequest(req, path)
 	if err != nil {
 		return nil, fmt.Errorf("error making request to external plugin: %w", err)
 	}
@@ -227,6 +344,16 @@ func getExternalPluginFlags(req external.PluginRequest, path string) ([]external
 	return res.Flags, nil
 }
 
+// makeStandalonePluginRequest sends the request to the plugin at path, stopping it if it is long-lived.
+// It is used for the requests made while building the CLI, which may not run the subcommand, e.g. with --help.
+func makeStandalonePluginRequest(req external.PluginRequest, path string) (*external.PluginResponse, error) {
+	res, err := makePluginRequest(req, path)
+	if closeErr := closePlugin(path); closeErr != nil {
+		return nil, errors.Join(err, closeErr)
+	}
+	return res, err
+}
+
 // isBooleanFlag is a helper function to determine if an argument flag is a boolean flag
 func isBooleanFlag(argIndex int, args []string) bool {
 	return argIndex+1 < len(args) &&
@@ -386,21 +513,34 @@ func setExternalPluginMetadata(subcommand, path string, subcmdMeta *plugin.Subco
 	}
 }
 
-// fetchExternalPluginMetadata performs the actual request to the
-// external plugin to get the metadata. It returns the metadata
-// or an error if an error occurs during the fetch process.
+// getExternalPluginMetadata performs the one-shot metadata request to the external plugin, which negotiates
+// the protocol used for the next requests: Kubebuilder sends the API versions it supports and switches to the
+// long-lived protocol only if the plugin answers with it. Plugins failing to answer use the one-shot protocol.
 func getExternalPluginMetadata(subcommand, path string) (*plugin.SubcommandMetadata, error) {
 	req := external.PluginRequest{
-		APIVersion: defaultAPIVersion,
-		Command:    "metadata",
-		Args:       []string{"--" + subcommand},
-		Universe:   map[string]string{},
+		APIVersion:  defaultAPIVersion,
+		Command:     "metadata",
+		Args:        []string{"--" + subcommand},
+		Universe:    map[string]string{},
+		APIVersions: supportedAPIVersions,
 	}
 
-	res, err := makePluginRequest(req, path)
+	protocol := pluginProtocol{apiVersion: external.APIVersionV1Alpha1}
+	res, err := execPluginRequest(req, path)
+	if err == nil && !res.Error {
+		if res.APIVersion == external.APIVersionRPCV1Alpha1 {
+			protocol.apiVersion = external.APIVersionRPCV1Alpha1
+		}
+		protocol.phases = res.Phases
+	}
+	registerProtocol(path, protocol)
+
 	if err != nil {
 		return nil, fmt.Errorf("error making request to external plugin: %w", err)
 	}
+	if res.Error {
+		return nil, fmt.Errorf("error making request to external plugin: %s", strings.Join(res.ErrorMsgs, "\n"))
+	}
 
 	return &res.Metadata, nil
 }
@@ -0,0 +1,172 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/afero"
+	"golang.org/x/mod/semver"
+	"sigs.k8s.io/yaml"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+)
+
+// ManifestFileName is the name of the optional manifest in the version directory of an external plugin
+const ManifestFileName = "plugin.yaml"
+
+// Names of the subcommands that external plugins can support
+const (
+	SubcommandInit          = "init"
+	SubcommandEdit          = "edit"
+	SubcommandCreateAPI     = "create api"
+	SubcommandCreateWebhook = "create webhook"
+	SubcommandDeleteWebhook = "delete webhook"
+)
+
+var supportedSubcommands = []string{
+	SubcommandInit,
+	SubcommandEdit,
+	SubcommandCreateAPI,
+	SubcommandCreateWebhook,
+	SubcommandDeleteWebhook,
+}
+
+// Manifest describes an external plugin, overriding what is inferred from its directory layout
+type Manifest struct {
+	// Name is the name of the plugin, which must match its directory name
+	Name string `json:"name,omitempty"`
+	// Version is the version of the plugin, which must match its version directory name
+	Version string `json:"version,omitempty"`
+	// Description is a short description of the plugin shown in the help output
+	Description string `json:"description,omitempty"`
+	// SupportedProjectVersions are the project versions supported by the plugin
+	SupportedProjectVersions []config.Version `json:"supportedProjectVersions,omitempty"`
+	// Subcommands are the subcommands supported by the plugin, all of them if empty
+	Subcommands []string `json:"subcommands,omitempty"`
+	// DeprecationMessage marks the plugin as deprecated and is shown to the users of the plugin
+	DeprecationMessage string `json:"deprecationMessage,omitempty"`
+	// RequiredKubebuilderVersion is the minimum version of kubebuilder required by the plugin
+	RequiredKubebuilderVersion string `json:"requiredKubebuilderVersion,omitempty"`
+	// Timeout is the time the plugin has to answer each request, e.g. "30s"
+	Timeout string `json:"timeout,omitempty"`
+	// Env are the names of the environment variables passed to the plugin in addition to the default ones,
+	// where a trailing "*" matches any suffix
+	Env []string `json:"env,omitempty"`
+	// Requires are the keys of the plugins that must run before the plugin
+	Requires []string `json:"requires,omitempty"`
+	// ConflictsWith are the keys of the plugins that cannot be used together with the plugin
+	ConflictsWith []string `json:"conflictsWith,omitempty"`
+}
+
+// LoadManifest reads and validates the manifest at path of the plugin found in the name/version directories
+func LoadManifest(fs afero.Fs, path, name, version string) (*Manifest, error) {
+	in, err := afero.ReadFile(fs, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %q file: %w", path, err)
+	}
+
+	m := &Manifest{}
+	if err = yaml.UnmarshalStrict(in, m); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal plugin manifest at %q: %w", path, err)
+	}
+
+	if err = m.validate(name, version); err != nil {
+		return nil, fmt.Errorf("invalid plugin manifest at %q: %w", path, err)
+	}
+
+	return m, nil
+}
+
+// validate checks that the manifest matches the plugin directories and can be used by this CLI
+func (m Manifest) validate(name, version string) error {
+	if m.Name != "" && m.Name != name {
+		return fmt.Errorf("name %q does not match the plugin directory %q", m.Name, name)
+	}
+	if m.Version != "" && m.Version != version {
+		return fmt.Errorf("version %q does not match the plugin version directory %q", m.Version, version)
+	}
+
+	if len(m.SupportedProjectVersions) != 0 && !slices.ContainsFunc(m.SupportedProjectVersions, config.IsRegistered) {
+		return fmt.Errorf("none of the supported project versions %v is supported by this CLI",
+			m.SupportedProjectVersions)
+	}
+
+	for _, subcommand := range m.Subcommands {
+		if !slices.Contains(supportedSubcommands, subcommand) {
+			return fmt.Errorf("unknown subcommand %q, supported subcommands are %q", subcommand, supportedSubcommands)
+		}
+	}
+
+	if m.RequiredKubebuilderVersion != "" && !semver.IsValid(canonicalVersion(m.RequiredKubebuilderVersion)) {
+		return fmt.Errorf("invalid required kubebuilder version %q", m.RequiredKubebuilderVersion)
+	}
+
+	for _, key := range slices.Concat(m.Requires, m.ConflictsWith) {
+		if err := plugin.ValidateKey(key); err != nil {
+			return fmt.Errorf("invalid plugin key %q: %w", key, err)
+		}
+	}
+
+	if m.Timeout != "" {
+		if timeout, err := time.ParseDuration(m.Timeout); err != nil || timeout <= 0 {
+			return fmt.Errorf("invalid timeout %q, expected a positive duration like \"30s\"", m.Timeout)
+		}
+	}
+
+	return nil
+}
+
+// Apply sets the metadata declared by the manifest on the plugin
+func (m Manifest) Apply(p *Plugin) {
+	p.PDescription = m.Description
+	p.PDeprecationWarning = m.DeprecationMessage
+	p.Subcommands = m.Subcommands
+	p.RequiredCLIVersion = m.RequiredKubebuilderVersion
+	p.Env = m.Env
+	p.PRequires = m.Requires
+	p.PConflictsWith = m.ConflictsWith
+	// The timeout was validated when loading the manifest
+	p.Timeout, _ = time.ParseDuration(m.Timeout)
+	if len(m.SupportedProjectVersions) != 0 {
+		p.PSupportedProjectVersions = m.SupportedProjectVersions
+	}
+}
+
+// CheckCLIVersion verifies that the provided CLI version satisfies the version required by the plugin.
+// Versions that are not semantic versions, like development builds, are always accepted.
+func (p Plugin) CheckCLIVersion(version string) error {
+	if p.RequiredCLIVersion == "" || !semver.IsValid(canonicalVersion(version)) {
+		return nil
+	}
+
+	if semver.Compare(canonicalVersion(version), canonicalVersion(p.RequiredCLIVersion)) < 0 {
+		return fmt.Errorf("plugin %q requires kubebuilder %s or newer, but the current version is %s",
+			p.Name(), p.RequiredCLIVersion, version)
+	}
+
+	return nil
+}
+
+// canonicalVersion adds the "v" prefix expected by semver to version
+func canonicalVersion(version string) string {
+	return "v" + strings.TrimPrefix(version, "v")
+}
@@ -17,17 +17,41 @@ limitations under the License.
 package external
 
 import (
+	"slices"
+	"time"
+
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full          = Plugin{}
+	_ plugin.DeleteWebhook = Plugin{}
+	_ plugin.Describable   = Plugin{}
+	_ plugin.Dependent     = Plugin{}
+	_ plugin.Conflicting   = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	PName                     string
 	PVersion                  plugin.Version
 	PSupportedProjectVersions []config.Version
+	PDescription              string
+	PDeprecationWarning       string
+	PRequires                 []string
+	PConflictsWith            []string
+
+	// Subcommands are the names of the subcommands supported by the plugin, all of them if empty.
+	// The getters of the unsupported subcommands return nil.
+	Subcommands []string
+	// RequiredCLIVersion is the minimum version of the CLI required by the plugin, if any
+	RequiredCLIVersion string
+	// Timeout is the time the plugin has to answer each request, DefaultTimeout if zero
+	Timeout time.Duration
+	// Env are the names of the environment variables passed to the plugin in addition to the default ones.
+	// A trailing "*" matches any suffix.
+	Env []string
 
 	Path string
 	Args []string
@@ -42,39 +66,87 @@ func (p Plugin) Version() plugin.Version { return p.PVersion }
 // SupportedProjectVersions returns an array with all project versions supported by the plugin
 func (p Plugin) SupportedProjectVersions() []config.Version { return p.PSupportedProjectVersions }
 
+// Description returns a short description of the plugin
+func (p Plugin) Description() string { return p.PDescription }
+
+// Requires returns the keys of the plugins that must run before the plugin
+func (p Plugin) Requires() []string { return p.PRequires }
+
+// ConflictsWith returns the keys of the plugins that cannot be used together with the plugin
+func (p Plugin) ConflictsWith() []string { return p.PConflictsWith }
+
+// path returns the path of the plugin executable, registering the options used to run it
+func (p Plugin) path() string {
+	registerExecOptions(p.Path, execOptions{name: p.PName, timeout: p.Timeout, env: p.Env})
+	return p.Path
+}
+
+// supports checks if the plugin supports the provided subcommand
+func (p Plugin) supports(subcommand string) bool {
+	return len(p.Subcommands) == 0 || slices.Contains(p.Subcommands, subcommand)
+}
+
 // GetInitSubcommand will return the subcommand which is responsible for initializing and common scaffolding
 func (p Plugin) GetInitSubcommand() plugin.InitSubcommand {
+	if !p.supports(SubcommandInit) {
+		return nil
+	}
+
 	return &initSubcommand{
-		Path: p.Path,
+		Path: p.path(),
 		Args: p.Args,
 	}
 }
 
 // GetCreateAPISubcommand will return the subcommand which is responsible for scaffolding apis
 func (p Plugin) GetCreateAPISubcommand() plugin.CreateAPISubcommand {
+	if !p.supports(SubcommandCreateAPI) {
+		return nil
+	}
+
 	return &createAPISubcommand{
-		Path: p.Path,
+		Path: p.path(),
 		Args: p.Args,
 	}
 }
 
 // GetCreateWebhookSubcommand will return the subcommand which is responsible for scaffolding webhooks
 func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
+	if !p.supports(SubcommandCreateWebhook) {
+		return nil
+	}
+
 	return &createWebhookSubcommand{
-		Path: p.Path,
+		Path: p.path(),
+		Args: p.Args,
+	}
+}
+
+// GetDeleteWebhookSubcommand will return the subcommand which is responsible for removing scaffolded webhooks
+func (p Plugin) GetDeleteWebhookSubcommand() plugin.DeleteWebhookSubcommand {
+	if !p.supports(SubcommandDeleteWebhook) {
+		return nil
+	}
+
+	return &deleteWebhookSubcommand{
+		Path: p.path(),
 		Args: p.Args,
 	}
 }
 
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand {
+	if !p.supports(SubcommandEdit) {
+		return nil
+	}
+
 	return &editSubcommand{
-		Path: p.Path,
+		Path: p.path(),
 		Args: p.Args,
 	}
 }
 
 // DeprecationWarning define the deprecation message or return empty when plugin is not deprecated
 func (p Plugin) DeprecationWarning() string {
-	return ""
+	return p.PDeprecationWarning
 }
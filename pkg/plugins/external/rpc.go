@@ -0,0 +1,293 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"os/exec"
+	"slices"
+	"sync"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// supportedAPIVersions are the protocols offered to the external plugins, by order of preference
+var supportedAPIVersions = []string{external.APIVersionRPCV1Alpha1, external.APIVersionV1Alpha1}
+
+var processStarter ProcessStarter = &execProcessStarter{}
+
+// ProcessStarter is an interface that implements the method to start a long-lived plugin process.
+type ProcessStarter interface {
+	// StartProcess starts the plugin at path, returning its stdin and stdout.
+	// Closing its stdout waits for the process to exit.
+	StartProcess(path string) (io.WriteCloser, io.ReadCloser, error)
+}
+
+type execProcessStarter struct{}
+
+func (e *execProcessStarter) StartProcess(path string) (io.WriteCloser, io.ReadCloser, error) {
+	opts := execOptionsFor(path)
+	stderr := newTailBuffer(stderrLines)
+	cmd := opts.command(context.Background(), path, stderr)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting stdin of %q: %w", path, err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting stdout of %q: %w", path, err)
+	}
+	if err = cmd.Start(); err != nil {
+		return nil, nil, fmt.Errorf("error starting %q: %w", path, err)
+	}
+
+	return stdin, &processOutput{ReadCloser: stdout, cmd: cmd, opts: opts, stderr: stderr}, nil
+}
+
+// pluginProcess is implemented by the stdout of the processes started by execProcessStarter
+type pluginProcess interface {
+	// kill stops the process without waiting for it
+	kill()
+	// failure returns the error describing the failure of the process, once it was waited for
+	failure(err error) *PluginError
+}
+
+// processOutput is the stdout of a process, waiting for it to exit when closed
+type processOutput struct {
+	io.ReadCloser
+	cmd    *exec.Cmd
+	opts   execOptions
+	stderr *tailBuffer
+}
+
+func (p *processOutput) Close() error {
+	if err := p.cmd.Wait(); err != nil {
+		return p.failure(err)
+	}
+	return nil
+}
+
+func (p *processOutput) kill() {
+	_ = p.cmd.Process.Kill()
+}
+
+func (p *processOutput) failure(err error) *PluginError {
+	return p.opts.pluginError(err, p.cmd.ProcessState, p.stderr)
+}
+
+// rpcClient calls the methods of a long-lived plugin process
+type rpcClient struct {
+	stdin  io.WriteCloser
+	stdout io.ReadCloser
+	enc    *json.Encoder
+	dec    *json.Decoder
+	lastID int64
+}
+
+func newRPCClient(stdin io.WriteCloser, stdout io.ReadCloser) *rpcClient {
+	return &rpcClient{stdin: stdin, stdout: stdout, enc: json.NewEncoder(stdin), dec: json.NewDecoder(stdout)}
+}
+
+// call calls the method of the plugin with the request, killing the plugin if ctx is canceled first
+func (c *rpcClient) call(ctx context.Context, method string, req external.PluginRequest) (
+	*external.PluginResponse, error,
+) {
+	type result struct {
+		res *external.PluginResponse
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		res, err := c.roundTrip(method, req)
+		done <- result{res: res, err: err}
+	}()
+
+	select {
+	case r := <-done:
+		return r.res, r.err
+	case <-ctx.Done():
+		if process, isProcess := c.stdout.(pluginProcess); isProcess {
+			process.kill()
+		}
+		return nil, ctx.Err()
+	}
+}
+
+// roundTrip writes the call of the method to the plugin and reads its response
+func (c *rpcClient) roundTrip(method string, req external.PluginRequest) (*external.PluginResponse, error) {
+	c.lastID++
+	if err := c.enc.Encode(external.NewRPCRequest(c.lastID, method, req)); err != nil {
+		return nil, fmt.Errorf("error writing %s call: %w", method, err)
+	}
+
+	var res external.RPCResponse
+	if err := c.dec.Decode(&res); err != nil {
+		return nil, fmt.Errorf("error reading %s response: %w", method, err)
+	}
+	if res.ID == nil || *res.ID != c.lastID {
+		return nil, fmt.Errorf("unexpected response to %s call %d", method, c.lastID)
+	}
+	if res.Error != nil {
+		return nil, res.Error
+	}
+	if res.Result == nil {
+		return nil, fmt.Errorf("empty response to %s call", method)
+	}
+
+	return res.Result, nil
+}
+
+// close closes the stdin of the plugin, which is expected to exit, and waits for it
+func (c *rpcClient) close() error {
+	if err := c.stdin.Close(); err != nil {
+		return fmt.Errorf("error closing stdin: %w", err)
+	}
+	if err := c.stdout.Close(); err != nil {
+		return fmt.Errorf("error closing stdout: %w", err)
+	}
+	return nil
+}
+
+// pluginProtocol is the protocol negotiated with a plugin
+type pluginProtocol struct {
+	apiVersion string
+	// phases are the optional phases declared by the plugin, or nil if it did not declare them
+	phases []string
+}
+
+// implements returns true if the phase of the plugin must be called. Long-lived plugins not declaring
+// their phases are called for all of them, answering with RPCCodeMethodNotFound for the missing ones.
+func (p pluginProtocol) implements(phase string) bool {
+	if p.phases == nil {
+		return p.apiVersion == external.APIVersionRPCV1Alpha1
+	}
+	return slices.Contains(p.phases, phase)
+}
+
+// pluginConnections holds the protocol of each plugin, keyed by path, and the clients of the long-lived
+// plugin processes, which are shared by the phases of a subcommand.
+var pluginConnections = struct {
+	sync.Mutex
+	protocols map[string]pluginProtocol
+	clients   map[string]*rpcClient
+}{protocols: map[string]pluginProtocol{}, clients: map[string]*rpcClient{}}
+
+// registerProtocol sets the protocol negotiated with the plugin at path
+func registerProtocol(path string, protocol pluginProtocol) {
+	pluginConnections.Lock()
+	defer pluginConnections.Unlock()
+	pluginConnections.protocols[path] = protocol
+}
+
+// protocolFor returns the protocol negotiated with the plugin at path, the one-shot protocol if not negotiated
+func protocolFor(path string) pluginProtocol {
+	pluginConnections.Lock()
+	defer pluginConnections.Unlock()
+
+	if protocol, found := pluginConnections.protocols[path]; found {
+		return protocol
+	}
+	return pluginProtocol{apiVersion: external.APIVersionV1Alpha1}
+}
+
+// callPlugin calls the method of the long-lived plugin at path, starting it the first time
+func callPlugin(path, method string, req external.PluginRequest) (*external.PluginResponse, error) {
+	pluginConnections.Lock()
+	defer pluginConnections.Unlock()
+
+	client, found := pluginConnections.clients[path]
+	if !found {
+		stdin, stdout, err := processStarter.StartProcess(path)
+		if err != nil {
+			return nil, err
+		}
+		client = newRPCClient(stdin, stdout)
+		pluginConnections.clients[path] = client
+	}
+
+	opts := execOptionsFor(path)
+	ctx, cancel := opts.context()
+	defer cancel()
+
+	res, err := client.call(ctx, method, req)
+	if err == nil {
+		return res, nil
+	}
+	var rpcErr *external.RPCError
+	if errors.As(err, &rpcErr) {
+		return nil, err
+	}
+
+	// The process can no longer be used, e.g. it exited or was killed
+	delete(pluginConnections.clients, path)
+	_ = client.close()
+	if ctx.Err() != nil {
+		err = opts.contextError(ctx)
+	}
+	if process, isProcess := client.stdout.(pluginProcess); isProcess {
+		pluginErr := process.failure(err)
+		pluginErr.Command = method
+		return nil, pluginErr
+	}
+	return nil, err
+}
+
+// closePlugin stops the long-lived plugin at path, if it was started
+func closePlugin(path string) error {
+	pluginConnections.Lock()
+	defer pluginConnections.Unlock()
+
+	client, found := pluginConnections.clients[path]
+	if !found {
+		return nil
+	}
+	delete(pluginConnections.clients, path)
+
+	if err := client.close(); err != nil {
+		return fmt.Errorf("error stopping external plugin %q: %w", path, err)
+	}
+	return nil
+}
+
+// StopPlugins stops the long-lived plugins that are still running, whose post-scaffold phase was not run,
+// e.g. because the subcommand failed or ran with --dry-run
+func StopPlugins() error {
+	pluginConnections.Lock()
+	paths := slices.Sorted(maps.Keys(pluginConnections.clients))
+	pluginConnections.Unlock()
+
+	var errs []error
+	for _, path := range paths {
+		errs = append(errs, closePlugin(path))
+	}
+	return errors.Join(errs...)
+}
+
+// methodForCommand returns the method of the long-lived protocol handling a one-shot command
+func methodForCommand(command string) string {
+	switch command {
+	case external.MethodMetadata, external.MethodFlags:
+		return command
+	default:
+		return external.MethodScaffold
+	}
+}
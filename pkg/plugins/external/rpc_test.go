@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package external
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/external"
+)
+
+// mockServeOutputGetter answers the one-shot requests with the handlers, accepting the long-lived
+// protocol unless oneShot is set
+type mockServeOutputGetter struct {
+	handlers map[string]external.Handler
+	requests []string
+	oneShot  bool
+}
+
+func (m *mockServeOutputGetter) GetExecOutput(req []byte, _ string) ([]byte, error) {
+	m.requests = append(m.requests, string(req))
+	if m.oneShot {
+		// Act as a plugin only supporting the one-shot protocol
+		var r external.PluginRequest
+		if err := json.Unmarshal(req, &r); err != nil {
+			return nil, err
+		}
+		r.APIVersions = nil
+		var err error
+		if req, err = json.Marshal(r); err != nil {
+			return nil, err
+		}
+	}
+	var out bytes.Buffer
+	if err := external.Serve(bytes.NewReader(req), &out, m.handlers); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// mockProcessStarter serves the long-lived plugin processes with the handlers
+type mockProcessStarter struct {
+	handlers map[string]external.Handler
+	started  int
+	// running is the number of processes whose stdout was not closed
+	running int
+}
+
+func (m *mockProcessStarter) StartProcess(_ string) (io.WriteCloser, io.ReadCloser, error) {
+	m.started++
+	m.running++
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	go func() {
+		_ = stdoutWriter.CloseWithError(external.Serve(stdinReader, stdoutWriter, m.handlers))
+	}()
+	return stdinWriter, &mockProcessOutput{ReadCloser: stdoutReader, starter: m}, nil
+}
+
+// mockProcessOutput is the stdout of a process started by mockProcessStarter
+type mockProcessOutput struct {
+	io.ReadCloser
+	starter *mockProcessStarter
+}
+
+func (m *mockProcessOutput) Close() error {
+	m.starter.running--
+	return m.ReadCloser.Close()
+}
+
+var _ = Describe("Long-lived external plugins", func() {
+	const path = "rpc-plugin"
+
+	var (
+		calls    []string
+		requests []external.PluginRequest
+		handlers map[string]external.Handler
+		getter   *mockServeOutputGetter
+		starter  *mockProcessStarter
+		fs       machinery.Filesystem
+	)
+
+	handle := func(method string, res external.PluginResponse) external.Handler {
+		return func(req external.PluginRequest) external.PluginResponse {
+			calls = append(calls, method+" "+req.APIVersion+" "+req.Command)
+			requests = append(requests, req)
+			return res
+		}
+	}
+
+	BeforeEach(func() {
+		calls = nil
+		requests = nil
+		handlers = map[string]external.Handler{
+			external.MethodMetadata: handle(external.MethodMetadata, external.PluginResponse{
+				Metadata: plugin.SubcommandMetadata{Description: "long-lived plugin"},
+			}),
+			external.MethodFlags: handle(external.MethodFlags, external.PluginResponse{
+				Flags: []external.Flag{{Name: "with-docs", Type: "bool"}},
+			}),
+			external.MethodPreScaffold: handle(external.MethodPreScaffold, external.PluginResponse{
+				Universe: map[string]string{"PRE": "pre\n"},
+			}),
+			external.MethodScaffold: handle(external.MethodScaffold, external.PluginResponse{
+				Universe: map[string]string{"LICENSE": "Apache 2.0 License\n"},
+			}),
+			external.MethodPostScaffold: handle(external.MethodPostScaffold, external.PluginResponse{}),
+		}
+		getter = &mockServeOutputGetter{handlers: handlers}
+		starter = &mockProcessStarter{handlers: handlers}
+
+		outputGetter = getter
+		processStarter = starter
+		currentDirGetter = &mockValidOsWdGetter{}
+		fs = machinery.Filesystem{FS: afero.NewMemMapFs()}
+		// The protocol is negotiated with the metadata request, which is not sent by most of the tests
+		registerProtocol(path, pluginProtocol{apiVersion: external.APIVersionRPCV1Alpha1})
+
+		DeferCleanup(func() {
+			processStarter = &execProcessStarter{}
+			Expect(closePlugin(path)).To(Succeed())
+			pluginConnections.Lock()
+			delete(pluginConnections.protocols, path)
+			pluginConnections.Unlock()
+		})
+	})
+
+	It("should negotiate the protocol with the metadata request and call the phases on a single process", func() {
+		sub := &editSubcommand{Path: path, Args: []string{"--with-docs"}}
+
+		// The metadata and the flags are requested while building the CLI, which may not run the subcommand
+		meta := plugin.SubcommandMetadata{}
+		sub.UpdateMetadata(plugin.CLIMetadata{}, &meta)
+		Expect(meta.Description).To(Equal("long-lived plugin"))
+		Expect(starter.started).To(BeZero())
+		Expect(getter.requests).To(HaveLen(1))
+		Expect(getter.requests[0]).To(ContainSubstring(`"apiVersions":["rpc/v1alpha1","v1alpha1"]`))
+		Expect(protocolFor(path)).To(Equal(pluginProtocol{
+			apiVersion: external.APIVersionRPCV1Alpha1,
+			phases:     []string{external.CommandPreScaffold, external.CommandPostScaffold},
+		}))
+
+		flags := pflag.NewFlagSet("edit", pflag.ContinueOnError)
+		sub.BindFlags(flags)
+		Expect(flags.Lookup("with-docs")).NotTo(BeNil())
+		Expect(starter.running).To(BeZero())
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(starter.running).To(Equal(1))
+		Expect(sub.PostScaffold()).To(Succeed())
+
+		Expect(getter.requests).To(HaveLen(1))
+		Expect(starter.started).To(Equal(2))
+		Expect(starter.running).To(BeZero())
+		Expect(calls).To(Equal([]string{
+			"metadata v1alpha1 metadata",
+			"flags rpc/v1alpha1 flags",
+			"preScaffold rpc/v1alpha1 edit",
+			"scaffold rpc/v1alpha1 edit",
+			"postScaffold rpc/v1alpha1 edit",
+		}))
+
+		content, err := afero.ReadFile(fs.FS, "tmp/externalPlugin/PRE")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("pre\n"))
+	})
+
+	It("should skip the phases the plugin does not implement", func() {
+		delete(handlers, external.MethodPreScaffold)
+		delete(handlers, external.MethodPostScaffold)
+		sub := &initSubcommand{Path: path}
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+		Expect(calls).To(Equal([]string{"scaffold rpc/v1alpha1 init"}))
+	})
+
+	It("should return the errors of the plugin", func() {
+		handlers[external.MethodScaffold] = handle(external.MethodScaffold, external.PluginResponse{
+			Error:     true,
+			ErrorMsgs: []string{"missing --kind"},
+		})
+		sub := &createAPISubcommand{Path: path}
+
+		err := sub.Scaffold(fs)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("missing --kind"))
+		// The post-scaffold phase is not run after a failure
+		Expect(starter.running).To(BeZero())
+	})
+
+	It("should stop the plugin when the post-scaffold phase fails", func() {
+		handlers[external.MethodPostScaffold] = handle(external.MethodPostScaffold, external.PluginResponse{
+			Error:     true,
+			ErrorMsgs: []string{"go mod tidy failed"},
+		})
+		sub := &initSubcommand{Path: path}
+
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(starter.running).To(Equal(1))
+		err := sub.PostScaffold()
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("go mod tidy failed"))
+		Expect(starter.running).To(BeZero())
+	})
+
+	It("should stop the plugins whose post-scaffold phase is not run", func() {
+		// The post-scaffold phase is skipped with --dry-run
+		sub := &initSubcommand{Path: path}
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(starter.running).To(Equal(1))
+		Expect(StopPlugins()).To(Succeed())
+		Expect(starter.running).To(BeZero())
+		Expect(calls).To(Equal([]string{"preScaffold rpc/v1alpha1 init", "scaffold rpc/v1alpha1 init"}))
+	})
+
+	It("should send the resource resolved by the chain", func() {
+		res := &resource.Resource{GVK: resource.GVK{Group: "crew", Domain: "testproject.org", Version: "v1", Kind: "Captain"}}
+		sub := &createAPISubcommand{Path: path}
+		Expect(sub.InjectResource(res)).To(Succeed())
+
+		// Plugins running before in the chain resolve the shared resource
+		res.Plural = "captains"
+		res.Path = "sigs.k8s.io/kubebuilder/testdata/project/api/v1"
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(requests).To(HaveLen(2))
+		for _, req := range requests {
+			Expect(req.Resource).To(Equal(res))
+		}
+	})
+
+	It("should call the phases declared by the one-shot plugins", func() {
+		getter.oneShot = true
+		delete(handlers, external.MethodPostScaffold)
+		res := &resource.Resource{GVK: resource.GVK{Group: "crew", Domain: "testproject.org", Version: "v1", Kind: "Captain"}}
+		sub := &createWebhookSubcommand{Path: path}
+		Expect(sub.InjectResource(res)).To(Succeed())
+
+		sub.UpdateMetadata(plugin.CLIMetadata{}, &plugin.SubcommandMetadata{})
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+
+		Expect(starter.started).To(BeZero())
+		Expect(calls).To(Equal([]string{
+			"metadata v1alpha1 metadata",
+			"preScaffold v1alpha1 pre-scaffold",
+			"scaffold v1alpha1 create webhook",
+		}))
+		Expect(requests[1].Subcommand).To(Equal("create webhook"))
+		Expect(requests[1].Resource).To(Equal(res))
+	})
+
+	It("should use the one-shot protocol with the plugins failing to negotiate", func() {
+		outputGetter = &mockValidOutputGetter{}
+		sub := &createWebhookSubcommand{Path: path}
+
+		sub.UpdateMetadata(plugin.CLIMetadata{}, &plugin.SubcommandMetadata{})
+		Expect(protocolFor(path)).To(Equal(pluginProtocol{apiVersion: external.APIVersionV1Alpha1}))
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+		Expect(starter.started).To(BeZero())
+
+		content, err := afero.ReadFile(fs.FS, "tmp/externalPlugin/LICENSE")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(content)).To(Equal("Apache 2.0 License\n"))
+	})
+
+	It("should only send the subcommand to the plugins whose metadata was not requested", func() {
+		pluginConnections.Lock()
+		delete(pluginConnections.protocols, path)
+		pluginConnections.Unlock()
+		sub := &createWebhookSubcommand{Path: path}
+
+		Expect(sub.PreScaffold(fs)).To(Succeed())
+		Expect(sub.Scaffold(fs)).To(Succeed())
+		Expect(sub.PostScaffold()).To(Succeed())
+		Expect(starter.started).To(BeZero())
+		Expect(getter.requests).To(HaveLen(1))
+		Expect(calls).To(Equal([]string{"scaffold v1alpha1 create webhook"}))
+	})
+
+	It("should answer the one-shot requests with Serve", func() {
+		var out bytes.Buffer
+		Expect(external.Serve(bytes.NewBufferString(`{"apiVersion":"v1alpha1","command":"create api"}`),
+			&out, handlers)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring(`"LICENSE":"Apache 2.0 License\n"`))
+		Expect(calls).To(Equal([]string{"scaffold v1alpha1 create api"}))
+	})
+})
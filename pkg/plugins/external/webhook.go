@@ -33,6 +33,7 @@ type createWebhookSubcommand struct {
 	Args        []string
 	pluginChain []string
 	config      config.Config
+	resource    *resource.Resource
 }
 
 // InjectConfig injects the project configuration so external plugins can read the PROJECT file.
@@ -59,8 +60,9 @@ func (p *createWebhookSubcommand) SetPluginChain(chain []string) {
 	p.pluginChain = append([]string(nil), chain...)
 }
 
-func (p *createWebhookSubcommand) InjectResource(*resource.Resource) error {
-	// Do nothing since resource flags are passed to the external plugin directly.
+func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	// The resource is shared with the plugins of the chain, so it is resolved when the request is sent
+	p.resource = res
 	return nil
 }
 
@@ -72,18 +74,25 @@ func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 	bindExternalPluginFlags(fs, "webhook", p.Path, p.Args)
 }
 
+func (p *createWebhookSubcommand) PreScaffold(fs machinery.Filesystem) error {
+	return handlePreScaffold(fs, p.request(), p.Path, p.config)
+}
+
 func (p *createWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
-	req := external.PluginRequest{
+	return handlePluginResponse(fs, p.request(), p.Path, p.config)
+}
+
+func (p *createWebhookSubcommand) PostScaffold() error {
+	return handlePostScaffold(p.request(), p.Path, p.config)
+}
+
+// request returns the request sent to the external plugin for each phase of the subcommand
+func (p *createWebhookSubcommand) request() external.PluginRequest {
+	return external.PluginRequest{
 		APIVersion:  defaultAPIVersion,
 		Command:     "create webhook",
 		Args:        p.Args,
 		PluginChain: p.pluginChain,
+		Resource:    p.resource,
 	}
-
-	err := handlePluginResponse(fs, req, p.Path, p.config)
-	if err != nil {
-		return err
-	}
-
-	return nil
 }
@@ -19,16 +19,18 @@ package v1alpha1
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
+	golangv4 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4"
 )
 
 const pluginName = "deploy-image." + golang.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 1, Stage: stage.Alpha}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
 var _ plugin.CreateAPI = Plugin{}
@@ -80,3 +82,11 @@ func (Plugin) Description() string {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// Requires returns the plugin that scaffolds the Go project the API and controller are added to
+func (Plugin) Requires() []string {
+	return []string{plugin.KeyFor(golangv4.Plugin{})}
+}
+
+// PluginConfig returns the configuration object tracking the scaffolded resources and their images
+func (Plugin) PluginConfig() any { return PluginConfig{} }
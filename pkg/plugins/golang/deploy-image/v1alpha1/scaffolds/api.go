@@ -150,14 +150,14 @@ func (s *apiScaffolder) Scaffold() error {
 // controller to create the Pod for the Kind
 func (s *apiScaffolder) addEnvVarIntoManager() error {
 	managerPath := filepath.Join("config", "manager", "manager.yaml")
-	err := util.ReplaceInFile(managerPath, `env:`, `env:`)
+	err := util.ReplaceInFileInFS(s.fs, managerPath, `env:`, `env:`)
 	if err != nil {
-		if err = util.InsertCode(managerPath, `name: manager`, "\n        env:"); err != nil {
+		if err = util.InsertCodeInFS(s.fs, managerPath, `name: manager`, "\n        env:"); err != nil {
 			return fmt.Errorf("error scaffolding env key in config/manager/manager.yaml")
 		}
 	}
 
-	if err = util.InsertCode(managerPath, `env:`,
+	if err = util.InsertCodeInFS(s.fs, managerPath, `env:`,
 		fmt.Sprintf(envVarTemplate, strings.ToUpper(s.resource.Kind), s.image)); err != nil {
 		return fmt.Errorf("error scaffolding env key in config/manager/manager.yaml")
 	}
@@ -182,8 +182,7 @@ func (s *apiScaffolder) scaffoldCreateAPI() error {
 // which will have its own controller template which set the recorder so that we can use it
 // in the reconciliation to create an event inside for the finalizer
 func (s *apiScaffolder) updateMainByAddingEventRecorder(defaultMainPath string) error {
-	if err := util.InsertCode(
-		defaultMainPath,
+	if err := util.InsertCodeInFS(s.fs, defaultMainPath,
 		fmt.Sprintf(
 			`%sReconciler{
 		Client: mgr.GetClient(),
@@ -198,8 +197,7 @@ func (s *apiScaffolder) updateMainByAddingEventRecorder(defaultMainPath string)
 
 // updateControllerCode will update the code generate on the template to add the Container information
 func (s *apiScaffolder) updateControllerCode(controller controllers.Controller) error {
-	if err := util.ReplaceInFile(
-		controller.Path,
+	if err := util.ReplaceInFileInFS(s.fs, controller.Path,
 		"//TODO: scaffold container",
 		fmt.Sprintf(containerTemplate, // value for the image
 			strings.ToLower(s.resource.Kind), // value for the name of the container
@@ -222,7 +220,7 @@ func (s *apiScaffolder) updateControllerCode(controller controllers.Controller)
 		// remove the first space to not fail in the go fmt ./...
 		res = strings.TrimLeft(res, " ")
 
-		if err := util.InsertCode(controller.Path, `SecurityContext: &corev1.SecurityContext{
+		if err := util.InsertCodeInFS(s.fs, controller.Path, `SecurityContext: &corev1.SecurityContext{
 							RunAsNonRoot:             ptr.To(true),
 							AllowPrivilegeEscalation: ptr.To(false),
 							Capabilities: &corev1.Capabilities{
@@ -238,8 +236,7 @@ func (s *apiScaffolder) updateControllerCode(controller controllers.Controller)
 
 	// Scaffold the port if informed
 	if len(s.port) > 0 {
-		if err := util.InsertCode(
-			controller.Path,
+		if err := util.InsertCodeInFS(s.fs, controller.Path,
 			`SecurityContext: &corev1.SecurityContext{
 							RunAsNonRoot:             ptr.To(true),
 							AllowPrivilegeEscalation: ptr.To(false),
@@ -261,8 +258,7 @@ func (s *apiScaffolder) updateControllerCode(controller controllers.Controller)
 	}
 
 	if len(s.runAsUser) > 0 {
-		if err := util.InsertCode(
-			controller.Path,
+		if err := util.InsertCodeInFS(s.fs, controller.Path,
 			`RunAsNonRoot:             ptr.To(true),`,
 			fmt.Sprintf(runAsUserTemplate, s.runAsUser),
 		); err != nil {
@@ -35,6 +35,7 @@ type Controller struct {
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	ControllerRuntimeVersion string
 
@@ -64,6 +65,12 @@ func (f *Controller) SetTemplateDefaults() error {
 	return nil
 }
 
+// NamespacedRBAC checks if the RBAC markers grant the permissions in the namespace of the manager only,
+// which is not the case when it watches a list of namespaces bound to its ClusterRole
+func (f *Controller) NamespacedRBAC() bool {
+	return f.Namespaced && len(f.WatchNamespaces) == 0
+}
+
 //nolint:lll
 const controllerTemplate = `{{ .Boilerplate }}
 
@@ -116,7 +123,7 @@ type {{ .Resource.Kind }}Reconciler struct {
 // when the command <make manifests> is executed.
 // To know more about markers see: https://book.kubebuilder.io/reference/markers.html
 
-{{ if .Namespaced -}}
+{{ if .NamespacedRBAC -}}
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/finalizers,verbs=update
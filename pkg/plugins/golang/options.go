@@ -17,6 +17,7 @@ limitations under the License.
 package golang
 
 import (
+	"fmt"
 	log "log/slog"
 	"path"
 	"strings"
@@ -25,6 +26,13 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 )
 
+const (
+	// ValidationBackendWebhook validates the resource with a validation webhook served by the manager
+	ValidationBackendWebhook = "webhook"
+	// ValidationBackendCEL validates the resource with a ValidatingAdmissionPolicy evaluated by the API server
+	ValidationBackendCEL = "cel"
+)
+
 var coreGroups = map[string]string{
 	"admission":             "k8s.io",
 	"admissionregistration": "k8s.io",
@@ -90,6 +98,27 @@ type Options struct {
 
 	// ValidationPath is the custom path for the validation webhook
 	ValidationPath string
+
+	// ValidationBackend is how the resource is validated, with a webhook (default) or with CEL expressions
+	ValidationBackend string
+
+	// FailurePolicy is the failure policy of the defaulting and validation webhooks
+	FailurePolicy string
+
+	// SideEffects are the side effects of the defaulting and validation webhooks
+	SideEffects string
+
+	// NamespaceSelector is the label selector of the namespaces whose objects are sent to the webhooks
+	NamespaceSelector string
+
+	// ObjectSelector is the label selector of the objects sent to the webhooks
+	ObjectSelector string
+
+	// Owns are the resources created and owned by the controller
+	Owns []resource.GVK
+
+	// Watches are the other resources watched by the controller
+	Watches []resource.GVK
 }
 
 // UpdateResource updates the provided resource with the options
@@ -121,7 +150,9 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 				res.Webhooks.DefaultingPath = opts.DefaultingPath
 			}
 		}
-		if opts.DoValidation {
+		if opts.DoValidation && opts.ValidationBackend == ValidationBackendCEL {
+			res.Webhooks.ValidatingAdmissionPolicy = true
+		} else if opts.DoValidation {
 			res.Webhooks.Validation = true
 			if opts.ValidationPath != "" {
 				res.Webhooks.ValidationPath = opts.ValidationPath
@@ -131,6 +162,18 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 			res.Webhooks.Conversion = true
 			res.Webhooks.Spoke = opts.Spoke
 		}
+		if opts.FailurePolicy != "" {
+			res.Webhooks.FailurePolicy = opts.FailurePolicy
+		}
+		if opts.SideEffects != "" {
+			res.Webhooks.SideEffects = opts.SideEffects
+		}
+		if opts.NamespaceSelector != "" {
+			res.Webhooks.NamespaceSelector = opts.NamespaceSelector
+		}
+		if opts.ObjectSelector != "" {
+			res.Webhooks.ObjectSelector = opts.ObjectSelector
+		}
 	}
 
 	if len(opts.ExternalAPIPath) > 0 {
@@ -173,6 +216,12 @@ func (opts Options) UpdateResource(res *resource.Resource, c config.Config) {
 // updateControllers applies controller-related options to the resource.
 // It handles both legacy (--controller) and new (--controller-name) controller creation.
 func (opts Options) updateControllers(res *resource.Resource) {
+	// Owned and watched resources are recorded on a named controller, named after the kind by default,
+	// which scaffolds the same files as a legacy controller
+	if opts.ControllerName == "" && (len(opts.Owns) != 0 || len(opts.Watches) != 0) {
+		opts.ControllerName = strings.ToLower(res.Kind)
+	}
+
 	if opts.ControllerName == "" {
 		// No controller name specified: use legacy mode
 		if res.Controllers == nil || res.Controllers.IsEmpty() {
@@ -202,4 +251,43 @@ func (opts Options) updateControllers(res *resource.Resource) {
 
 	// Add the new named controller (AddController validates and checks for duplicates)
 	_ = res.Controllers.AddController(opts.ControllerName)
+
+	_ = res.Controllers.Update(&resource.Controllers{{
+		Name:    opts.ControllerName,
+		Owns:    opts.Owns,
+		Watches: opts.Watches,
+	}})
+}
+
+// ParseGVK parses a resource referenced as <group>/<version>/<Kind>, e.g. apps/v1/Deployment or core/v1/Service.
+// The group is looked up in the resources of the project first and in the well-known core groups otherwise,
+// to find its domain. Other groups are not supported as the package where their types are defined is unknown.
+func ParseGVK(c config.Config, s string) (resource.GVK, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return resource.GVK{}, fmt.Errorf("invalid resource %q: expected <group>/<version>/<Kind>", s)
+	}
+	gvk := resource.GVK{Group: parts[0], Version: parts[1], Kind: parts[2]}
+
+	resources, err := c.GetResources()
+	if err != nil {
+		return resource.GVK{}, fmt.Errorf("failed to get resources: %w", err)
+	}
+	for _, res := range resources {
+		if res.Group == gvk.Group && res.Version == gvk.Version && res.Kind == gvk.Kind && res.Path != "" {
+			return res.GVK, nil
+		}
+	}
+
+	domain, found := coreGroups[gvk.Group]
+	if !found {
+		return resource.GVK{}, fmt.Errorf("invalid resource %q: the group is neither a Kubernetes core group "+
+			"nor the group of a resource of the project", s)
+	}
+	gvk.Domain = domain
+
+	if err = gvk.Validate(); err != nil {
+		return resource.GVK{}, fmt.Errorf("invalid resource %q: %w", s, err)
+	}
+	return gvk, nil
 }
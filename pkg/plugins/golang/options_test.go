@@ -125,6 +125,18 @@ var _ = Describe("Options", func() {
 				Options{DoAPI: true, DoDefaulting: true, DoValidation: true, DoConversion: true}),
 		)
 
+		It("should validate with a ValidatingAdmissionPolicy when the backend is CEL", func() {
+			options := Options{DoDefaulting: true, DoValidation: true, ValidationBackend: ValidationBackendCEL}
+
+			res := resource.Resource{GVK: gvk, Plural: "firstmates", API: &resource.API{}, Webhooks: &resource.Webhooks{}}
+			options.UpdateResource(&res, cfg)
+
+			Expect(res.Webhooks.Defaulting).To(BeTrue())
+			Expect(res.Webhooks.Validation).To(BeFalse())
+			Expect(res.Webhooks.ValidatingAdmissionPolicy).To(BeTrue())
+			Expect(res.Webhooks.WebhookVersion).To(Equal("v1"))
+		})
+
 		DescribeTable("should use core apis",
 			func(group, qualified string) {
 				options := Options{}
@@ -199,4 +211,84 @@ var _ = Describe("Options", func() {
 			Entry("for `authentication`", "authentication", "authentication.k8s.io"),
 		)
 	})
+
+	Context("UpdateResource with owned and watched resources", func() {
+		var (
+			cfg config.Config
+			res resource.Resource
+		)
+
+		deployment := resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+		configMap := resource.GVK{Group: "core", Version: "v1", Kind: "ConfigMap"}
+
+		BeforeEach(func() {
+			cfg = cfgv3.New()
+			_ = cfg.SetRepository("test")
+
+			res = resource.Resource{
+				GVK:      resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "FirstMate"},
+				Plural:   "firstmates",
+				API:      &resource.API{},
+				Webhooks: &resource.Webhooks{},
+			}
+		})
+
+		It("should record them on a controller named after the kind by default", func() {
+			options := Options{DoController: true, Owns: []resource.GVK{deployment}, Watches: []resource.GVK{configMap}}
+			options.UpdateResource(&res, cfg)
+
+			Expect(res.Controller).To(BeFalse())
+			Expect(*res.Controllers).To(Equal(resource.Controllers{{
+				Name:    "firstmate",
+				Owns:    []resource.GVK{deployment},
+				Watches: []resource.GVK{configMap},
+			}}))
+		})
+
+		It("should record them on the named controller", func() {
+			res.Controller = true
+			options := Options{DoController: true, ControllerName: "firstmate-backup", Owns: []resource.GVK{deployment}}
+			options.UpdateResource(&res, cfg)
+
+			Expect(*res.Controllers).To(Equal(resource.Controllers{
+				{Name: "firstmate"},
+				{Name: "firstmate-backup", Owns: []resource.GVK{deployment}},
+			}))
+		})
+	})
+
+	Context("ParseGVK", func() {
+		var cfg config.Config
+
+		BeforeEach(func() {
+			cfg = cfgv3.New()
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:  resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Sailor"},
+				Path: "test/api/v1",
+				API:  &resource.API{CRDVersion: "v1"},
+			})).To(Succeed())
+		})
+
+		DescribeTable("should succeed",
+			func(value string, expected resource.GVK) {
+				Expect(ParseGVK(cfg, value)).To(Equal(expected))
+			},
+			Entry("for a core resource", "core/v1/Service", resource.GVK{Group: "core", Version: "v1", Kind: "Service"}),
+			Entry("for a core resource with a domain", "networking/v1/Ingress",
+				resource.GVK{Group: "networking", Domain: "k8s.io", Version: "v1", Kind: "Ingress"}),
+			Entry("for a resource of the project", "crew/v1/Sailor",
+				resource.GVK{Group: "crew", Domain: "test.io", Version: "v1", Kind: "Sailor"}),
+		)
+
+		DescribeTable("should fail",
+			func(value string) {
+				_, err := ParseGVK(cfg, value)
+				Expect(err).To(HaveOccurred())
+			},
+			Entry("without a kind", "apps/v1"),
+			Entry("with an empty version", "apps//Deployment"),
+			Entry("for an unknown group", "cert-manager/v1/Certificate"),
+			Entry("for an unknown version of a resource of the project", "crew/v2/Sailor"),
+		)
+	})
 })
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	log "log/slog"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/spf13/pflag"
@@ -56,6 +57,14 @@ type createAPISubcommand struct {
 
 	// runMake indicates whether to run make or not after scaffolding APIs
 	runMake bool
+
+	// owns and watches reference the secondary resources of the controller as <group>/<version>/<Kind>
+	owns    []string
+	watches []string
+
+	// withFinalizer and withConditions scaffold the finalizer and status conditions logic in the controller
+	withFinalizer  bool
+	withConditions bool
 }
 
 func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
@@ -70,6 +79,13 @@ make generate will be run.
 	subcmdMeta.Examples = fmt.Sprintf(`  # Create a frigates API with Group: ship, Version: v1beta1 and Kind: Frigate
   %[1]s create api --group ship --version v1beta1 --kind Frigate
 
+  # Create a controller that owns the Deployments and Services it creates and watches ConfigMaps
+  %[1]s create api --group ship --version v1beta1 --kind Frigate \
+    --owns apps/v1/Deployment,core/v1/Service --watches core/v1/ConfigMap
+
+  # Create a controller that cleans up with a finalizer and reports status conditions
+  %[1]s create api --group ship --version v1beta1 --kind Frigate --with-finalizer --with-conditions
+
   # Edit the API Scheme
 
   nano api/v1beta1/frigate_types.go
@@ -127,6 +143,22 @@ func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
 
 	fs.StringVar(&p.options.ExternalAPIModule, "external-api-module", "",
 		"External API module with optional version (e.g., github.com/cert-manager/cert-manager@v1.18.2)")
+
+	fs.StringSliceVar(&p.owns, "owns", nil,
+		"Resources created and owned by the controller, as <group>/<version>/<Kind> "+
+			"(e.g., apps/v1/Deployment,core/v1/Service); the controller reconciles again when they change")
+
+	fs.StringSliceVar(&p.watches, "watches", nil,
+		"Other resources watched by the controller, as <group>/<version>/<Kind> (e.g., core/v1/ConfigMap); "+
+			"a function mapping them to the objects to reconcile is scaffolded")
+
+	fs.BoolVar(&p.withFinalizer, "with-finalizer", false,
+		"Scaffold a finalizer in the controller, with the logic adding it and removing it "+
+			"once the cleanup operations ran, and a test case deleting the resource")
+
+	fs.BoolVar(&p.withConditions, "with-conditions", false,
+		"Scaffold the controller logic reporting the status conditions of the resource "+
+			"(requires the Status.Conditions field scaffolded in the API types)")
 }
 
 func (p *createAPISubcommand) InjectConfig(c config.Config) error {
@@ -137,14 +169,17 @@ func (p *createAPISubcommand) InjectConfig(c config.Config) error {
 func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 	p.resource = res
 
+	// The answers are flagged as provided so that they are recorded along with the other flags
 	reader := bufio.NewReader(os.Stdin)
 	if !p.resourceFlag.Changed {
 		log.Info("Create Resource [y/n]")
 		p.options.DoAPI = util.YesNo(reader)
+		p.resourceFlag.Changed = true
 	}
 	if !p.controllerFlag.Changed {
 		log.Info("Create Controller [y/n]")
 		p.options.DoController = util.YesNo(reader)
+		p.controllerFlag.Changed = true
 	}
 
 	// When scaffolding a controller without an API (--resource=false), copy essential
@@ -177,6 +212,14 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 		return errors.New("'--external-api-module' requires '--external-api-path' to be specified")
 	}
 
+	if err := p.parseSecondaryResources(); err != nil {
+		return err
+	}
+
+	if err := p.validateReconcileOptions(); err != nil {
+		return err
+	}
+
 	p.options.UpdateResource(p.resource, p.config)
 
 	if err := p.resource.Validate(); err != nil {
@@ -190,6 +233,76 @@ func (p *createAPISubcommand) InjectResource(res *resource.Resource) error {
 	return p.validateController()
 }
 
+// parseSecondaryResources parses the resources owned and watched by the controller
+func (p *createAPISubcommand) parseSecondaryResources() error {
+	if len(p.owns) == 0 && len(p.watches) == 0 {
+		return nil
+	}
+	if !p.options.DoController {
+		return errors.New("'--owns' and '--watches' require a controller to be scaffolded with '--controller'")
+	}
+
+	var seen []resource.GVK
+	watchedKinds := make(map[string]struct{}, len(p.watches))
+	parse := func(flag string, values []string) ([]resource.GVK, error) {
+		gvks := make([]resource.GVK, 0, len(values))
+		for _, value := range values {
+			gvk, err := goPlugin.ParseGVK(p.config, value)
+			if err != nil {
+				return nil, fmt.Errorf("invalid '--%s' value: %w", flag, err)
+			}
+			if gvk.IsEqualTo(p.resource.GVK) {
+				return nil, fmt.Errorf("invalid '--%s' value %q: the resource is reconciled by the controller", flag, value)
+			}
+			if slices.ContainsFunc(seen, gvk.IsEqualTo) {
+				return nil, fmt.Errorf("resource %q can only be owned or watched once", value)
+			}
+			seen = append(seen, gvk)
+
+			if flag == "watches" {
+				// The kind names the function mapping the watched resource to the objects to reconcile
+				if _, found := watchedKinds[gvk.Kind]; found {
+					return nil, fmt.Errorf("only one resource of kind %q can be watched", gvk.Kind)
+				}
+				watchedKinds[gvk.Kind] = struct{}{}
+			}
+			gvks = append(gvks, gvk)
+		}
+		return gvks, nil
+	}
+
+	var err error
+	if p.options.Owns, err = parse("owns", p.owns); err != nil {
+		return err
+	}
+	if p.options.Watches, err = parse("watches", p.watches); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateReconcileOptions checks that the finalizer and status conditions logic can be scaffolded,
+// as it relies on the API types defined in the project
+func (p *createAPISubcommand) validateReconcileOptions() error {
+	if !p.withFinalizer && !p.withConditions {
+		return nil
+	}
+	if !p.options.DoController {
+		return errors.New(
+			"'--with-finalizer' and '--with-conditions' require a controller to be scaffolded with '--controller'")
+	}
+	if p.options.DoAPI {
+		return nil
+	}
+	if r, err := p.config.GetResource(p.resource.GVK); err != nil || !r.HasAPI() {
+		return errors.New("'--with-finalizer' and '--with-conditions' can only be used " +
+			"for resources whose API is defined in the project")
+	}
+
+	return nil
+}
+
 func (p *createAPISubcommand) validateAPI() error {
 	if !p.options.DoAPI {
 		return nil
@@ -300,7 +413,10 @@ func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
 }
 
 func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewAPIScaffolder(p.config, *p.resource, p.force)
+	scaffolder := scaffolds.NewAPIScaffolderWithOptions(p.config, *p.resource, p.force, scaffolds.APIOptions{
+		WithFinalizer:  p.withFinalizer,
+		WithConditions: p.withConditions,
+	})
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding API: %w", err)
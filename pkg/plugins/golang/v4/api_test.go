@@ -155,4 +155,107 @@ var _ = Describe("createAPISubcommand", func() {
 
 		Expect(subCmd.InjectResource(res)).To(Succeed())
 	})
+
+	Context("owned and watched resources", func() {
+		BeforeEach(func() {
+			subCmd.options.DoAPI = true
+			subCmd.options.DoController = true
+		})
+
+		It("should record them on the controller", func() {
+			subCmd.owns = []string{"apps/v1/Deployment", "core/v1/Service"}
+			subCmd.watches = []string{"core/v1/ConfigMap"}
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+
+			Expect(res.Controllers).NotTo(BeNil())
+			Expect(*res.Controllers).To(Equal(resource.Controllers{{
+				Name: "captain",
+				Owns: []resource.GVK{
+					{Group: "apps", Version: "v1", Kind: "Deployment"},
+					{Group: "core", Version: "v1", Kind: "Service"},
+				},
+				Watches: []resource.GVK{{Group: "core", Version: "v1", Kind: "ConfigMap"}},
+			}}))
+		})
+
+		It("should require a controller", func() {
+			subCmd.options.DoController = false
+			subCmd.owns = []string{"apps/v1/Deployment"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("require a controller")))
+		})
+
+		It("should reject unknown groups", func() {
+			subCmd.watches = []string{"cert-manager/v1/Certificate"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("invalid '--watches' value")))
+		})
+
+		It("should reject the reconciled resource", func() {
+			Expect(cfg.AddResource(resource.Resource{GVK: res.GVK, Path: "github.com/example/test/api/v1"})).
+				To(Succeed())
+			subCmd.force = true
+			subCmd.owns = []string{"crew/v1/Captain"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("the resource is reconciled by the controller")))
+		})
+
+		It("should reject a resource both owned and watched", func() {
+			subCmd.owns = []string{"apps/v1/Deployment"}
+			subCmd.watches = []string{"apps/v1/Deployment"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("can only be owned or watched once")))
+		})
+
+		It("should reject watching two resources of the same kind", func() {
+			subCmd.watches = []string{"apps/v1/Deployment", "extensions/v1beta1/Deployment"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring(`only one resource of kind "Deployment" can be watched`)))
+		})
+	})
+
+	Context("finalizer and status conditions", func() {
+		BeforeEach(func() {
+			subCmd.options.DoController = true
+			subCmd.withFinalizer = true
+			subCmd.withConditions = true
+		})
+
+		It("should allow them when creating the API", func() {
+			subCmd.options.DoAPI = true
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+		})
+
+		It("should allow them for an API already defined in the project", func() {
+			Expect(cfg.AddResource(resource.Resource{
+				GVK:  res.GVK,
+				Path: "github.com/example/test/api/v1",
+				API:  &resource.API{CRDVersion: "v1", Namespaced: true},
+			})).To(Succeed())
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+		})
+
+		It("should require a controller", func() {
+			subCmd.options.DoAPI = true
+			subCmd.options.DoController = false
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("require a controller")))
+		})
+
+		It("should reject resources whose API is not defined in the project", func() {
+			res.GVK = resource.GVK{Group: "apps", Version: "v1", Kind: "Deployment"}
+
+			err := subCmd.InjectResource(res)
+			Expect(err).To(MatchError(ContainSubstring("whose API is defined in the project")))
+		})
+	})
 })
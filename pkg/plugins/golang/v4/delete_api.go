@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.DeleteAPISubcommand = &deleteAPISubcommand{}
+
+type deleteAPISubcommand struct {
+	config config.Config
+
+	resource *resource.Resource
+
+	// runMake indicates whether to run make or not after removing the API
+	runMake bool
+}
+
+func (p *deleteAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Delete a Kubernetes API previously scaffolded with "create api".
+
+Removes the Go types, the controllers and their tests, and unwires them from
+cmd/main.go. Code shared with other resources, such as the group registration
+or the scheme of the controller test suite, is kept while still in use.
+The resource is removed from the PROJECT file.
+
+The webhooks of the resource must be removed first.
+
+After the files are removed, the dependencies will be updated and
+make generate will be run.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Delete the frigates API with Group: ship, Version: v1beta1 and Kind: Frigate
+  %[1]s delete api --group ship --version v1beta1 --kind Frigate
+
+  # Regenerate the manifests
+  make manifests
+`, cliMeta.CommandName)
+}
+
+func (p *deleteAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.runMake, "make", true,
+		"Run 'make generate' after removing files (enabled by default; use --make=false to disable)")
+}
+
+func (p *deleteAPISubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *deleteAPISubcommand) InjectResource(res *resource.Resource) error {
+	// The resource tracked in the PROJECT file is the source of truth of what was scaffolded
+	stored, err := plugins.LookupResource(p.config, res.GVK)
+	if err != nil {
+		return fmt.Errorf("error looking up resource: %w", err)
+	}
+
+	if stored.Webhooks != nil && !stored.Webhooks.IsEmpty() {
+		return errors.New("resource has webhooks scaffolded, remove its webhooks first")
+	}
+
+	// The version can not be removed while it is a spoke of the conversion webhook of the hub
+	resources, err := p.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	for _, r := range resources {
+		if r.QualifiedGroup() != stored.QualifiedGroup() || r.Kind != stored.Kind || r.Webhooks == nil {
+			continue
+		}
+		if slices.Contains(r.Webhooks.Spoke, stored.Version) {
+			return fmt.Errorf("version %s is a spoke of the conversion webhook of %s/%s/%s, "+
+				"remove the conversion webhook first", stored.Version, r.Group, r.Version, r.Kind)
+		}
+	}
+
+	*res = stored
+	p.resource = res
+
+	return nil
+}
+
+func (p *deleteAPISubcommand) PreScaffold(machinery.Filesystem) error {
+	// check if main.go is present in the root directory
+	if _, err := os.Stat(DefaultMainPath); os.IsNotExist(err) {
+		return fmt.Errorf("%s file should present in the root directory", DefaultMainPath)
+	}
+
+	return nil
+}
+
+func (p *deleteAPISubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewDeleteAPIScaffolder(p.config, *p.resource)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error deleting API: %w", err)
+	}
+
+	return nil
+}
+
+func (p *deleteAPISubcommand) PostScaffold() error {
+	err := util.RunCmd("Update dependencies", "go", "mod", "tidy")
+	if err != nil {
+		return fmt.Errorf("error updating go dependencies: %w", err)
+	}
+	if p.runMake && p.resource.HasAPI() {
+		err = util.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+		fmt.Print("Next: regenerate the manifests (e.g. CRDs, RBAC) with:\n$ make manifests\n")
+	}
+
+	return nil
+}
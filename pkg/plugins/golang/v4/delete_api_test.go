@@ -0,0 +1,125 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("deleteAPISubcommand", func() {
+	var (
+		subCmd *deleteAPISubcommand
+		cfg    config.Config
+		res    *resource.Resource
+		stored resource.Resource
+	)
+
+	BeforeEach(func() {
+		subCmd = &deleteAPISubcommand{}
+		cfg = cfgv3.New()
+		_ = cfg.SetRepository("github.com/example/test")
+
+		res = &resource.Resource{
+			GVK: resource.GVK{
+				Group:   "crew",
+				Domain:  "test.io",
+				Version: "v1",
+				Kind:    "Captain",
+			},
+			Plural:   "captains",
+			API:      &resource.API{},
+			Webhooks: &resource.Webhooks{},
+		}
+		stored = resource.Resource{
+			GVK:         res.GVK,
+			Plural:      "captains",
+			Path:        "github.com/example/test/api/v1",
+			API:         &resource.API{CRDVersion: "v1", Namespaced: true},
+			Controllers: &resource.Controllers{{Name: "captain"}},
+		}
+
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+	})
+
+	It("should fail if the resource is not tracked", func() {
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to find resource crew/v1/Captain"))
+	})
+
+	It("should inject the tracked resource", func() {
+		Expect(cfg.AddResource(stored)).To(Succeed())
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(subCmd.resource).To(Equal(res))
+		Expect(res.HasAPI()).To(BeTrue())
+		Expect(res.GetControllerNames()).To(Equal([]string{"captain"}))
+		Expect(res.Path).To(Equal("github.com/example/test/api/v1"))
+	})
+
+	It("should find external resources tracked with another domain", func() {
+		external := resource.Resource{
+			GVK: resource.GVK{
+				Group:   "cert-manager",
+				Domain:  "io",
+				Version: "v1",
+				Kind:    "Certificate",
+			},
+			Path:        "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1",
+			External:    true,
+			Controllers: &resource.Controllers{{Name: "certificate"}},
+		}
+		Expect(cfg.AddResource(external)).To(Succeed())
+
+		res.Group = "cert-manager"
+		res.Kind = "Certificate"
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.Domain).To(Equal("io"))
+		Expect(res.IsExternal()).To(BeTrue())
+	})
+
+	It("should refuse to delete a resource with webhooks", func() {
+		stored.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Defaulting: true}
+		Expect(cfg.AddResource(stored)).To(Succeed())
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("remove its webhooks first"))
+	})
+
+	It("should refuse to delete a spoke of a conversion webhook", func() {
+		hub := stored.Copy()
+		hub.Version = "v2"
+		hub.Path = "github.com/example/test/api/v2"
+		hub.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Conversion: true, Spoke: []string{"v1"}}
+		Expect(cfg.AddResource(stored)).To(Succeed())
+		Expect(cfg.AddResource(hub)).To(Succeed())
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("remove the conversion webhook first"))
+	})
+})
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/pflag"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin/util"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
+)
+
+var _ plugin.DeleteWebhookSubcommand = &deleteWebhookSubcommand{}
+
+type deleteWebhookSubcommand struct {
+	config config.Config
+
+	resource *resource.Resource
+
+	// doDefaulting, doValidation and doConversion indicate which webhook types to remove
+	doDefaulting bool
+	doValidation bool
+	doConversion bool
+
+	// runMake indicates whether to run make or not after removing the webhooks
+	runMake bool
+}
+
+func (p *deleteWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
+	subcmdMeta.Description = `Delete webhooks previously scaffolded with "create webhook".
+
+Only the selected webhook types are removed, the others are kept along with
+any changes made to their code. Once no webhook type is left, the webhook
+files are removed and unwired from cmd/main.go and the webhook test suite.
+The webhooks of the resource are updated in the PROJECT file. The sections of
+config/default/kustomization.yaml enabled for the removed webhook types are
+commented out again once no resource of the project uses them.
+
+After the files are updated, the dependencies will be updated and
+make generate will be run.
+`
+	subcmdMeta.Examples = fmt.Sprintf(`  # Delete the defaulting webhook for Group: ship, Version: v1beta1
+  # and Kind: Frigate, keeping any other webhook type
+  %[1]s delete webhook --group ship --version v1beta1 --kind Frigate --defaulting
+
+  # Delete all the webhooks for Group: ship, Version: v1beta1
+  # and Kind: Frigate
+  %[1]s delete webhook --group ship --version v1beta1 --kind Frigate \
+    --defaulting --programmatic-validation --conversion
+
+  # Regenerate the manifests
+  make manifests
+`, cliMeta.CommandName)
+}
+
+func (p *deleteWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.runMake, "make", true,
+		"Run 'make generate' after removing files (enabled by default; use --make=false to disable)")
+
+	fs.BoolVar(&p.doDefaulting, "defaulting", false,
+		"If set, remove the defaulting webhook")
+	fs.BoolVar(&p.doValidation, "programmatic-validation", false,
+		"If set, remove the validating webhook or the ValidatingAdmissionPolicy")
+	fs.BoolVar(&p.doConversion, "conversion", false,
+		"If set, remove the conversion webhook")
+}
+
+func (p *deleteWebhookSubcommand) InjectConfig(c config.Config) error {
+	p.config = c
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) InjectResource(res *resource.Resource) error {
+	if !p.doDefaulting && !p.doValidation && !p.doConversion {
+		return errors.New("resource webhook type is required, " +
+			"use one or more of --defaulting, --programmatic-validation and --conversion")
+	}
+
+	// The resource tracked in the PROJECT file is the source of truth of what was scaffolded
+	stored, err := plugins.LookupResource(p.config, res.GVK)
+	if err != nil {
+		return fmt.Errorf("error looking up resource: %w", err)
+	}
+
+	if p.doDefaulting && !stored.HasDefaultingWebhook() {
+		return errors.New("resource does not have a defaulting webhook")
+	}
+	if p.doValidation && !stored.HasValidationWebhook() && !stored.HasValidatingAdmissionPolicy() {
+		return errors.New("resource does not have a validating webhook nor a ValidatingAdmissionPolicy")
+	}
+	if p.doConversion && !stored.HasConversionWebhook() {
+		return errors.New("resource does not have a conversion webhook")
+	}
+
+	*res = stored
+	p.resource = res
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) PreScaffold(machinery.Filesystem) error {
+	// check if main.go is present in the root directory
+	if _, err := os.Stat(DefaultMainPath); os.IsNotExist(err) {
+		return fmt.Errorf("%s file should present in the root directory", DefaultMainPath)
+	}
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) Scaffold(fs machinery.Filesystem) error {
+	scaffolder := scaffolds.NewDeleteWebhookScaffolder(p.config, *p.resource,
+		p.doDefaulting, p.doValidation, p.doConversion)
+	scaffolder.InjectFS(fs)
+	if err := scaffolder.Scaffold(); err != nil {
+		return fmt.Errorf("error deleting webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (p *deleteWebhookSubcommand) PostScaffold() error {
+	err := util.RunCmd("Update dependencies", "go", "mod", "tidy")
+	if err != nil {
+		return fmt.Errorf("error updating go dependencies: %w", err)
+	}
+	if p.runMake {
+		err = util.RunCmd("Running make", "make", "generate")
+		if err != nil {
+			return fmt.Errorf("error running make generate: %w", err)
+		}
+		fmt.Print("Next: regenerate the manifests (e.g. CRDs, webhooks) with:\n$ make manifests\n")
+	}
+
+	return nil
+}
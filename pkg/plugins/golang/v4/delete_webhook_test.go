@@ -0,0 +1,119 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v4
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+var _ = Describe("deleteWebhookSubcommand", func() {
+	var (
+		subCmd *deleteWebhookSubcommand
+		cfg    config.Config
+		res    *resource.Resource
+	)
+
+	BeforeEach(func() {
+		subCmd = &deleteWebhookSubcommand{}
+		cfg = cfgv3.New()
+		_ = cfg.SetRepository("github.com/example/test")
+
+		res = &resource.Resource{
+			GVK: resource.GVK{
+				Group:   "crew",
+				Domain:  "test.io",
+				Version: "v1",
+				Kind:    "Captain",
+			},
+			Plural:   "captains",
+			API:      &resource.API{},
+			Webhooks: &resource.Webhooks{},
+		}
+		Expect(cfg.AddResource(resource.Resource{
+			GVK:    res.GVK,
+			Plural: "captains",
+			Path:   "github.com/example/test/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{
+				WebhookVersion: "v1",
+				Defaulting:     true,
+				DefaultingPath: "/custom-mutate",
+			},
+		})).To(Succeed())
+
+		Expect(subCmd.InjectConfig(cfg)).To(Succeed())
+	})
+
+	It("should fail if no webhook type is provided", func() {
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("resource webhook type is required"))
+	})
+
+	It("should fail if the resource is not tracked", func() {
+		subCmd.doDefaulting = true
+		res.Kind = "FirstMate"
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unable to find resource crew/v1/FirstMate"))
+	})
+
+	It("should fail if the webhook type was not scaffolded", func() {
+		subCmd.doDefaulting = true
+		subCmd.doValidation = true
+
+		err := subCmd.InjectResource(res)
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("resource does not have a validating webhook"))
+	})
+
+	It("should inject the tracked resource", func() {
+		subCmd.doDefaulting = true
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(subCmd.resource).To(Equal(res))
+		Expect(res.HasDefaultingWebhook()).To(BeTrue())
+		Expect(res.Webhooks.DefaultingPath).To(Equal("/custom-mutate"))
+		Expect(res.Path).To(Equal("github.com/example/test/api/v1"))
+	})
+
+	It("should remove the ValidatingAdmissionPolicy with --programmatic-validation", func() {
+		Expect(cfg.UpdateResource(resource.Resource{
+			GVK:    res.GVK,
+			Plural: "captains",
+			Path:   "github.com/example/test/api/v1",
+			API:    &resource.API{CRDVersion: "v1", Namespaced: true},
+			Webhooks: &resource.Webhooks{
+				WebhookVersion:            "v1",
+				ValidatingAdmissionPolicy: true,
+			},
+		})).To(Succeed())
+		subCmd.doValidation = true
+
+		Expect(subCmd.InjectResource(res)).To(Succeed())
+		Expect(res.HasValidatingAdmissionPolicy()).To(BeTrue())
+	})
+})
@@ -17,11 +17,14 @@ limitations under the License.
 package v4
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/spf13/pflag"
+	"golang.org/x/mod/module"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
@@ -34,12 +37,15 @@ var _ plugin.EditSubcommand = &editSubcommand{}
 type editSubcommand struct {
 	config config.Config
 
-	multigroup  bool
-	namespaced  bool
-	force       bool
-	licenseFile string
-	license     string
-	owner       string
+	multigroup      bool
+	namespaced      bool
+	watchNamespaces []string
+	force           bool
+	licenseFile     string
+	license         string
+	owner           string
+	domain          string
+	repo            string
 
 	// fs stores the FlagSet to check if flags were explicitly set
 	fs *pflag.FlagSet
@@ -67,7 +73,28 @@ Namespaced (--namespaced):
   Webhooks remain cluster-scoped even in namespace-scoped mode.
   The manager cache is restricted to WATCH_NAMESPACE, but webhooks receive requests
   from ALL namespaces. You must configure namespaceSelector or objectSelector to align
-  webhook scope with the cache.
+  webhook scope with the cache, e.g. with 'create webhook --force --namespace-selector'.
+
+Watch namespaces (--watch-namespaces):
+  Set the list of namespaces watched by a namespace-scoped manager, which is deployed to its own namespace.
+  Automatic: Updates PROJECT file, scaffolds a RoleBinding in each namespace for the manager ClusterRole,
+             the kustomize replacements that deploy them and the WATCH_NAMESPACE env var of manager.yaml
+  Manual: Update defaultWatchNamespaces in cmd/main.go, remove namespace= from RBAC markers, run 'make manifests'
+  Use --watch-namespaces= to watch the namespace of the manager only.
+
+Domain (--domain):
+  Change the domain of the project, which is the suffix of the API groups of its resources.
+  Automatic: Updates PROJECT file, groupversion_info.go files, RBAC and webhook markers,
+             kustomize config (CRD names and file names, RBAC, samples, webhooks) and the leader election ID
+  Manual: Run 'make manifests generate', reinstall the CRDs and recreate custom resources in your clusters
+  Resources from external APIs and Kubernetes core types keep their API groups; the command fails if
+  one of them belongs to an API group that would be renamed.
+
+Repository (--repo):
+  Change the Go module path of the project.
+  Automatic: Updates PROJECT file, go.mod and the import paths of all Go files
+  Manual: Run 'go mod tidy'
+  The command fails if a resource from an external API is imported from the current module path.
 
 Force (--force):
   Overwrite existing scaffolded files to apply configuration changes.
@@ -86,6 +113,9 @@ Note: To add optional plugins after initialization, use 'kubebuilder edit --plug
   # Enable with automatic file regeneration
   %[1]s edit --namespaced --force
 
+  # Watch a list of namespaces with a namespace-scoped manager
+  %[1]s edit --namespaced --watch-namespaces=tenant-a,tenant-b
+
   # Disable multigroup layout
   %[1]s edit --multigroup=false
 
@@ -97,6 +127,12 @@ Note: To add optional plugins after initialization, use 'kubebuilder edit --plug
 
   # Update license header to built-in apache2
   %[1]s edit --license apache2 --owner "Your Company"
+
+  # Change the domain (e.g., crew.my.domain becomes crew.example.org)
+  %[1]s edit --domain example.org
+
+  # Change the Go module path
+  %[1]s edit --repo github.com/example/new-project
 `, cliMeta.CommandName)
 }
 
@@ -106,6 +142,9 @@ func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 		"Enable or disable multigroup layout (organize APIs by group); use --multigroup=false to disable")
 	fs.BoolVar(&p.namespaced, "namespaced", false,
 		"Enable or disable namespace-scoped deployment (default: cluster-scoped); use --namespaced=false to disable")
+	fs.StringSliceVar(&p.watchNamespaces, "watch-namespaces", nil,
+		"Comma-separated list of namespaces watched by a namespace-scoped manager; "+
+			"use --watch-namespaces= to watch the namespace of the manager only")
 	fs.BoolVar(&p.force, "force", false, "If set, overwrite scaffolded files to apply changes (manual edits may be lost)")
 	fs.StringVar(&p.licenseFile, "license-file", "",
 		"Path to custom license file; content copied to hack/boilerplate.go.txt")
@@ -113,6 +152,10 @@ func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 		"License header to use for boilerplate (e.g., apache2, none) "+
 			"(see: https://book.kubebuilder.io/reference/license-header)")
 	fs.StringVar(&p.owner, "owner", "", "Owner name for copyright license headers")
+	fs.StringVar(&p.domain, "domain", "",
+		"New domain for the APIs of the project (e.g., example.org); rewrites their API groups")
+	fs.StringVar(&p.repo, "repo", "",
+		"New Go module path of the project (e.g., github.com/user/repo); rewrites the import paths")
 }
 
 func (p *editSubcommand) InjectConfig(c config.Config) error {
@@ -149,6 +192,17 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 		}
 	}
 
+	if p.domain != "" {
+		if errs := validation.IsDNS1123Subdomain(p.domain); len(errs) > 0 {
+			return fmt.Errorf("invalid domain %q: %s", p.domain, strings.Join(errs, "; "))
+		}
+	}
+	if p.repo != "" {
+		if err := module.CheckPath(p.repo); err != nil {
+			return fmt.Errorf("invalid repository %q: %w", p.repo, err)
+		}
+	}
+
 	// If flags were not explicitly set, preserve existing PROJECT file values
 	// This prevents one flag from clearing another when using default values
 	// Only when FlagSet was bound (e.g. from CLI); tests may call PreScaffold without BindFlags
@@ -159,13 +213,34 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 		if !p.fs.Changed("namespaced") {
 			p.namespaced = p.config.IsNamespaced()
 		}
+		// Watched namespaces are dropped along with namespace-scoped deployment
+		if !p.fs.Changed("watch-namespaces") && p.namespaced {
+			p.watchNamespaces = p.config.GetWatchNamespaces()
+		}
+	}
+
+	if len(p.watchNamespaces) > 0 {
+		if !p.namespaced {
+			return errors.New("'--watch-namespaces' requires namespace-scoped deployment, enable it with '--namespaced'")
+		}
+		if err := validateWatchNamespaces(p.watchNamespaces); err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	scaffolder := scaffolds.NewEditScaffolder(p.config, p.multigroup, p.namespaced, p.force,
+	if p.domain != "" || p.repo != "" {
+		renamer := scaffolds.NewRenameScaffolder(p.config, p.domain, p.repo)
+		renamer.InjectFS(fs)
+		if err := renamer.Scaffold(); err != nil {
+			return fmt.Errorf("failed to rename project: %w", err)
+		}
+	}
+
+	scaffolder := scaffolds.NewEditScaffolder(p.config, p.multigroup, p.namespaced, p.watchNamespaces, p.force,
 		p.license, p.owner, p.licenseFile)
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
@@ -27,7 +27,9 @@ import (
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds"
 )
 
@@ -137,6 +139,53 @@ var _ = Describe("editSubcommand", func() {
 			Expect(subCmd.multigroup).To(BeTrue(), "multigroup should be preserved from PROJECT file")
 			Expect(subCmd.namespaced).To(BeTrue(), "namespaced should be preserved from PROJECT file")
 		})
+
+		It("should preserve the watched namespaces when the flag is not set", func() {
+			Expect(cfg.SetNamespaced()).To(Succeed())
+			Expect(cfg.SetWatchNamespaces([]string{"tenant-a"})).To(Succeed())
+
+			Expect(subCmd.PreScaffold(mockFS)).To(Succeed())
+
+			Expect(subCmd.watchNamespaces).To(Equal([]string{"tenant-a"}))
+		})
+
+		It("should drop the watched namespaces when disabling namespaced", func() {
+			Expect(cfg.SetNamespaced()).To(Succeed())
+			Expect(cfg.SetWatchNamespaces([]string{"tenant-a"})).To(Succeed())
+			Expect(fs.Set("namespaced", "false")).To(Succeed())
+
+			Expect(subCmd.PreScaffold(mockFS)).To(Succeed())
+
+			Expect(subCmd.watchNamespaces).To(BeEmpty())
+		})
+
+		It("should fail to set watched namespaces on a cluster-scoped project", func() {
+			Expect(fs.Set("watch-namespaces", "tenant-a,tenant-b")).To(Succeed())
+
+			err := subCmd.PreScaffold(mockFS)
+			Expect(err).To(MatchError(ContainSubstring("requires namespace-scoped deployment")))
+		})
+
+		It("should fail with an invalid domain", func() {
+			Expect(fs.Set("domain", "Example_Org")).To(Succeed())
+
+			err := subCmd.PreScaffold(mockFS)
+			Expect(err).To(MatchError(ContainSubstring(`invalid domain "Example_Org"`)))
+		})
+
+		It("should fail with an invalid repository", func() {
+			Expect(fs.Set("repo", "github.com/example/project name")).To(Succeed())
+
+			err := subCmd.PreScaffold(mockFS)
+			Expect(err).To(MatchError(ContainSubstring(`invalid repository "github.com/example/project name"`)))
+		})
+
+		It("should accept a valid domain and repository", func() {
+			Expect(fs.Set("domain", "example.org")).To(Succeed())
+			Expect(fs.Set("repo", "github.com/example/project")).To(Succeed())
+
+			Expect(subCmd.PreScaffold(mockFS)).To(Succeed())
+		})
 	})
 
 	Context("Boilerplate update", func() {
@@ -179,7 +228,7 @@ Updated License Header.
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "", "", customLicensePath)
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false, "", "", customLicensePath)
 			scaffolder.InjectFS(fs)
 			err = scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -197,7 +246,7 @@ Updated License Header.
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "apache2", "New Owner", "")
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false, "apache2", "New Owner", "")
 			scaffolder.InjectFS(fs)
 			err := scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -216,7 +265,7 @@ Updated License Header.
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "", "", "")
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false, "", "", "")
 			scaffolder.InjectFS(fs)
 			err := scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -237,7 +286,8 @@ Updated License Header.
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "apache2", "Test Owner", customLicensePath)
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false,
+				"apache2", "Test Owner", customLicensePath)
 			scaffolder.InjectFS(fs)
 			err = scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -266,7 +316,7 @@ Fixed License Header.
 
 			// Pass owner flag - it should be ignored when license-file is provided
 			scaffolder := scaffolds.NewEditScaffolder(
-				testCfg, false, false, false, "apache2", "Ignored Owner", customLicensePath)
+				testCfg, false, false, nil, false, "apache2", "Ignored Owner", customLicensePath)
 			scaffolder.InjectFS(fs)
 			err = scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -320,7 +370,7 @@ this is my license
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "", "", customLicensePath)
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false, "", "", customLicensePath)
 			scaffolder.InjectFS(fs)
 			err = scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -353,7 +403,7 @@ Custom License Header
 			_ = testCfg.SetRepository("github.com/test/repo")
 			_ = testCfg.SetDomain("test.io")
 
-			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, false, "apache2", "New Company", "")
+			scaffolder := scaffolds.NewEditScaffolder(testCfg, false, false, nil, false, "apache2", "New Company", "")
 			scaffolder.InjectFS(fs)
 			err = scaffolder.Scaffold()
 			Expect(err).NotTo(HaveOccurred())
@@ -524,4 +574,207 @@ Custom License Header
 			Expect(err).NotTo(HaveOccurred())
 		})
 	})
+
+	Context("Renaming the project", func() {
+		const (
+			oldDomain = "example.com"
+			oldRepo   = "github.com/example/project"
+		)
+
+		var (
+			cfg      config.Config
+			fs       machinery.Filesystem
+			captain  resource.Resource
+			external resource.Resource
+		)
+
+		writeFile := func(path, content string) {
+			Expect(afero.WriteFile(fs.FS, path, []byte(content), 0o644)).To(Succeed())
+		}
+
+		readFile := func(path string) string {
+			content, err := afero.ReadFile(fs.FS, path)
+			Expect(err).NotTo(HaveOccurred())
+			return string(content)
+		}
+
+		BeforeEach(func() {
+			cfg = cfgv3.New()
+			Expect(cfg.SetDomain(oldDomain)).To(Succeed())
+			Expect(cfg.SetRepository(oldRepo)).To(Succeed())
+
+			captain = resource.Resource{
+				GVK:      resource.GVK{Group: "crew", Domain: oldDomain, Version: "v1", Kind: "Captain"},
+				Plural:   "captains",
+				Path:     oldRepo + "/api/v1",
+				API:      &resource.API{CRDVersion: "v1", Namespaced: true},
+				Webhooks: &resource.Webhooks{WebhookVersion: "v1", Defaulting: true},
+			}
+			external = resource.Resource{
+				GVK:        resource.GVK{Group: "cert-manager", Domain: "io", Version: "v1", Kind: "Certificate"},
+				Plural:     "certificates",
+				Path:       "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1",
+				External:   true,
+				Controller: true,
+			}
+			Expect(cfg.AddResource(captain)).To(Succeed())
+			Expect(cfg.AddResource(external)).To(Succeed())
+
+			fs = machinery.Filesystem{FS: afero.NewMemMapFs(), Manifest: &machinery.Manifest{}}
+			writeFile("go.mod", "module "+oldRepo+"\n\nrequire github.com/example/project-utils v1.0.0\n")
+			writeFile("cmd/main.go", `package main
+
+import (
+	"github.com/example/project-utils/log"
+	crewv1 "`+oldRepo+`/api/v1"
+)
+
+var _ = Options{
+	LeaderElectionID: "1234abcd.`+oldDomain+`",
+}
+`)
+			writeFile("api/v1/groupversion_info.go", `// +groupName=crew.`+oldDomain+`
+package v1
+
+var GroupVersion = schema.GroupVersion{Group: "crew.`+oldDomain+`", Version: "v1"}
+`)
+			writeFile("internal/webhook/v1/captain_webhook.go",
+				"// +kubebuilder:webhook:path=/mutate-crew-example-com-v1-captain,groups=crew."+oldDomain+"\n")
+			writeFile("config/crd/bases/crew."+oldDomain+"_captains.yaml", "name: captains.crew."+oldDomain+"\n")
+			writeFile("config/crd/kustomization.yaml", "resources:\n- bases/crew."+oldDomain+"_captains.yaml\n")
+			writeFile("config/rbac/captain_admin_role.yaml", "# Grants full permissions ('*') over crew."+oldDomain+".\n"+
+				"- apiGroups:\n  - crew."+oldDomain+"\n  - crew."+oldDomain+".au\n  - cert-manager.io\n  - mycrew."+oldDomain+"\n")
+			writeFile("go.sum", oldDomain+"/dependency v1.0.0 h1:abc=\n")
+			fs.Manifest.Record("config/crd/bases/crew."+oldDomain+"_captains.yaml", "go.kubebuilder.io/v4", "crd",
+				[]byte("name: captains.crew."+oldDomain+"\n"))
+		})
+
+		It("should do nothing if the domain and the repository do not change", func() {
+			s := scaffolds.NewRenameScaffolder(cfg, oldDomain, "")
+			s.InjectFS(fs)
+			Expect(s.Scaffold()).To(Succeed())
+
+			Expect(readFile("cmd/main.go")).To(ContainSubstring(oldRepo))
+			Expect(cfg.GetDomain()).To(Equal(oldDomain))
+		})
+
+		It("should rename the API groups owned by the project", func() {
+			s := scaffolds.NewRenameScaffolder(cfg, "example.org", "")
+			s.InjectFS(fs)
+			Expect(s.Scaffold()).To(Succeed())
+
+			Expect(readFile("api/v1/groupversion_info.go")).To(And(
+				ContainSubstring("+groupName=crew.example.org"),
+				ContainSubstring(`Group: "crew.example.org"`),
+			))
+			Expect(readFile("cmd/main.go")).To(ContainSubstring(`LeaderElectionID: "1234abcd.example.org"`))
+			Expect(readFile("internal/webhook/v1/captain_webhook.go")).To(Equal(
+				"// +kubebuilder:webhook:path=/mutate-crew-example-org-v1-captain,groups=crew.example.org\n"))
+			Expect(readFile("config/crd/kustomization.yaml")).To(ContainSubstring("bases/crew.example.org_captains.yaml"))
+			Expect(readFile("config/rbac/captain_admin_role.yaml")).To(And(
+				ContainSubstring("over crew.example.org.\n"),
+				ContainSubstring("  - crew.example.org\n"),
+				ContainSubstring("  - crew."+oldDomain+".au\n"),
+				ContainSubstring("  - cert-manager.io\n"),
+				ContainSubstring("  - mycrew."+oldDomain+"\n"),
+			))
+			Expect(readFile("go.sum")).To(HavePrefix(oldDomain))
+
+			By("renaming the CRD files")
+			Expect(readFile("config/crd/bases/crew.example.org_captains.yaml")).To(Equal("name: captains.crew.example.org\n"))
+			_, err := fs.FS.Stat("config/crd/bases/crew." + oldDomain + "_captains.yaml")
+			Expect(err).To(HaveOccurred())
+
+			By("keeping the renamed files tracked by the scaffold manifest")
+			_, tracked := fs.Manifest.Get("config/crd/bases/crew." + oldDomain + "_captains.yaml")
+			Expect(tracked).To(BeFalse())
+			entry, tracked := fs.Manifest.Get("config/crd/bases/crew.example.org_captains.yaml")
+			Expect(tracked).To(BeTrue())
+			Expect(entry.Template).To(Equal("crd"))
+
+			By("updating the project configuration")
+			Expect(cfg.GetDomain()).To(Equal("example.org"))
+			resources, err := cfg.GetResources()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(HaveLen(2))
+			Expect(resources[0].GVK).To(Equal(resource.GVK{
+				Group: "crew", Domain: "example.org", Version: "v1", Kind: "Captain",
+			}))
+			Expect(resources[1].GVK).To(Equal(external.GVK))
+		})
+
+		It("should rewrite the import paths of the project", func() {
+			s := scaffolds.NewRenameScaffolder(cfg, "", "github.com/acme/fleet")
+			s.InjectFS(fs)
+			Expect(s.Scaffold()).To(Succeed())
+
+			Expect(readFile("go.mod")).To(Equal(
+				"module github.com/acme/fleet\n\nrequire github.com/example/project-utils v1.0.0\n"))
+			By("sorting the imports again")
+			Expect(readFile("cmd/main.go")).To(ContainSubstring(`import (
+	crewv1 "github.com/acme/fleet/api/v1"
+	"github.com/example/project-utils/log"
+)`))
+			Expect(readFile("api/v1/groupversion_info.go")).To(ContainSubstring("crew." + oldDomain))
+
+			Expect(cfg.GetRepository()).To(Equal("github.com/acme/fleet"))
+			res, err := cfg.GetResource(captain.GVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal("github.com/acme/fleet/api/v1"))
+			res, err = cfg.GetResource(external.GVK)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(res.Path).To(Equal(external.Path))
+		})
+
+		It("should keep the scaffold records of the resources", func() {
+			cfg = cfgv4.New()
+			Expect(cfg.SetDomain(oldDomain)).To(Succeed())
+			Expect(cfg.SetRepository(oldRepo)).To(Succeed())
+			Expect(cfg.AddResource(captain)).To(Succeed())
+			tracker := cfg.(config.ProvenanceTracker)
+			Expect(tracker.RecordScaffold(captain.GVK, config.ScaffoldRecord{
+				Command: "create webhook",
+				Flags:   []string{"--defaulting", "--defaulting-path=/mutate-crew-example-com-v1-captain"},
+			})).To(Succeed())
+
+			s := scaffolds.NewRenameScaffolder(cfg, "example.org", "")
+			s.InjectFS(fs)
+			Expect(s.Scaffold()).To(Succeed())
+
+			gvk := resource.GVK{Group: "crew", Domain: "example.org", Version: "v1", Kind: "Captain"}
+			records, err := tracker.GetScaffoldRecords(gvk)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(Equal([]config.ScaffoldRecord{{
+				Command: "create webhook",
+				Flags:   []string{"--defaulting", "--defaulting-path=/mutate-crew-example-org-v1-captain"},
+			}}))
+		})
+
+		It("should refuse to rename the API group of an external resource", func() {
+			external.GVK = resource.GVK{Group: "crew", Domain: oldDomain, Version: "v1", Kind: "Sailor"}
+			Expect(cfg.AddResource(external)).To(Succeed())
+
+			s := scaffolds.NewRenameScaffolder(cfg, "example.org", "")
+			s.InjectFS(fs)
+			err := s.Scaffold()
+			Expect(err).To(MatchError(ContainSubstring("cannot change the domain")))
+
+			Expect(cfg.GetDomain()).To(Equal(oldDomain))
+			Expect(readFile("api/v1/groupversion_info.go")).To(ContainSubstring("crew." + oldDomain))
+		})
+
+		It("should refuse to rewrite the import path of an external resource", func() {
+			external.GVK.Kind = "Issuer"
+			external.Path = oldRepo + "/third_party/certmanager/v1"
+			Expect(cfg.AddResource(external)).To(Succeed())
+
+			s := scaffolds.NewRenameScaffolder(cfg, "", "github.com/acme/fleet")
+			s.InjectFS(fs)
+			err := s.Scaffold()
+			Expect(err).To(MatchError(ContainSubstring("cannot change the repository")))
+
+			Expect(cfg.GetRepository()).To(Equal(oldRepo))
+			Expect(readFile("go.mod")).To(HavePrefix("module " + oldRepo + "\n"))
+		})
+	})
 })
@@ -17,6 +17,7 @@ limitations under the License.
 package v4
 
 import (
+	"errors"
 	"fmt"
 	log "log/slog"
 	"os"
@@ -25,6 +26,7 @@ import (
 	"strings"
 
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/validation"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
@@ -60,6 +62,7 @@ type initSubcommand struct {
 	skipGoVersionCheck bool
 	multigroup         bool
 	namespaced         bool
+	watchNamespaces    []string
 }
 
 func (p *initSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *plugin.SubcommandMetadata) {
@@ -95,6 +98,9 @@ Layout flags:
                 Namespaces to watch are configured via WATCH_NAMESPACE environment variable
                 Uses Role/RoleBinding instead of ClusterRole/ClusterRoleBinding
                 Suitable for multi-tenant environments or limited scope deployments
+  --watch-namespaces: Comma-separated list of namespaces watched by a namespace-scoped manager
+                Requires --namespaced; the manager is deployed to its own namespace
+                Binds the manager permissions with a RoleBinding in each of the namespaces
 
 Note: Layout settings can be changed later with 'kubebuilder edit'.
 `
@@ -107,6 +113,9 @@ Note: Layout settings can be changed later with 'kubebuilder edit'.
   # Initialize with namespace-scoped deployment
   %[1]s init --domain example.org --namespaced
 
+  # Initialize with a namespace-scoped manager watching a list of namespaces
+  %[1]s init --domain example.org --namespaced --watch-namespaces=tenant-a,tenant-b
+
   # Initialize with optional plugins
   %[1]s init --plugins go/v4,autoupdate/v1-alpha --domain example.org
   %[1]s init --plugins go/v4,helm/v2-alpha --domain example.org
@@ -151,6 +160,9 @@ func (p *initSubcommand) BindFlags(fs *pflag.FlagSet) {
 		"If set, enable multigroup layout (organize APIs by group)")
 	fs.BoolVar(&p.namespaced, "namespaced", false,
 		"If set, enable namespace-scoped deployment (default: cluster-scoped)")
+	fs.StringSliceVar(&p.watchNamespaces, "watch-namespaces", nil,
+		"Comma-separated list of namespaces watched by the manager, requires --namespaced "+
+			"(default: the namespace the manager is deployed to)")
 }
 
 func (p *initSubcommand) InjectConfig(c config.Config) error {
@@ -181,6 +193,18 @@ func (p *initSubcommand) InjectConfig(c config.Config) error {
 		}
 	}
 
+	if len(p.watchNamespaces) > 0 {
+		if !p.namespaced {
+			return errors.New("'--watch-namespaces' requires namespace-scoped deployment, enable it with '--namespaced'")
+		}
+		if err := validateWatchNamespaces(p.watchNamespaces); err != nil {
+			return err
+		}
+		if err := p.config.SetWatchNamespaces(p.watchNamespaces); err != nil {
+			return fmt.Errorf("error setting watch namespaces: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -230,7 +254,7 @@ func (p *initSubcommand) Scaffold(fs machinery.Filesystem) error {
 		return fmt.Errorf("error scaffolding init plugin: %w", err)
 	}
 
-	if !p.fetchDeps {
+	if !p.fetchDeps || fs.DryRun {
 		log.Info("skipping fetching dependencies")
 		return nil
 	}
@@ -325,3 +349,16 @@ func checkDir() error {
 
 	return nil
 }
+
+// validateWatchNamespaces checks that the namespaces watched by the manager are valid and listed once
+func validateWatchNamespaces(namespaces []string) error {
+	for i, namespace := range namespaces {
+		if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace %q in '--watch-namespaces': %s", namespace, strings.Join(errs, "; "))
+		}
+		if slices.Contains(namespaces[:i], namespace) {
+			return fmt.Errorf("namespace %q is listed more than once in '--watch-namespaces'", namespace)
+		}
+	}
+	return nil
+}
@@ -107,6 +107,34 @@ var _ = Describe("initSubcommand", func() {
 			Expect(cfg.IsMultiGroup()).To(BeTrue())
 			Expect(cfg.IsNamespaced()).To(BeTrue())
 		})
+
+		It("should set the watched namespaces of a namespace-scoped manager", func() {
+			subCmd.repo = testRepo
+			subCmd.namespaced = true
+			subCmd.watchNamespaces = []string{"tenant-a", "tenant-b"}
+			err := subCmd.InjectConfig(cfg)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cfg.GetWatchNamespaces()).To(Equal([]string{"tenant-a", "tenant-b"}))
+		})
+
+		It("should fail to set watched namespaces without namespaced", func() {
+			subCmd.repo = testRepo
+			subCmd.watchNamespaces = []string{"tenant-a"}
+			err := subCmd.InjectConfig(cfg)
+
+			Expect(err).To(MatchError(ContainSubstring("requires namespace-scoped deployment")))
+		})
+
+		It("should fail with an invalid or repeated watched namespace", func() {
+			subCmd.repo = testRepo
+			subCmd.namespaced = true
+			subCmd.watchNamespaces = []string{"Tenant_A"}
+			Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring(`invalid namespace "Tenant_A"`)))
+
+			subCmd.watchNamespaces = []string{"tenant-a", "tenant-a"}
+			Expect(subCmd.InjectConfig(cfg)).To(MatchError(ContainSubstring("listed more than once")))
+		})
 	})
 
 	Context("checkDir validation", func() {
@@ -19,6 +19,7 @@ package v4
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang"
@@ -28,16 +29,22 @@ const pluginName = "base." + golang.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 4, Stage: stage.Stable}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
-var _ plugin.Full = Plugin{}
+var (
+	_ plugin.Full          = Plugin{}
+	_ plugin.DeleteAPI     = Plugin{}
+	_ plugin.DeleteWebhook = Plugin{}
+)
 
 // Plugin implements the plugin.Full interface
 type Plugin struct {
 	initSubcommand
 	createAPISubcommand
 	createWebhookSubcommand
+	deleteAPISubcommand
+	deleteWebhookSubcommand
 	editSubcommand
 }
 
@@ -61,6 +68,14 @@ func (p Plugin) GetCreateWebhookSubcommand() plugin.CreateWebhookSubcommand {
 	return &p.createWebhookSubcommand
 }
 
+// GetDeleteAPISubcommand will return the subcommand which is responsible for removing scaffolded apis
+func (p Plugin) GetDeleteAPISubcommand() plugin.DeleteAPISubcommand { return &p.deleteAPISubcommand }
+
+// GetDeleteWebhookSubcommand will return the subcommand which is responsible for removing scaffolded webhooks
+func (p Plugin) GetDeleteWebhookSubcommand() plugin.DeleteWebhookSubcommand {
+	return &p.deleteWebhookSubcommand
+}
+
 // GetEditSubcommand will return the subcommand which is responsible for editing the scaffold of the project
 func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcommand }
 
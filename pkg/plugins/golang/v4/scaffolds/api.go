@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	log "log/slog"
+	"path"
 
 	"github.com/spf13/afero"
 
@@ -33,6 +34,14 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/hack"
 )
 
+// APIOptions selects the optional logic scaffolded in the controller
+type APIOptions struct {
+	// WithFinalizer scaffolds a finalizer added to the reconciled objects and removed once cleaned up
+	WithFinalizer bool
+	// WithConditions scaffolds the logic reporting the status conditions of the reconciled objects
+	WithConditions bool
+}
+
 var _ plugins.Scaffolder = &apiScaffolder{}
 
 // apiScaffolder contains configuration for generating scaffolding for Go type
@@ -46,14 +55,25 @@ type apiScaffolder struct {
 
 	// force indicates whether to scaffold controller files even if it exists or not
 	force bool
+
+	options APIOptions
 }
 
 // NewAPIScaffolder returns a new Scaffolder for API/controller creation operations
 func NewAPIScaffolder(cfg config.Config, res resource.Resource, force bool) plugins.Scaffolder {
+	return NewAPIScaffolderWithOptions(cfg, res, force, APIOptions{})
+}
+
+// NewAPIScaffolderWithOptions returns a new Scaffolder for API/controller creation operations
+// that scaffolds the optional controller logic selected by options
+func NewAPIScaffolderWithOptions(cfg config.Config, res resource.Resource, force bool,
+	options APIOptions,
+) plugins.Scaffolder {
 	return &apiScaffolder{
 		config:   cfg,
 		resource: res,
 		force:    force,
+		options:  options,
 	}
 }
 
@@ -118,14 +138,31 @@ func (s *apiScaffolder) Scaffold() error {
 			}
 		}
 
+		controller := &controllers.Controller{
+			ControllerRuntimeVersion: ControllerRuntimeVersion,
+			Force:                    s.force,
+			ControllerName:           controllerName,
+			WithFinalizer:            s.options.WithFinalizer,
+			WithConditions:           s.options.WithConditions,
+		}
+		if s.resource.Controllers != nil {
+			for _, c := range *s.resource.Controllers {
+				if c.Name == controllerName {
+					controller.Owns = s.secondaryResources(c.Owns)
+					controller.Watches = s.secondaryResources(c.Watches)
+				}
+			}
+		}
+
 		if err := scaffold.Execute(
 			&controllers.SuiteTest{Force: s.force},
-			&controllers.Controller{
-				ControllerRuntimeVersion: ControllerRuntimeVersion,
-				Force:                    s.force,
-				ControllerName:           controllerName,
+			controller,
+			&controllers.ControllerTest{
+				Force:          s.force,
+				DoAPI:          doAPI,
+				WithFinalizer:  s.options.WithFinalizer,
+				WithConditions: s.options.WithConditions,
 			},
-			&controllers.ControllerTest{Force: s.force, DoAPI: doAPI},
 		); err != nil {
 			return fmt.Errorf("error scaffolding controller: %w", err)
 		}
@@ -151,3 +188,25 @@ func (s *apiScaffolder) Scaffold() error {
 
 	return nil
 }
+
+// secondaryResources returns the resources owned or watched by a controller. They are either tracked by the
+// project configuration, which knows the package defining their types, or Kubernetes core resources.
+func (s *apiScaffolder) secondaryResources(gvks []resource.GVK) []resource.Resource {
+	resources := make([]resource.Resource, 0, len(gvks))
+	for _, gvk := range gvks {
+		res, err := s.config.GetResource(gvk)
+		if err != nil || res.Path == "" {
+			res = resource.Resource{
+				GVK:  gvk,
+				Path: path.Join("k8s.io", "api", gvk.Group, gvk.Version),
+				Core: true,
+			}
+		}
+		if res.Plural == "" {
+			res.Plural = resource.RegularPlural(res.Kind)
+		}
+		resources = append(resources, res)
+	}
+
+	return resources
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+	log "log/slog"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/controllers"
+)
+
+// deepCopyFileName is the name of the file generated by controller-gen with the DeepCopy methods
+const deepCopyFileName = "zz_generated.deepcopy.go"
+
+var _ plugins.Scaffolder = &deleteAPIScaffolder{}
+
+// deleteAPIScaffolder contains configuration for removing the scaffolding of the Go type
+// representing the API and the controllers that implement the behavior for the API.
+type deleteAPIScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewDeleteAPIScaffolder returns a new Scaffolder for API/controller deletion operations
+func NewDeleteAPIScaffolder(cfg config.Config, res resource.Resource) plugins.Scaffolder {
+	return &deleteAPIScaffolder{
+		config:   cfg,
+		resource: res,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteAPIScaffolder) Scaffold() error {
+	log.Info("Removing scaffold of the API...")
+
+	if err := s.config.RemoveResource(s.resource.GVK); err != nil {
+		return fmt.Errorf("error removing resource: %w", err)
+	}
+
+	// Packages and scheme registrations can be shared with the remaining resources,
+	// so we need to check which of them are still in use before removing them.
+	remaining, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	var sharesAPIPackage, sharesScheme, sharesControllerScheme bool
+	for _, res := range remaining {
+		if res.Path != s.resource.Path {
+			continue
+		}
+		sharesAPIPackage = sharesAPIPackage || res.HasAPI()
+		sharesScheme = sharesScheme || res.HasAPI() || res.IsExternal()
+		sharesControllerScheme = sharesControllerScheme || res.HasController()
+	}
+
+	// Initialize the machinery.Scaffold that will remove the files from disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if s.resource.HasAPI() {
+		if err = s.deleteDeepCopyFile(); err != nil {
+			return err
+		}
+
+		builders := []machinery.Builder{&api.Types{}}
+		if !sharesAPIPackage {
+			builders = append(builders, &api.Group{})
+		}
+		if err = scaffold.Delete(builders...); err != nil {
+			return fmt.Errorf("error removing APIs: %w", err)
+		}
+	}
+
+	if s.resource.HasController() {
+		builders := []machinery.Builder{&controllers.ControllerTest{}}
+		for _, controllerName := range s.resource.GetControllerNames() {
+			builders = append(builders, &controllers.Controller{ControllerName: controllerName})
+		}
+		if !sharesControllerScheme {
+			builders = append(builders, &controllers.SuiteTest{})
+		}
+		if err = scaffold.Delete(builders...); err != nil {
+			return fmt.Errorf("error removing controllers: %w", err)
+		}
+	}
+
+	// The scheme registration in cmd/main.go is removed only when no other resource needs it.
+	mainResource := s.resource.Copy()
+	if sharesScheme {
+		mainResource.External = false
+	}
+	mainScaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&mainResource),
+	)
+	for _, controllerName := range append([]string{""}, s.resource.GetControllerNames()...) {
+		if err = mainScaffold.Delete(&cmd.MainUpdater{
+			WireResource:   controllerName == "" && s.resource.HasAPI() && !sharesScheme,
+			WireController: controllerName != "",
+			ControllerName: controllerName,
+		}); err != nil {
+			return fmt.Errorf("error updating cmd/main.go: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteDeepCopyFile removes the DeepCopy methods generated for the API package, which would
+// not compile without the removed types. They are generated again by `make generate`.
+func (s *deleteAPIScaffolder) deleteDeepCopyFile() error {
+	apiDir := filepath.Join("api", s.resource.Version)
+	if s.config.IsMultiGroup() && s.resource.Group != "" {
+		apiDir = filepath.Join("api", s.resource.Group, s.resource.Version)
+	}
+	return removeFile(s.fs, filepath.Join(apiDir, deepCopyFileName))
+}
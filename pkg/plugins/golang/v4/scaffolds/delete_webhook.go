@@ -0,0 +1,580 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	log "log/slog"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/afero"
+	"golang.org/x/tools/imports"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/api"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/test/e2e"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/webhooks"
+)
+
+// webhookSuiteFileName is the name of the file that sets up the webhook tests of a package
+const webhookSuiteFileName = "webhook_suite_test.go"
+
+var _ plugins.Scaffolder = &deleteWebhookScaffolder{}
+
+// deleteWebhookScaffolder contains configuration for removing the scaffolding of one or more
+// webhook types of a resource, while keeping the remaining ones.
+type deleteWebhookScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+
+	// defaulting, validation and conversion indicate which webhook types are removed
+	defaulting bool
+	validation bool
+	conversion bool
+}
+
+// NewDeleteWebhookScaffolder returns a new Scaffolder for webhook deletion operations
+func NewDeleteWebhookScaffolder(
+	cfg config.Config,
+	res resource.Resource,
+	defaulting, validation, conversion bool,
+) plugins.Scaffolder {
+	return &deleteWebhookScaffolder{
+		config:     cfg,
+		resource:   res,
+		defaulting: defaulting,
+		validation: validation,
+		conversion: conversion,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *deleteWebhookScaffolder) Scaffold() error {
+	log.Info("Removing scaffold of the webhooks...")
+
+	remaining := s.remainingWebhooks()
+	if err := s.updateConfig(remaining); err != nil {
+		return err
+	}
+
+	// Only the webhooks served by the manager have Go code, unlike a ValidatingAdmissionPolicy
+	s.validation = s.validation && s.resource.HasValidationWebhook()
+	if !s.defaulting && !s.validation && !s.conversion {
+		return nil
+	}
+
+	isLegacy, err := s.isLegacyPath()
+	if err != nil {
+		return err
+	}
+
+	// Initialize the machinery.Scaffold that will remove the files from disk
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	if !remaining.Defaulting && !remaining.Validation && !remaining.Conversion {
+		if err = s.deleteWebhookFiles(scaffold, isLegacy); err != nil {
+			return err
+		}
+	} else {
+		if err = s.editWebhookFiles(isLegacy); err != nil {
+			return err
+		}
+	}
+
+	if s.conversion {
+		builders := []machinery.Builder{&api.Hub{}}
+		for _, spoke := range s.resource.Webhooks.Spoke {
+			builders = append(builders, &api.Spoke{SpokeVersion: spoke})
+		}
+		if err = scaffold.Delete(builders...); err != nil {
+			return fmt.Errorf("error removing conversion files: %w", err)
+		}
+	}
+
+	return s.deleteE2ETests(scaffold)
+}
+
+// remainingWebhooks returns the webhooks of the resource once the requested types are removed
+func (s *deleteWebhookScaffolder) remainingWebhooks() resource.Webhooks {
+	remaining := s.resource.Webhooks.Copy()
+	if s.defaulting {
+		remaining.Defaulting = false
+		remaining.DefaultingPath = ""
+	}
+	if s.validation {
+		remaining.Validation = false
+		remaining.ValidationPath = ""
+		remaining.ValidatingAdmissionPolicy = false
+	}
+	if s.conversion {
+		remaining.Conversion = false
+		remaining.Spoke = nil
+	}
+	if !remaining.Defaulting && !remaining.Validation && !remaining.ValidatingAdmissionPolicy {
+		remaining.FailurePolicy = ""
+		remaining.SideEffects = ""
+		remaining.NamespaceSelector = ""
+		remaining.ObjectSelector = ""
+	}
+	if !remaining.Defaulting && !remaining.Validation && !remaining.ValidatingAdmissionPolicy &&
+		!remaining.Conversion {
+		remaining.WebhookVersion = ""
+	}
+
+	return remaining
+}
+
+// updateConfig stores the remaining webhooks of the resource, which is removed from the
+// PROJECT file if nothing else was scaffolded for it.
+func (s *deleteWebhookScaffolder) updateConfig(remaining resource.Webhooks) error {
+	res := s.resource.Copy()
+	res.Webhooks = &remaining
+
+	if !res.HasAPI() && !res.HasController() && remaining.IsEmpty() {
+		if err := s.config.RemoveResource(res.GVK); err != nil {
+			return fmt.Errorf("error removing resource: %w", err)
+		}
+		return nil
+	}
+
+	if err := s.config.SetResource(res); err != nil {
+		return fmt.Errorf("error updating resource: %w", err)
+	}
+
+	return nil
+}
+
+// isLegacyPath checks whether the webhooks of the resource were scaffolded under the API
+// directory, as done with the deprecated --legacy flag of "create webhook".
+func (s *deleteWebhookScaffolder) isLegacyPath() (bool, error) {
+	paths := &webhookScaffolder{config: s.config, resource: s.resource}
+	exists, err := afero.Exists(s.fs.FS, paths.getWebhookFilePath())
+	if err != nil || exists {
+		return false, err
+	}
+
+	paths.isLegacy = true
+	return afero.Exists(s.fs.FS, paths.getWebhookFilePath())
+}
+
+// deleteWebhookFiles removes the webhook implementation and its tests, and unwires them
+// from cmd/main.go and the webhook test suite.
+func (s *deleteWebhookScaffolder) deleteWebhookFiles(scaffold *machinery.Scaffold, isLegacy bool) error {
+	// The test suite of the package is kept while other webhooks are tested with it
+	remaining, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	sharesSuite := slices.ContainsFunc(remaining, func(res resource.Resource) bool {
+		return res.Version == s.resource.Version &&
+			(!s.config.IsMultiGroup() || res.Group == s.resource.Group) &&
+			(res.HasDefaultingWebhook() || res.HasValidationWebhook() || res.HasConversionWebhook())
+	})
+
+	paths := &webhookScaffolder{config: s.config, resource: s.resource, isLegacy: isLegacy}
+	if sharesSuite {
+		err = scaffold.Delete(&webhooks.WebhookSuite{IsLegacyPath: isLegacy})
+	} else {
+		err = removeFile(s.fs, filepath.Join(filepath.Dir(paths.getWebhookFilePath()), webhookSuiteFileName))
+	}
+	if err != nil {
+		return fmt.Errorf("error removing webhook suite: %w", err)
+	}
+
+	if err = scaffold.Delete(
+		&webhooks.Webhook{IsLegacyPath: isLegacy},
+		&webhooks.WebhookTest{IsLegacyPath: isLegacy},
+	); err != nil {
+		return fmt.Errorf("error removing webhook: %w", err)
+	}
+
+	// The scheme of external types is registered along with the webhook, so it is removed
+	// as well unless the resource is still tracked or another resource needs it.
+	mainResource := s.resource.Copy()
+	if s.config.HasResource(s.resource.GVK) || slices.ContainsFunc(remaining, func(res resource.Resource) bool {
+		return res.Path == s.resource.Path && (res.HasAPI() || res.IsExternal())
+	}) {
+		mainResource.External = false
+	}
+	mainScaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&mainResource),
+	)
+	if err = mainScaffold.Delete(&cmd.MainUpdater{WireWebhook: true, IsLegacyPath: isLegacy}); err != nil {
+		return fmt.Errorf("error updating cmd/main.go: %w", err)
+	}
+
+	return nil
+}
+
+// editWebhookFiles removes the code of the requested webhook types from the webhook
+// implementation and its tests, keeping the rest of the code, including user changes.
+func (s *deleteWebhookScaffolder) editWebhookFiles(isLegacy bool) error {
+	paths := &webhookScaffolder{config: s.config, resource: s.resource, isLegacy: isLegacy}
+	kind := s.resource.Kind
+
+	if err := s.editGoFile(paths.getWebhookFilePath(), func(e *goSourceEditor) {
+		if s.defaulting {
+			e.removeTypeAndMethods(kind + "CustomDefaulter")
+			e.removeCommentGroupsWith("+kubebuilder:webhook:", "mutating=true")
+			e.removeLinesMatching(regexp.MustCompile(
+				`^\s*WithDefaulter(CustomPath\(.*\)|\(&` + kind + `CustomDefaulter\{\}\))\.\s*$`))
+		}
+		if s.validation {
+			e.removeTypeAndMethods(kind + "CustomValidator")
+			e.removeCommentGroupsWith("+kubebuilder:webhook:", "mutating=false")
+			e.removeLinesMatching(regexp.MustCompile(
+				`^\s*WithValidator(CustomPath\(.*\)|\(&` + kind + `CustomValidator\{\}\))\.\s*$`))
+		}
+	}); err != nil {
+		return err
+	}
+
+	return s.editGoFile(paths.getWebhookTestFilePath(), func(e *goSourceEditor) {
+		if s.defaulting {
+			e.removeContextsWith("under Defaulting Webhook")
+			e.removeStatementsUsing("defaulter")
+		}
+		if s.validation {
+			e.removeContextsWith("under Validating Webhook")
+			e.removeStatementsUsing("validator")
+		}
+		if s.conversion {
+			e.removeContextsWith("under Conversion Webhook")
+		}
+	})
+}
+
+// editGoFile applies the removals made by edit to the Go file at path, if it exists
+func (s *deleteWebhookScaffolder) editGoFile(path string, edit func(*goSourceEditor)) error {
+	src, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			log.Warn("skipping missing file", "file", path)
+			return nil
+		}
+		return fmt.Errorf("error reading %s: %w", path, err)
+	}
+
+	editor, err := newGoSourceEditor(path, src)
+	if err != nil {
+		return err
+	}
+	edit(editor)
+
+	content, err := editor.content()
+	if err != nil {
+		return err
+	}
+	if bytes.Equal(content, src) {
+		return nil
+	}
+
+	if err = afero.WriteFile(s.fs.FS, path, content, 0o644); err != nil {
+		return fmt.Errorf("error writing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// deleteE2ETests removes the e2e checks of the webhook types that are no longer used in the project
+func (s *deleteWebhookScaffolder) deleteE2ETests(scaffold *machinery.Scaffold) error {
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("error getting resources: %w", err)
+	}
+	var hasWebhooks, hasDefaulting, hasValidation bool
+	for _, res := range resources {
+		hasWebhooks = hasWebhooks ||
+			res.HasDefaultingWebhook() || res.HasValidationWebhook() || res.HasConversionWebhook()
+		hasDefaulting = hasDefaulting || res.HasDefaultingWebhook()
+		hasValidation = hasValidation || res.HasValidationWebhook()
+	}
+
+	if err = scaffold.Delete(&e2e.WebhookTestRemover{
+		RemoveWebhookChecks:    !hasWebhooks,
+		RemoveServiceReadiness: (s.defaulting || s.validation) && !hasDefaulting && !hasValidation,
+		RemoveMutating:         s.defaulting && !hasDefaulting,
+		RemoveValidating:       s.validation && !hasValidation,
+		RemoveConversion:       s.conversion,
+		RemoveObjectDefaulting: s.defaulting,
+		RemoveObjectValidation: s.validation,
+	}); err != nil {
+		return fmt.Errorf("error updating e2e tests: %w", err)
+	}
+
+	return nil
+}
+
+// removeFile removes the file at path, if it exists
+func removeFile(fs machinery.Filesystem, path string) error {
+	exists, err := afero.Exists(fs.FS, path)
+	if err != nil {
+		return fmt.Errorf("error checking %s: %w", path, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	if err = fs.FS.Remove(path); err != nil {
+		return fmt.Errorf("error removing %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// goSourceEditor removes declarations, statements and lines from a Go source file.
+// Removals are always done for whole lines, and they are applied when calling content.
+type goSourceEditor struct {
+	path string
+	src  []byte
+	fset *token.FileSet
+	file *ast.File
+
+	// removed holds the [start, end) offsets of the source that will be removed
+	removed [][2]int
+}
+
+func newGoSourceEditor(path string, src []byte) (*goSourceEditor, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing %s: %w", path, err)
+	}
+
+	return &goSourceEditor{path: path, src: src, fset: fset, file: file}, nil
+}
+
+// remove removes the lines spanned by the source between start and end
+func (e *goSourceEditor) remove(start, end token.Pos) {
+	from := e.fset.Position(start).Offset
+	to := e.fset.Position(end).Offset
+
+	from = bytes.LastIndexByte(e.src[:from], '\n') + 1
+	if i := bytes.IndexByte(e.src[to:], '\n'); i >= 0 {
+		to += i + 1
+	} else {
+		to = len(e.src)
+	}
+
+	// Remove one of the empty lines that surround the code, so that it does not leave a
+	// double empty line, or an empty line before the end of the block.
+	if next, _, _ := bytes.Cut(e.src[to:], []byte("\n")); len(bytes.TrimSpace(next)) == 0 && to < len(e.src) {
+		to += len(next) + 1
+	}
+	if next, _, _ := bytes.Cut(e.src[to:], []byte("\n")); bytes.HasPrefix(bytes.TrimSpace(next), []byte("}")) && from > 0 {
+		if prev := bytes.LastIndexByte(e.src[:from-1], '\n') + 1; len(bytes.TrimSpace(e.src[prev:from])) == 0 {
+			from = prev
+		}
+	}
+
+	e.removed = append(e.removed, [2]int{from, to})
+}
+
+// removeNode removes the node along with its doc comment, if any
+func (e *goSourceEditor) removeNode(node ast.Node, doc *ast.CommentGroup) {
+	start := node.Pos()
+	if doc != nil {
+		start = doc.Pos()
+	}
+	e.remove(start, node.End())
+}
+
+// removeTypeAndMethods removes the declaration of the type typeName and its methods
+func (e *goSourceEditor) removeTypeAndMethods(typeName string) {
+	for _, decl := range e.file.Decls {
+		switch d := decl.(type) {
+		case *ast.GenDecl:
+			if d.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range d.Specs {
+				typeSpec, ok := spec.(*ast.TypeSpec)
+				if !ok || typeSpec.Name.Name != typeName {
+					continue
+				}
+				if len(d.Specs) == 1 {
+					e.removeNode(d, d.Doc)
+				} else {
+					e.removeNode(typeSpec, typeSpec.Doc)
+				}
+			}
+		case *ast.FuncDecl:
+			if d.Recv == nil || len(d.Recv.List) == 0 {
+				continue
+			}
+			recvType := d.Recv.List[0].Type
+			if star, ok := recvType.(*ast.StarExpr); ok {
+				recvType = star.X
+			}
+			if ident, ok := recvType.(*ast.Ident); ok && ident.Name == typeName {
+				e.removeNode(d, d.Doc)
+			}
+		}
+	}
+}
+
+// removeCommentGroupsWith removes the comment groups that contain all the provided substrings
+func (e *goSourceEditor) removeCommentGroupsWith(substrings ...string) {
+	for _, group := range e.file.Comments {
+		text := string(e.src[e.fset.Position(group.Pos()).Offset:e.fset.Position(group.End()).Offset])
+		matches := true
+		for _, substring := range substrings {
+			matches = matches && strings.Contains(text, substring)
+		}
+		if matches {
+			e.remove(group.Pos(), group.End())
+		}
+	}
+}
+
+// removeLinesMatching removes the lines that match re
+func (e *goSourceEditor) removeLinesMatching(re *regexp.Regexp) {
+	offset := 0
+	for _, line := range bytes.SplitAfter(e.src, []byte("\n")) {
+		if re.Match(bytes.TrimSuffix(line, []byte("\n"))) {
+			e.removed = append(e.removed, [2]int{offset, offset + len(line)})
+		}
+		offset += len(line)
+	}
+}
+
+// removeContextsWith removes the Ginkgo containers whose description contains the provided substring
+func (e *goSourceEditor) removeContextsWith(substring string) {
+	ast.Inspect(e.file, func(n ast.Node) bool {
+		stmt, ok := n.(*ast.ExprStmt)
+		if !ok {
+			return true
+		}
+		call, ok := stmt.X.(*ast.CallExpr)
+		if !ok || len(call.Args) == 0 {
+			return true
+		}
+		if fun, isIdent := call.Fun.(*ast.Ident); !isIdent || !slices.Contains([]string{"Context", "When"}, fun.Name) {
+			return true
+		}
+		if desc, isLit := call.Args[0].(*ast.BasicLit); isLit && strings.Contains(desc.Value, substring) {
+			e.removeNode(stmt, nil)
+			return false
+		}
+		return true
+	})
+}
+
+// removeStatementsUsing removes the statements and variable declarations that refer to the identifier name.
+// Statements that contain function literals, such as Ginkgo containers, are not removed but inspected instead.
+func (e *goSourceEditor) removeStatementsUsing(name string) {
+	ast.Inspect(e.file, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.BlockStmt:
+			for _, stmt := range node.List {
+				if _, isDecl := stmt.(*ast.DeclStmt); !isDecl && !containsFuncLit(stmt) && refersTo(stmt, name) {
+					e.removeNode(stmt, nil)
+				}
+			}
+		case *ast.GenDecl:
+			if node.Tok != token.VAR {
+				return true
+			}
+			for _, spec := range node.Specs {
+				valueSpec, ok := spec.(*ast.ValueSpec)
+				if !ok || !slices.ContainsFunc(valueSpec.Names, func(id *ast.Ident) bool { return id.Name == name }) {
+					continue
+				}
+				if len(node.Specs) == 1 {
+					e.removeNode(node, node.Doc)
+				} else {
+					e.removeNode(valueSpec, valueSpec.Doc)
+				}
+			}
+		}
+		return true
+	})
+}
+
+// content returns the formatted source once the removals are applied
+func (e *goSourceEditor) content() ([]byte, error) {
+	if len(e.removed) == 0 {
+		return e.src, nil
+	}
+
+	slices.SortFunc(e.removed, func(a, b [2]int) int { return a[0] - b[0] })
+
+	var out bytes.Buffer
+	last := 0
+	for _, r := range e.removed {
+		if r[0] > last {
+			out.Write(e.src[last:r[0]])
+		}
+		last = max(last, r[1])
+	}
+	out.Write(e.src[last:])
+
+	formatted, err := imports.Process(e.path, out.Bytes(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error formatting %s: %w", e.path, err)
+	}
+
+	return formatted, nil
+}
+
+// containsFuncLit checks whether the node contains a function literal
+func containsFuncLit(node ast.Node) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if _, ok := n.(*ast.FuncLit); ok {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// refersTo checks whether the node contains the identifier name
+func refersTo(node ast.Node, name string) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if ident, ok := n.(*ast.Ident); ok && ident.Name == name {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
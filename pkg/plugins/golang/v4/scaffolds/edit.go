@@ -20,6 +20,8 @@ import (
 	"fmt"
 	log "log/slog"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/spf13/afero"
 
@@ -33,30 +35,32 @@ import (
 var _ plugins.Scaffolder = &editScaffolder{}
 
 type editScaffolder struct {
-	config      config.Config
-	multigroup  bool
-	namespaced  bool
-	force       bool
-	license     string
-	owner       string
-	licenseFile string
+	config          config.Config
+	multigroup      bool
+	namespaced      bool
+	watchNamespaces []string
+	force           bool
+	license         string
+	owner           string
+	licenseFile     string
 
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 }
 
 // NewEditScaffolder returns a new Scaffolder for configuration edit operations
-func NewEditScaffolder(cfg config.Config, multigroup bool, namespaced bool, force bool,
+func NewEditScaffolder(cfg config.Config, multigroup bool, namespaced bool, watchNamespaces []string, force bool,
 	license, owner, licenseFile string,
 ) plugins.Scaffolder {
 	return &editScaffolder{
-		config:      cfg,
-		multigroup:  multigroup,
-		namespaced:  namespaced,
-		force:       force,
-		license:     license,
-		owner:       owner,
-		licenseFile: licenseFile,
+		config:          cfg,
+		multigroup:      multigroup,
+		namespaced:      namespaced,
+		watchNamespaces: watchNamespaces,
+		force:           force,
+		license:         license,
+		owner:           owner,
+		licenseFile:     licenseFile,
 	}
 }
 
@@ -82,8 +86,9 @@ func (s *editScaffolder) Scaffold() error {
 		}
 	}
 
-	// Track if we're toggling namespaced mode
+	// Track if we're toggling namespaced mode or changing the watched namespaces
 	wasNamespaced := s.config.IsNamespaced()
+	previousWatchNamespaces := s.config.GetWatchNamespaces()
 
 	// Update config flags
 	if s.multigroup {
@@ -97,6 +102,7 @@ func (s *editScaffolder) Scaffold() error {
 	} else {
 		_ = s.config.ClearNamespaced()
 	}
+	_ = s.config.SetWatchNamespaces(s.watchNamespaces)
 
 	// Scaffold appropriate RBAC and manager config based on namespaced flag
 	if s.namespaced && !wasNamespaced {
@@ -105,7 +111,7 @@ func (s *editScaffolder) Scaffold() error {
 			return fmt.Errorf("failed to scaffold namespaced RBAC: %w", rbacErr)
 		}
 
-		if !s.force {
+		if !s.force && len(s.watchNamespaces) == 0 {
 			fmt.Println()
 			fmt.Println("Run with --force to update config/manager/manager.yaml with WATCH_NAMESPACE")
 		}
@@ -113,20 +119,28 @@ func (s *editScaffolder) Scaffold() error {
 		// Check if project has webhooks and warn about scope mismatch
 		if s.hasWebhooks() {
 			log.Warn("your project has webhooks which are cluster-scoped.\n" +
-				"You will need to manually configure namespaceSelector or objectSelector")
+				"You will need to configure namespaceSelector or objectSelector, e.g. by running " +
+				"'create webhook --force' with --namespace-selector or --object-selector")
 		}
 
 		// Print next steps
 		fmt.Println()
 		fmt.Println("Next steps:")
 		fmt.Println("1. Update cmd/main.go to configure namespace-scoped cache")
-		fmt.Println("2. Add namespace= to RBAC markers in existing controllers:")
-		fmt.Printf("   // +kubebuilder:rbac:groups=mygroup,resources=myresources,verbs=get;list,"+
-			"namespace=%s-system\n", s.config.GetProjectName())
+		if len(s.watchNamespaces) > 0 {
+			fmt.Printf("   const defaultWatchNamespaces = %q\n", strings.Join(s.watchNamespaces, ","))
+			fmt.Println("2. Keep RBAC markers in existing controllers without namespace=, " +
+				"the rules are bound in each watched namespace")
+		} else {
+			fmt.Println("2. Add namespace= to RBAC markers in existing controllers:")
+			fmt.Printf("   // +kubebuilder:rbac:groups=mygroup,resources=myresources,verbs=get;list,"+
+				"namespace=%s-system\n", s.config.GetProjectName())
+		}
 		fmt.Println("3. Run: make manifests")
 
 		if s.hasWebhooks() {
-			fmt.Println("4. Configure namespaceSelector or objectSelector for webhooks")
+			fmt.Println("4. Configure namespaceSelector or objectSelector for webhooks " +
+				"(create webhook --namespace-selector or --object-selector)")
 		}
 
 		fmt.Println()
@@ -152,6 +166,13 @@ func (s *editScaffolder) Scaffold() error {
 		fmt.Println("3. Run: make manifests")
 		fmt.Println()
 		fmt.Println("See: https://book.kubebuilder.io/migration/namespace-scoped.html")
+	} else if s.namespaced && !slices.Equal(s.watchNamespaces, previousWatchNamespaces) {
+		// Changing the watched namespaces: scaffold a RoleBinding in each of them or back to a single Role
+		if rbacErr := s.scaffoldNamespacedRBAC(s.force); rbacErr != nil {
+			return fmt.Errorf("failed to scaffold namespaced RBAC: %w", rbacErr)
+		}
+
+		s.printWatchNamespacesNextSteps(len(previousWatchNamespaces) > 0)
 	}
 
 	// Check if the str is not empty, because when the file is already in desired format it will return empty string
@@ -186,6 +207,32 @@ func (s *editScaffolder) scaffoldClusterRBAC(force bool) error {
 	return nil
 }
 
+// printWatchNamespacesNextSteps prints the manual changes required after the watched namespaces changed
+func (s *editScaffolder) printWatchNamespacesNextSteps(wasWatchingNamespaces bool) {
+	fmt.Println()
+	fmt.Println("Next steps:")
+	switch {
+	case len(s.watchNamespaces) == 0:
+		fmt.Println("1. Update cmd/main.go:")
+		fmt.Println("   - Remove the defaultWatchNamespaces constant")
+		fmt.Println("   - Return an error from getWatchNamespace() when WATCH_NAMESPACE is not set")
+		fmt.Println("2. Add namespace= to RBAC markers in existing controllers:")
+		fmt.Printf("   // +kubebuilder:rbac:groups=mygroup,resources=myresources,verbs=get;list,"+
+			"namespace=%s-system\n", s.config.GetProjectName())
+	case wasWatchingNamespaces:
+		fmt.Println("1. Update the defaultWatchNamespaces constant in cmd/main.go:")
+		fmt.Printf("   const defaultWatchNamespaces = %q\n", strings.Join(s.watchNamespaces, ","))
+	default:
+		fmt.Println("1. Update cmd/main.go to fall back to the watched namespaces when WATCH_NAMESPACE is not set:")
+		fmt.Printf("   const defaultWatchNamespaces = %q\n", strings.Join(s.watchNamespaces, ","))
+		fmt.Println("2. Remove namespace= from RBAC markers in existing controllers, " +
+			"the rules are bound in each watched namespace")
+	}
+	fmt.Println("Then run: make manifests")
+	fmt.Println()
+	fmt.Println("See: https://book.kubebuilder.io/reference/manager-scope.html")
+}
+
 // hasWebhooks checks if any resources in the project have webhooks configured
 func (s *editScaffolder) hasWebhooks() bool {
 	resources, err := s.config.GetResources()
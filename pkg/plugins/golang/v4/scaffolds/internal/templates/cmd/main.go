@@ -35,6 +35,7 @@ type Main struct {
 	machinery.DomainMixin
 	machinery.RepositoryMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	ControllerRuntimeVersion string
 }
@@ -283,18 +284,38 @@ func init() {
 	%s
 }
 {{- if .Namespaced }}
+{{- if .WatchNamespaces }}
+
+// defaultWatchNamespaces are the namespaces watched by the manager when WATCH_NAMESPACE is not set,
+// e.g. when it runs outside of the cluster with 'make run'. They match the watchNamespaces of the PROJECT file.
+const defaultWatchNamespaces = "{{ range $i, $namespace := .WatchNamespaces }}{{ if $i }},{{ end }}{{ $namespace }}{{ end }}"
+{{- end }}
 
 // getWatchNamespace returns the namespace(s) the manager should watch for changes.
 // It reads the value from the WATCH_NAMESPACE environment variable.
+{{- if .WatchNamespaces }}
+// - If WATCH_NAMESPACE is not set, the manager watches the defaultWatchNamespaces
+// - If WATCH_NAMESPACE is empty, an error is returned as the manager is only granted access to some namespaces
+{{- else }}
 // - If WATCH_NAMESPACE is not set, an error is returned
+{{- end }}
 // - If WATCH_NAMESPACE contains a single namespace, the manager watches that namespace
 // - If WATCH_NAMESPACE contains comma-separated namespaces, the manager watches those namespaces
 func getWatchNamespace() (string, error) {
 	watchNamespaceEnvVar := "WATCH_NAMESPACE"
 	ns, found := os.LookupEnv(watchNamespaceEnvVar)
+{{- if .WatchNamespaces }}
+	if !found {
+		ns = defaultWatchNamespaces
+	}
+	if ns == "" {
+		return "", fmt.Errorf("%%s must not be empty", watchNamespaceEnvVar)
+	}
+{{- else }}
 	if !found {
 		return "", fmt.Errorf("%%s must be set", watchNamespaceEnvVar)
 	}
+{{- end }}
 	return ns, nil
 }
 
@@ -19,6 +19,8 @@ package controllers
 import (
 	log "log/slog"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
@@ -36,6 +38,7 @@ type Controller struct {
 	machinery.ResourceMixin
 	machinery.ProjectNameMixin
 	machinery.NamespacedMixin
+	machinery.WatchNamespacesMixin
 
 	ControllerRuntimeVersion string
 
@@ -44,6 +47,16 @@ type Controller struct {
 	// ControllerName is the specific name for this controller.
 	// If empty, a default name based on the resource kind will be used.
 	ControllerName string
+
+	// Owns are the resources created and owned by the controller
+	Owns []resource.Resource
+	// Watches are the other resources watched by the controller
+	Watches []resource.Resource
+
+	// WithFinalizer scaffolds a finalizer along with the logic adding and removing it
+	WithFinalizer bool
+	// WithConditions scaffolds the logic reporting the status conditions of the reconciled objects
+	WithConditions bool
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -87,6 +100,53 @@ func (f *Controller) ControllerRuntimeName() string {
 	return resource.GetControllerName(f.ControllerName, f.Resource.Kind, f.Resource.Group, f.MultiGroup)
 }
 
+// NamespacedRBAC checks if the RBAC markers restrict the permissions to the namespace of the manager.
+// Managers watching a list of namespaces get cluster-wide rules instead, which are only bound in those namespaces.
+func (f *Controller) NamespacedRBAC() bool {
+	return f.Namespaced && len(f.WatchNamespaces) == 0
+}
+
+// FinalizerConst returns the name of the constant holding the finalizer of the controller.
+func (f *Controller) FinalizerConst() string {
+	name := f.reconciledName()
+	if name == f.Resource.Kind {
+		return strings.ToLower(name) + "Finalizer"
+	}
+	return strings.ToLower(name[:1]) + name[1:] + "Finalizer"
+}
+
+// FinalizerName returns the finalizer added by the controller to the reconciled objects.
+// Controllers with a custom name get their own finalizer, so that each one cleans up independently.
+func (f *Controller) FinalizerName() string {
+	if f.ControllerName == "" || f.ControllerName == strings.ToLower(f.Resource.Kind) {
+		return f.Resource.QualifiedGroup() + "/finalizer"
+	}
+	return f.Resource.QualifiedGroup() + "/" + f.ControllerName + "-finalizer"
+}
+
+// ConditionConst returns the name of the constant holding the provided condition type.
+func (f *Controller) ConditionConst(conditionType string) string {
+	return "type" + conditionType + f.reconciledName()
+}
+
+// reconciledName returns the reconciler name without its suffix, which tells apart the constants
+// of the controllers of a same resource living in a same package.
+func (f *Controller) reconciledName() string {
+	return strings.TrimSuffix(f.ReconcilerName(), "Reconciler")
+}
+
+// SecondaryImports returns the import paths of the owned and watched resources keyed by their alias,
+// without the package of the reconciled resource.
+func (f *Controller) SecondaryImports() map[string]string {
+	imports := make(map[string]string)
+	for _, res := range append(slices.Clone(f.Owns), f.Watches...) {
+		if res.Path != f.Resource.Path {
+			imports[res.ImportAlias()] = res.Path
+		}
+	}
+	return imports
+}
+
 //nolint:lll
 const controllerTemplate = `{{ .Boilerplate }}
 
@@ -101,7 +161,43 @@ import (
 	{{ if not (isEmptyStr .Resource.Path) -}}
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 	{{- end }}
+	{{- range $alias, $path := .SecondaryImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
+	{{- if .Watches }}
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	{{- end }}
+	{{- if or .WithFinalizer .WithConditions }}
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	{{- end }}
+	{{- if .WithConditions }}
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	{{- end }}
+	{{- if .WithFinalizer }}
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	{{- end }}
 )
+{{- if .WithFinalizer }}
+
+// {{ .FinalizerConst }} is added to the {{ .Resource.Kind }} objects so that the controller
+// performs its cleanup before they are removed from the cluster
+const {{ .FinalizerConst }} = "{{ .FinalizerName }}"
+{{- end }}
+{{- if .WithConditions }}
+
+// Definitions of the conditions reported in the status of the {{ .Resource.Kind }} objects
+const (
+	// {{ .ConditionConst "Available" }} represents the status of the reconciliation
+	{{ .ConditionConst "Available" }} = "Available"
+	{{- if .WithFinalizer }}
+	// {{ .ConditionConst "Degraded" }} represents the status used when the object is deleted
+	// and the finalizer operations are yet to occur
+	{{ .ConditionConst "Degraded" }} = "Degraded"
+	{{- end }}
+)
+{{- end }}
 
 // {{ .ReconcilerName }} reconciles a {{ .Resource.Kind }} object
 type {{ .ReconcilerName }} struct {
@@ -109,7 +205,7 @@ type {{ .ReconcilerName }} struct {
 	Scheme *runtime.Scheme
 }
 
-{{ if .Namespaced -}}
+{{ if .NamespacedRBAC -}}
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},namespace={{ .ProjectName }}-system,resources={{ .Resource.Plural }}/finalizers,verbs=update
@@ -118,6 +214,12 @@ type {{ .ReconcilerName }} struct {
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups={{ .Resource.QualifiedGroup }},resources={{ .Resource.Plural }}/finalizers,verbs=update
 {{- end }}
+{{- range .Owns }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},{{ if $.NamespacedRBAC }}namespace={{ $.ProjectName }}-system,{{ end }}resources={{ .Plural }},verbs=get;list;watch;create;update;patch;delete
+{{- end }}
+{{- range .Watches }}
+// +kubebuilder:rbac:groups={{ .QualifiedGroup }},{{ if $.NamespacedRBAC }}namespace={{ $.ProjectName }}-system,{{ end }}resources={{ .Plural }},verbs=get;list;watch
+{{- end }}
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -129,12 +231,125 @@ type {{ .ReconcilerName }} struct {
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@{{ .ControllerRuntimeVersion }}/pkg/reconcile
 func (r *{{ .ReconcilerName }}) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+{{- if or .WithFinalizer .WithConditions }}
+	log := logf.FromContext(ctx)
+
+	// Fetch the {{ .Resource.Kind }} instance. If it is not found, it was deleted
+	// after the request was queued and there is nothing left to reconcile.
+	obj := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+	if err := r.Get(ctx, req.NamespacedName, obj); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Info("{{ .Resource.Kind }} resource not found, ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		log.Error(err, "Failed to get {{ .Resource.Kind }}")
+		return ctrl.Result{}, err
+	}
+	{{- if .WithConditions }}
+
+	// Let's just set the status as Unknown when no status is available
+	if len(obj.Status.Conditions) == 0 {
+		meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+			Type:    {{ .ConditionConst "Available" }},
+			Status:  metav1.ConditionUnknown,
+			Reason:  "Reconciling",
+			Message: "Starting reconciliation",
+		})
+		if err := r.Status().Update(ctx, obj); err != nil {
+			log.Error(err, "Failed to update {{ .Resource.Kind }} status")
+			return ctrl.Result{}, err
+		}
+	}
+	{{- end }}
+	{{- if .WithFinalizer }}
+
+	// Check if the {{ .Resource.Kind }} instance is marked to be deleted, which is indicated by
+	// the deletion timestamp being set. The finalizer keeps it in the cluster until it is removed.
+	// More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/finalizers
+	if !obj.GetDeletionTimestamp().IsZero() {
+		if controllerutil.ContainsFinalizer(obj, {{ .FinalizerConst }}) {
+			log.Info("Performing finalizer operations for {{ .Resource.Kind }} before deleting it")
+			{{- if .WithConditions }}
+
+			// Let's add here a status "Degraded" to reflect that this resource began its process to be terminated
+			meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+				Type:    {{ .ConditionConst "Degraded" }},
+				Status:  metav1.ConditionUnknown,
+				Reason:  "Finalizing",
+				Message: "Performing finalizer operations before deleting the resource",
+			})
+			if err := r.Status().Update(ctx, obj); err != nil {
+				log.Error(err, "Failed to update {{ .Resource.Kind }} status")
+				return ctrl.Result{}, err
+			}
+			{{- end }}
+
+			if err := r.doFinalizerOperations(ctx, obj); err != nil {
+				log.Error(err, "Failed to perform finalizer operations for {{ .Resource.Kind }}")
+				return ctrl.Result{}, err
+			}
+
+			log.Info("Removing finalizer for {{ .Resource.Kind }} after successfully performing the operations")
+			controllerutil.RemoveFinalizer(obj, {{ .FinalizerConst }})
+			if err := r.Update(ctx, obj); err != nil {
+				log.Error(err, "Failed to remove finalizer for {{ .Resource.Kind }}")
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Let's add a finalizer. Then, we can define some operations which should
+	// occur before the {{ .Resource.Kind }} is deleted.
+	if controllerutil.AddFinalizer(obj, {{ .FinalizerConst }}) {
+		log.Info("Adding finalizer for {{ .Resource.Kind }}")
+		if err := r.Update(ctx, obj); err != nil {
+			log.Error(err, "Failed to add finalizer for {{ .Resource.Kind }}")
+			return ctrl.Result{}, err
+		}
+	}
+	{{- end }}
+
+	// TODO(user): your logic here
+	{{- if .WithConditions }}
+
+	// The following implementation will update the status once the reconciliation succeeded
+	meta.SetStatusCondition(&obj.Status.Conditions, metav1.Condition{
+		Type:    {{ .ConditionConst "Available" }},
+		Status:  metav1.ConditionTrue,
+		Reason:  "Reconciled",
+		Message: "The {{ .Resource.Kind }} was reconciled successfully",
+	})
+	if err := r.Status().Update(ctx, obj); err != nil {
+		log.Error(err, "Failed to update {{ .Resource.Kind }} status")
+		return ctrl.Result{}, err
+	}
+	{{- end }}
+{{- else }}
 	_ = logf.FromContext(ctx)
 
 	// TODO(user): your logic here
+{{- end }}
 
 	return ctrl.Result{}, nil
 }
+{{- if .WithFinalizer }}
+
+// doFinalizerOperations performs the required operations before the {{ .Resource.Kind }} is deleted.
+// TODO(user): Add the cleanup steps that the controller needs to do before the object can be deleted,
+// such as performing backups or deleting the external resources that it is not the owner of.
+//
+// Note: It is not recommended to use finalizers with the purpose of deleting resources created in the
+// cluster by the reconciliation. When the object is set as their owner with ctrl.SetControllerReference,
+// they are deleted by the Kubernetes garbage collector.
+// More info: https://kubernetes.io/docs/tasks/administer-cluster/use-cascading-deletion/
+func (r *{{ .ReconcilerName }}) doFinalizerOperations(ctx context.Context, obj *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}) error {
+	_ = logf.FromContext(ctx)
+	_ = obj
+
+	return nil
+}
+{{- end }}
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *{{ .ReconcilerName }}) SetupWithManager(mgr ctrl.Manager) error {
@@ -145,7 +360,24 @@ func (r *{{ .ReconcilerName }}) SetupWithManager(mgr ctrl.Manager) error {
 		// Uncomment the following line adding a pointer to an instance of the controlled resource as an argument
 		// For().
 		{{- end }}
+		{{- range .Owns }}
+		Owns(&{{ .ImportAlias }}.{{ .Kind }}{}).
+		{{- end }}
+		{{- range .Watches }}
+		Watches(&{{ .ImportAlias }}.{{ .Kind }}{}, handler.EnqueueRequestsFromMapFunc(r.map{{ .Kind }}ToRequests)).
+		{{- end }}
 		Named("{{ .ControllerRuntimeName }}").
 		Complete(r)
 }
+{{ range .Watches }}
+// map{{ .Kind }}ToRequests maps a {{ .Kind }} to the {{ $.Resource.Kind }} objects to reconcile when it changes.
+// TODO(user): return a request for each {{ $.Resource.Kind }} that depends on the {{ .Kind }}, e.g. the ones
+// referencing it by name in their spec.
+func (r *{{ $.ReconcilerName }}) map{{ .Kind }}ToRequests(ctx context.Context, obj client.Object) []reconcile.Request {
+	_ = logf.FromContext(ctx)
+	_ = obj
+
+	return nil
+}
+{{ end -}}
 `
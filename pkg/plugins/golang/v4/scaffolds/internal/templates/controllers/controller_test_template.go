@@ -37,6 +37,11 @@ type ControllerTest struct {
 	Force bool
 
 	DoAPI bool
+
+	// WithFinalizer adds a case deleting the resource to exercise the finalizer of the controller
+	WithFinalizer bool
+	// WithConditions checks the status conditions reported by the controller
+	WithConditions bool
 }
 
 // SetTemplateDefaults implements machinery.Template
@@ -82,6 +87,9 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	{{- if .WithConditions }}
+	"k8s.io/apimachinery/pkg/api/meta"
+	{{- end }}
 	{{ if not (isEmptyStr .Resource.Path) -}}
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 	{{- end }}
@@ -120,10 +128,27 @@ var _ = Describe("{{ .Resource.Kind }} Controller", func() {
 			// TODO(user): Cleanup logic after each test, like removing the resource instance.
 			resource := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
 			err := k8sClient.Get(ctx, typeNamespacedName, resource)
+			{{- if .WithFinalizer }}
+			if errors.IsNotFound(err) {
+				return
+			}
+			{{- end }}
 			Expect(err).NotTo(HaveOccurred())
 
 			By("Cleanup the specific resource instance {{ .Resource.Kind }}")
 			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			{{- if .WithFinalizer }}
+
+			By("Reconciling the deleted resource to remove its finalizer")
+			controllerReconciler := &{{ .Resource.Kind }}Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			{{- end }}
 		})
 		{{- end }}
 		It("should successfully reconcile the resource", func() {
@@ -138,10 +163,48 @@ var _ = Describe("{{ .Resource.Kind }} Controller", func() {
 				NamespacedName: typeNamespacedName,
 			})
 			Expect(err).NotTo(HaveOccurred())
+			{{- if .WithConditions }}
+
+			By("Checking the status conditions of the reconciled resource")
+			resource := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(meta.IsStatusConditionTrue(resource.Status.Conditions, "Available")).To(BeTrue())
+			{{- end }}
 			{{- end }}
 			// TODO(user): Add more specific assertions depending on your controller's reconciliation logic.
 			// Example: If you expect a certain status condition after reconciliation, verify it here.
 		})
+		{{- if and .DoAPI .WithFinalizer }}
+
+		It("should run the finalizer when the resource is deleted", func() {
+			controllerReconciler := &{{ .Resource.Kind }}Reconciler{
+				Client: k8sClient,
+				Scheme: k8sClient.Scheme(),
+			}
+
+			By("Reconciling the created resource to add the finalizer")
+			_, err := controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			resource := &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.GetFinalizers()).NotTo(BeEmpty())
+
+			By("Deleting the resource, which is kept until the finalizer is removed")
+			Expect(k8sClient.Delete(ctx, resource)).To(Succeed())
+			Expect(k8sClient.Get(ctx, typeNamespacedName, resource)).To(Succeed())
+			Expect(resource.GetDeletionTimestamp()).NotTo(BeNil())
+
+			By("Reconciling the deleted resource to perform the finalizer operations")
+			_, err = controllerReconciler.Reconcile(ctx, reconcile.Request{
+				NamespacedName: typeNamespacedName,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			err = k8sClient.Get(ctx, typeNamespacedName, resource)
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+		{{- end }}
 	})
 })
 `
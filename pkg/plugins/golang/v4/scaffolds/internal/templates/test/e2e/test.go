@@ -30,6 +30,7 @@ import (
 var (
 	_ machinery.Template = &Test{}
 	_ machinery.Inserter = &WebhookTestUpdater{}
+	_ machinery.Inserter = &WebhookTestRemover{}
 )
 
 const (
@@ -130,6 +131,18 @@ func (f *WebhookTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 				fragments = append(fragments, validatingWebhookCode)
 			}
 
+			if f.Resource != nil && f.Resource.HasDefaultingWebhook() {
+				if check := coreObjectWebhookCheck(f.Resource, true); check != "" {
+					fragments = append(fragments, check)
+				}
+			}
+
+			if f.Resource != nil && f.Resource.HasValidationWebhook() {
+				if check := coreObjectWebhookCheck(f.Resource, false); check != "" {
+					fragments = append(fragments, check)
+				}
+			}
+
 			if f.Resource != nil && f.Resource.HasConversionWebhook() {
 				conversionWebhookCode := fmt.Sprintf(
 					conversionWebhookChecksFragment,
@@ -185,6 +198,97 @@ func (f *WebhookTestUpdater) GetCodeFragments() machinery.CodeFragmentsMap {
 	return codeFragments
 }
 
+// WebhookTestRemover selects the webhook validation tests of e2e_test.go that are no longer needed,
+// so they can be removed with machinery.Scaffold.Delete
+type WebhookTestRemover struct {
+	machinery.ProjectNameMixin
+	machinery.ResourceMixin
+
+	// RemoveWebhookChecks removes the checks shared by every webhook type
+	RemoveWebhookChecks bool
+	// RemoveServiceReadiness removes the readiness checks of the webhook service
+	RemoveServiceReadiness bool
+	// RemoveMutating removes the checks of the mutating webhook configuration
+	RemoveMutating bool
+	// RemoveValidating removes the checks of the validating webhook configuration
+	RemoveValidating bool
+	// RemoveConversion removes the check of the conversion webhook of the resource
+	RemoveConversion bool
+	// RemoveObjectDefaulting and RemoveObjectValidation remove the checks that send an object of the resource,
+	// a core type, to its defaulting or validation webhook
+	RemoveObjectDefaulting bool
+	RemoveObjectValidation bool
+}
+
+// GetPath implements file.Builder
+func (*WebhookTestRemover) GetPath() string {
+	return filepath.Join("test", "e2e", "e2e_test.go")
+}
+
+// GetIfExistsAction implements file.Builder
+func (*WebhookTestRemover) GetIfExistsAction() machinery.IfExistsAction {
+	return machinery.OverwriteFile
+}
+
+// GetMarkers implements file.Inserter
+func (f *WebhookTestRemover) GetMarkers() []machinery.Marker {
+	return []machinery.Marker{
+		machinery.NewMarkerFor(f.GetPath(), webhookChecksMarker),
+		machinery.NewMarkerFor(f.GetPath(), metricsWebhookReadinessMarker),
+	}
+}
+
+// GetCodeFragments implements file.Inserter
+func (f *WebhookTestRemover) GetCodeFragments() machinery.CodeFragmentsMap {
+	var checks, readiness []string
+
+	if f.RemoveWebhookChecks {
+		checks = append(checks, webhookChecksFragment)
+	}
+	if f.RemoveMutating {
+		checks = append(checks, fmt.Sprintf(mutatingWebhookChecksFragment, f.ProjectName))
+		readiness = append(readiness, fmt.Sprintf(mutatingWebhookReadinessFragment, f.ProjectName))
+	}
+	if f.RemoveValidating {
+		checks = append(checks, fmt.Sprintf(validatingWebhookChecksFragment, f.ProjectName))
+		readiness = append(readiness, fmt.Sprintf(validatingWebhookReadinessFragment, f.ProjectName))
+	}
+	if f.RemoveObjectDefaulting {
+		if check := coreObjectWebhookCheck(f.Resource, true); check != "" {
+			checks = append(checks, check)
+		}
+	}
+	if f.RemoveObjectValidation {
+		if check := coreObjectWebhookCheck(f.Resource, false); check != "" {
+			checks = append(checks, check)
+		}
+	}
+	if f.RemoveConversion && f.Resource != nil {
+		checks = append(checks, fmt.Sprintf(
+			conversionWebhookChecksFragment,
+			f.Resource.Kind,
+			f.Resource.Plural+"."+f.Resource.Group+"."+f.Resource.Domain,
+		))
+	}
+	if f.RemoveServiceReadiness {
+		webhookServiceName := fmt.Sprintf("%s-webhook-service", f.ProjectName)
+		readiness = append(readiness,
+			fmt.Sprintf(webhookEndpointsReadinessFragment, webhookServiceName),
+			webhookStabilizationFragment,
+		)
+	}
+
+	codeFragments := machinery.CodeFragmentsMap{}
+	if len(checks) > 0 {
+		codeFragments[machinery.NewMarkerFor(f.GetPath(), webhookChecksMarker)] = checks
+	}
+	if len(readiness) > 0 {
+		codeFragments[machinery.NewMarkerFor(f.GetPath(), metricsWebhookReadinessMarker)] = readiness
+	}
+
+	return codeFragments
+}
+
 const webhookChecksFragment = `It("should provisioned cert-manager", func() {
 	By("validating that cert-manager has the certificate Secret")
 	verifyCertManager := func(g Gomega) {
@@ -0,0 +1,169 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package e2e
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// webhookTestObjectSpecs holds the spec of the objects that the e2e tests send to the webhooks of core types,
+// keyed by <group>/<version>/<Kind>. Webhooks of other types are left to the user to test.
+var webhookTestObjectSpecs = map[string]string{
+	"core/v1/Pod": `spec:
+  containers:
+  - name: test
+    image: busybox
+`,
+	"core/v1/ConfigMap": `data:
+  key: value
+`,
+	"apps/v1/Deployment": `spec:
+  selector:
+    matchLabels:
+      app: webhook-test
+  template:
+    metadata:
+      labels:
+        app: webhook-test
+    spec:
+      containers:
+      - name: test
+        image: busybox
+`,
+}
+
+// coreObjectWebhookCheck returns the e2e test that sends an object of a core type to its defaulting
+// (mutating) or validation webhook, or an empty string if no object of the type is known.
+// The namespace and the object are labeled to match the selectors of the webhooks.
+func coreObjectWebhookCheck(res *resource.Resource, mutating bool) string {
+	if res == nil || !res.Core || res.Webhooks == nil {
+		return ""
+	}
+	spec, found := webhookTestObjectSpecs[res.Group+"/"+res.Version+"/"+res.Kind]
+	if !found {
+		return ""
+	}
+
+	webhookType, logMessage := "validation", fmt.Sprintf("Validation for %s upon creation", res.Kind)
+	testNamespace := "e2e-validating-" + strings.ToLower(res.Kind)
+	if mutating {
+		webhookType, logMessage = "defaulting", fmt.Sprintf("Defaulting for %s", res.Kind)
+		testNamespace = "e2e-mutating-" + strings.ToLower(res.Kind)
+	}
+
+	var labelNamespace string
+	if nsLabels := matchingLabels(res.Webhooks.NamespaceSelector); len(nsLabels) > 0 {
+		args := make([]string, 0, len(nsLabels))
+		for _, label := range nsLabels {
+			args = append(args, fmt.Sprintf("%q", label))
+		}
+		labelNamespace = fmt.Sprintf(labelNamespaceFragment, strings.Join(args, ", "))
+	}
+
+	apiVersion := res.QualifiedGroup() + "/" + res.Version
+	if res.QualifiedGroup() == "core" {
+		apiVersion = res.Version
+	}
+	var manifest strings.Builder
+	fmt.Fprintf(&manifest, "apiVersion: %s\nkind: %s\nmetadata:\n  name: webhook-test\n", apiVersion, res.Kind)
+	if objectLabels := matchingLabels(res.Webhooks.ObjectSelector); len(objectLabels) > 0 {
+		manifest.WriteString("  labels:\n")
+		for _, label := range objectLabels {
+			key, value, _ := strings.Cut(label, "=")
+			fmt.Fprintf(&manifest, "    %s: %q\n", key, value)
+		}
+	}
+	manifest.WriteString(spec)
+
+	return fmt.Sprintf(coreObjectWebhookCheckFragment,
+		webhookType, res.Kind, testNamespace, labelNamespace, manifest.String(), logMessage)
+}
+
+// matchingLabels returns labels, formatted as <key>=<value> and sorted, that an object needs to match
+// the label selector. Requirements that an object without labels already matches are skipped.
+func matchingLabels(selector string) []string {
+	labelSelector, err := metav1.ParseToLabelSelector(selector)
+	if err != nil || labelSelector == nil {
+		return nil
+	}
+
+	labels := maps.Clone(labelSelector.MatchLabels)
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	for _, requirement := range labelSelector.MatchExpressions {
+		switch requirement.Operator {
+		case metav1.LabelSelectorOpIn:
+			labels[requirement.Key] = requirement.Values[0]
+		case metav1.LabelSelectorOpExists:
+			labels[requirement.Key] = "e2e"
+		default:
+		}
+	}
+
+	formatted := make([]string, 0, len(labels))
+	for _, key := range slices.Sorted(maps.Keys(labels)) {
+		formatted = append(formatted, key+"="+labels[key])
+	}
+	return formatted
+}
+
+const labelNamespaceFragment = `
+
+	By("labeling the namespace to match the namespace selector of the webhook")
+	cmd = exec.Command("kubectl", "label", "--overwrite", "ns", testNamespace, %s)
+	_, err = utils.Run(cmd)
+	Expect(err).NotTo(HaveOccurred(), "Failed to label namespace")`
+
+const coreObjectWebhookCheckFragment = `It("should call the %[1]s webhook for %[2]s objects", func() {
+	testNamespace := "%[3]s"
+	By("creating a namespace for the %[2]s sent to the %[1]s webhook")
+	cmd := exec.Command("kubectl", "create", "ns", testNamespace)
+	_, err := utils.Run(cmd)
+	Expect(err).NotTo(HaveOccurred(), "Failed to create namespace")
+	DeferCleanup(func() {
+		cmd := exec.Command("kubectl", "delete", "ns", testNamespace, "--ignore-not-found")
+		_, _ = utils.Run(cmd)
+	})%[4]s
+
+	By("writing the manifest of the %[2]s")
+	manifest := filepath.Join("/tmp", testNamespace+".yaml")
+	err = os.WriteFile(manifest, []byte(` + "`" + `%[5]s` + "`" + `), os.FileMode(0o644))
+	Expect(err).NotTo(HaveOccurred(), "Failed to write the %[2]s manifest")
+
+	By("creating the %[2]s with a server-side dry run, which calls the webhook without persisting it")
+	verifyWebhookCalled := func(g Gomega) {
+		// The webhook may reject the object: only check that it was called
+		cmd := exec.Command("kubectl", "create", "--dry-run=server", "-n", testNamespace, "-f", manifest)
+		_, _ = utils.Run(cmd)
+
+		cmd = exec.Command("kubectl", "logs", controllerPodName, "-n", namespace)
+		output, err := utils.Run(cmd)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(output).To(ContainSubstring("%[6]s"))
+	}
+	Eventually(verifyWebhookCalled).Should(Succeed())
+})
+
+`
@@ -45,6 +45,10 @@ type WebhookUpdater struct {
 
 	// AdmissionReviewVersions defines value for AdmissionReviewVersions marker
 	AdmissionReviewVersions string
+
+	// FailurePolicy and SideEffects define the values of the markers with the same name
+	FailurePolicy string
+	SideEffects   string
 }
 
 // GetPath implements file.Builder
@@ -79,6 +83,7 @@ func (f *WebhookUpdater) SetTemplateDefaults() error {
 
 	f.QualifiedGroupWithDash = strings.ReplaceAll(f.Resource.QualifiedGroup(), ".", "-")
 	f.AdmissionReviewVersions = "v1"
+	f.FailurePolicy, f.SideEffects = admissionSettings(f.Resource.Webhooks)
 
 	fileContent := string(content)
 	var newCode strings.Builder
@@ -280,7 +285,7 @@ func (f *WebhookUpdater) generateDefaultingWebhookCode() string {
 	//nolint:lll
 	code.WriteString(fmt.Sprintf(
 		`
-// +kubebuilder:webhook:path=%s,mutating=true,failurePolicy=fail,sideEffects=None,groups=%s,resources=%s,verbs=create;update,versions=%s,name=m%s-%s.kb.io,admissionReviewVersions=%s
+// +kubebuilder:webhook:path=%s,mutating=true,failurePolicy=%s,sideEffects=%s,groups=%s,resources=%s,verbs=create;update,versions=%s,name=m%s-%s.kb.io,admissionReviewVersions=%s
 
 // %sCustomDefaulter struct is responsible for setting default values on the custom resource of the
 // Kind %s when those are created or updated.
@@ -292,7 +297,7 @@ type %sCustomDefaulter struct {
 }
 
 `,
-		defaultingPath, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
+		defaultingPath, f.FailurePolicy, f.SideEffects, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
 		strings.ToLower(f.Resource.Kind), f.Resource.Version,
 		f.AdmissionReviewVersions,
 		f.Resource.Kind, f.Resource.Kind, f.Resource.Kind))
@@ -338,7 +343,7 @@ func (f *WebhookUpdater) generateValidationWebhookCode() string {
 `)
 	//nolint:lll
 	code.WriteString(fmt.Sprintf(
-		`// +kubebuilder:webhook:path=%s,mutating=false,failurePolicy=fail,sideEffects=None,groups=%s,resources=%s,verbs=create;update,versions=%s,name=v%s-%s.kb.io,admissionReviewVersions=%s
+		`// +kubebuilder:webhook:path=%s,mutating=false,failurePolicy=%s,sideEffects=%s,groups=%s,resources=%s,verbs=create;update,versions=%s,name=v%s-%s.kb.io,admissionReviewVersions=%s
 
 // %sCustomValidator struct is responsible for validating the %s resource
 // when it is created, updated, or deleted.
@@ -350,7 +355,7 @@ type %sCustomValidator struct{
 }
 
 `,
-		validationPath, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
+		validationPath, f.FailurePolicy, f.SideEffects, f.getGroupValue(), f.Resource.Plural, f.Resource.Version,
 		strings.ToLower(f.Resource.Kind), f.Resource.Version, f.AdmissionReviewVersions,
 		f.Resource.Kind, f.Resource.Kind, f.Resource.Kind))
 
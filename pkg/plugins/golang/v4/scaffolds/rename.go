@@ -0,0 +1,418 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	log "log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/afero"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+)
+
+var _ plugins.Scaffolder = &renameScaffolder{}
+
+var (
+	// renameSkippedDirs are the directories whose files are never rewritten
+	renameSkippedDirs = map[string]struct{}{
+		".git":         {},
+		".kubebuilder": {},
+		"bin":          {},
+		"vendor":       {},
+	}
+
+	// renameSkippedFiles are the files that are never rewritten, as the PROJECT file is saved
+	// from the updated configuration and go.sum only lists the dependencies of the project
+	renameSkippedFiles = map[string]struct{}{
+		"PROJECT": {},
+		"go.sum":  {},
+	}
+)
+
+type renameScaffolder struct {
+	config config.Config
+	domain string
+	repo   string
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewRenameScaffolder returns a new Scaffolder that changes the domain and the Go module of a project.
+// An empty domain or repo keeps the current value.
+func NewRenameScaffolder(cfg config.Config, domain, repo string) plugins.Scaffolder {
+	return &renameScaffolder{
+		config: cfg,
+		domain: domain,
+		repo:   repo,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *renameScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *renameScaffolder) Scaffold() error {
+	r := projectRewriter{groups: make(map[string]string)}
+	if s.domain != "" && s.domain != s.config.GetDomain() {
+		r.oldDomain, r.newDomain = s.config.GetDomain(), s.domain
+		r.leaderElectionID = regexp.MustCompile(`(LeaderElectionID:\s*"[^"]*\.)` + regexp.QuoteMeta(r.oldDomain) + `"`)
+	}
+	if s.repo != "" && s.repo != s.config.GetRepository() {
+		r.oldRepo, r.newRepo = s.config.GetRepository(), s.repo
+	}
+	if r.oldDomain == "" && r.oldRepo == "" {
+		return nil
+	}
+
+	resources, err := s.config.GetResources()
+	if err != nil {
+		return fmt.Errorf("failed to get resources: %w", err)
+	}
+
+	if r.oldDomain != "" {
+		for _, res := range resources {
+			if isOwnedResource(res) && res.Domain == r.oldDomain {
+				r.groups[res.QualifiedGroup()] = resource.GVK{Group: res.Group, Domain: r.newDomain}.QualifiedGroup()
+			}
+		}
+	}
+
+	if err = r.checkUnownedResources(resources); err != nil {
+		return err
+	}
+
+	if err = s.rewriteFiles(r); err != nil {
+		return err
+	}
+
+	if err = s.updateConfig(r, resources); err != nil {
+		return err
+	}
+
+	if len(r.groups) > 0 {
+		log.Warn("the API groups of the project were renamed; " +
+			"CRDs and custom resources already installed in clusters keep the previous groups")
+	}
+
+	fmt.Println()
+	fmt.Println("Next steps:")
+	fmt.Println("1. Run: go mod tidy")
+	fmt.Println("2. Run: make manifests generate")
+	if len(r.groups) > 0 {
+		fmt.Println("3. Reinstall the CRDs and recreate the custom resources in your clusters")
+	}
+
+	return nil
+}
+
+// isOwnedResource checks if the API of the resource is defined by the project itself
+func isOwnedResource(res resource.Resource) bool {
+	return !res.IsExternal() && !res.Core
+}
+
+// rewriteFiles rewrites the content and the names of the project files
+func (s *renameScaffolder) rewriteFiles(r projectRewriter) error {
+	var paths []string
+	err := afero.Walk(s.fs.FS, ".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if _, skip := renameSkippedDirs[info.Name()]; skip && path != "." {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if _, skip := renameSkippedFiles[filepath.ToSlash(path)]; !skip && info.Mode().IsRegular() {
+			paths = append(paths, path)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list the project files: %w", err)
+	}
+
+	for _, path := range paths {
+		if err = s.rewriteFile(r, path); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rewriteFile rewrites the content of the file at path and renames it if needed
+func (s *renameScaffolder) rewriteFile(r projectRewriter, path string) error {
+	info, err := s.fs.FS.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat %q: %w", path, err)
+	}
+	content, err := afero.ReadFile(s.fs.FS, path)
+	if err != nil {
+		return fmt.Errorf("failed to read %q: %w", path, err)
+	}
+	// Binary files are left as they are
+	if bytes.IndexByte(content, 0) != -1 {
+		return nil
+	}
+
+	updated := []byte(r.rewriteContent(string(content)))
+	newPath := r.rewritePath(path)
+	if bytes.Equal(updated, content) && newPath == path {
+		return nil
+	}
+
+	// The imports of the project may have to be sorted again once the module changes
+	if filepath.Ext(path) == ".go" {
+		if formatted, fmtErr := format.Source(updated); fmtErr == nil {
+			updated = formatted
+		}
+	}
+
+	// Renamed files keep being tracked by the scaffold manifest only if they were not modified
+	var entry machinery.ManifestEntry
+	var unmodified bool
+	if newPath != path && s.fs.Manifest != nil {
+		entry, _ = s.fs.Manifest.Get(path)
+		if unmodified, err = s.fs.Manifest.IsUnmodified(s.fs.FS, path); err != nil {
+			return fmt.Errorf("failed to check if %q was modified: %w", path, err)
+		}
+	}
+
+	if err = afero.WriteFile(s.fs.FS, newPath, updated, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("failed to write %q: %w", newPath, err)
+	}
+	if newPath == path {
+		return nil
+	}
+
+	if err = s.fs.FS.Remove(path); err != nil {
+		return fmt.Errorf("failed to remove %q: %w", path, err)
+	}
+	log.Info("renamed file", "path", newPath)
+
+	if s.fs.Manifest != nil {
+		s.fs.Manifest.Remove(path)
+		if unmodified {
+			s.fs.Manifest.Record(newPath, entry.Plugin, entry.Template, updated)
+		}
+	}
+
+	return nil
+}
+
+// updateConfig updates the domain, the repository and the resources of the project configuration
+func (s *renameScaffolder) updateConfig(r projectRewriter, resources []resource.Resource) error {
+	tracker, isTracker := s.config.(config.ProvenanceTracker)
+
+	// Resources are identified by their domain, so they are all added again to keep their order
+	records := make([][]config.ScaffoldRecord, len(resources))
+	for i, res := range resources {
+		if isTracker {
+			var err error
+			if records[i], err = tracker.GetScaffoldRecords(res.GVK); err != nil {
+				return fmt.Errorf("failed to get the scaffold records of %q: %w", res.GVK, err)
+			}
+		}
+		if err := s.config.RemoveResource(res.GVK); err != nil {
+			return fmt.Errorf("failed to remove resource %q: %w", res.GVK, err)
+		}
+	}
+
+	for i, res := range resources {
+		if isOwnedResource(res) {
+			r.rewriteResource(&res)
+			for j := range records[i] {
+				for k, flag := range records[i][j].Flags {
+					records[i][j].Flags[k] = r.rewriteContent(flag)
+				}
+			}
+		}
+
+		if err := s.config.AddResource(res); err != nil {
+			return fmt.Errorf("failed to add resource %q: %w", res.GVK, err)
+		}
+		for _, record := range records[i] {
+			if err := tracker.RecordScaffold(res.GVK, record); err != nil {
+				return fmt.Errorf("failed to record the scaffold of %q: %w", res.GVK, err)
+			}
+		}
+	}
+
+	if r.newDomain != "" {
+		if err := s.config.SetDomain(r.newDomain); err != nil {
+			return fmt.Errorf("failed to set domain: %w", err)
+		}
+	}
+	if r.newRepo != "" {
+		if err := s.config.SetRepository(r.newRepo); err != nil {
+			return fmt.Errorf("failed to set repository: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// projectRewriter replaces the domain and the Go module of a project.
+// Empty old values mean that the corresponding value is not replaced.
+type projectRewriter struct {
+	oldDomain, newDomain string
+	oldRepo, newRepo     string
+
+	// groups maps the qualified API groups owned by the project to their new value
+	groups map[string]string
+	// leaderElectionID matches the leader election ID of the manager, which ends with the domain
+	leaderElectionID *regexp.Regexp
+}
+
+// checkUnownedResources refuses to rename the project when external or core resources would be rewritten,
+// and warns about the external resources that share the domain of the project but are left as they are.
+func (r projectRewriter) checkUnownedResources(resources []resource.Resource) error {
+	for _, res := range resources {
+		if isOwnedResource(res) {
+			continue
+		}
+
+		if r.oldDomain != "" {
+			if _, found := r.groups[res.QualifiedGroup()]; found {
+				return fmt.Errorf("cannot change the domain: resource %q is not defined by the project "+
+					"but its API group %q would be renamed", res.GVK, res.QualifiedGroup())
+			}
+			if res.Domain == r.oldDomain {
+				log.Warn("resource is not defined by the project, so its API group keeps the previous domain",
+					"gvk", res.GVK)
+			}
+		}
+
+		if r.oldRepo != "" && (res.Path == r.oldRepo || strings.HasPrefix(res.Path, r.oldRepo+"/")) {
+			return fmt.Errorf("cannot change the repository: resource %q is not defined by the project "+
+				"but its import path %q would be rewritten", res.GVK, res.Path)
+		}
+	}
+
+	return nil
+}
+
+// rewriteResource updates the domain, the import path and the webhook paths of a resource owned by the project
+func (r projectRewriter) rewriteResource(res *resource.Resource) {
+	if r.oldDomain != "" && res.Domain == r.oldDomain {
+		res.Domain = r.newDomain
+	}
+	if r.oldRepo != "" {
+		res.Path = r.rewriteContent(res.Path)
+	}
+	if res.Webhooks != nil {
+		res.Webhooks.DefaultingPath = r.rewriteContent(res.Webhooks.DefaultingPath)
+		res.Webhooks.ValidationPath = r.rewriteContent(res.Webhooks.ValidationPath)
+	}
+}
+
+// rewriteContent replaces the API groups, the webhook paths, the leader election ID and the import paths
+func (r projectRewriter) rewriteContent(content string) string {
+	for oldGroup, newGroup := range r.groups {
+		content = replaceToken(content, oldGroup, newGroup, isLabelByte, continuesHostname)
+		// Default webhook paths embed the group with dashes, e.g. /mutate-crew-example-com-v1-captain
+		content = strings.ReplaceAll(content,
+			"-"+strings.ReplaceAll(oldGroup, ".", "-")+"-", "-"+strings.ReplaceAll(newGroup, ".", "-")+"-")
+	}
+
+	if r.leaderElectionID != nil {
+		content = r.leaderElectionID.ReplaceAllString(content, "${1}"+r.newDomain+`"`)
+	}
+
+	if r.oldRepo != "" {
+		content = replaceToken(content, r.oldRepo, r.newRepo, isImportPathByte, continuesImportPathElem)
+	}
+
+	return content
+}
+
+// rewritePath renames files named after the API groups, e.g. config/crd/bases/crew.example.com_captains.yaml
+func (r projectRewriter) rewritePath(path string) string {
+	dir, name := filepath.Split(path)
+	for oldGroup, newGroup := range r.groups {
+		name = replaceToken(name, oldGroup, newGroup, isLabelByte, continuesHostname)
+	}
+
+	return dir + name
+}
+
+// replaceToken replaces the occurrences of old in s that are not part of a longer token, i.e. that are
+// neither preceded by a byte for which before returns true nor followed by a string for which after returns true.
+func replaceToken(s, old, replacement string, before func(byte) bool, after func(string) bool) string {
+	var b strings.Builder
+	for {
+		i := strings.Index(s, old)
+		if i == -1 {
+			b.WriteString(s)
+			return b.String()
+		}
+
+		end := i + len(old)
+		if (i > 0 && before(s[i-1])) || after(s[end:]) {
+			b.WriteString(s[:end])
+		} else {
+			b.WriteString(s[:i])
+			b.WriteString(replacement)
+		}
+		s = s[end:]
+	}
+}
+
+// isLabelByte checks if c can be part of a DNS label
+func isLabelByte(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '-'
+}
+
+// continuesHostname checks if rest continues a hostname, a trailing dot ending a sentence does not
+func continuesHostname(rest string) bool {
+	return rest != "" && (isLabelByte(rest[0]) || rest[0] == '.' && len(rest) > 1 && isLabelByte(rest[1]))
+}
+
+// isImportPathElemByte checks if c can be part of an element of a Go import path
+func isImportPathElemByte(c byte) bool {
+	return isLabelByte(c) || c == '.' || c == '_' || c == '~'
+}
+
+// isImportPathByte checks if c can be part of a Go import path
+func isImportPathByte(c byte) bool {
+	return isImportPathElemByte(c) || c == '/'
+}
+
+// continuesImportPathElem checks if rest continues the last element of an import path,
+// a trailing dot ending a sentence does not
+func continuesImportPathElem(rest string) bool {
+	if rest == "" || !isImportPathElemByte(rest[0]) {
+		return false
+	}
+	return rest[0] != '.' || len(rest) > 1 && isImportPathElemByte(rest[1])
+}
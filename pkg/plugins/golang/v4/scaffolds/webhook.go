@@ -103,6 +103,11 @@ func (s *webhookScaffolder) Scaffold() error {
 		return fmt.Errorf("error updating resource: %w", err)
 	}
 
+	// A ValidatingAdmissionPolicy has no Go code, its manifests are scaffolded by the kustomize plugin
+	if !doDefaulting && !doValidation && !doConversion {
+		return nil
+	}
+
 	// Check if webhook files exist
 	webhookFilePath := s.getWebhookFilePath()
 	webhookFileExists := false
@@ -177,13 +182,14 @@ You need to implement the conversion.Hub and conversion.Convertible interfaces f
 
 	// TODO: remove for go/v5
 	if !s.isLegacy {
-		if hasInternalController, err := pluginutil.HasFileContentWith("Dockerfile", "internal/controller"); err != nil {
+		hasInternalController, err := pluginutil.HasFileContentWithInFS(s.fs, "Dockerfile", "internal/controller")
+		if err != nil {
 			log.Error("failed to read Dockerfile to check if webhook(s) will be properly copied", "error", err)
 		} else if hasInternalController {
 			log.Warn("Dockerfile is copying internal/controller; to allow copying webhooks, " +
 				"it will be edited, and `internal/controller` will be replaced by `internal/`")
 
-			if err = pluginutil.ReplaceInFile("Dockerfile", "internal/controller", "internal/"); err != nil {
+			if err = pluginutil.ReplaceInFileInFS(s.fs, "Dockerfile", "internal/controller", "internal/"); err != nil {
 				log.Error("failed to replace \"internal/controller\" with \"internal/\" in the Dockerfile", "error", err)
 			}
 		}
@@ -0,0 +1,89 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scaffolds
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4/scaffolds/internal/templates/cmd"
+)
+
+// WireOptions selects the code of a resource to wire into cmd/main.go
+type WireOptions struct {
+	// Resource registers the API types of the resource in the manager scheme
+	Resource bool
+	// Controllers sets up the controllers with the provided names
+	Controllers []string
+	// Webhook sets up the webhooks of the resource
+	Webhook bool
+	// IsLegacyPath indicates that the webhooks are scaffolded under the API (deprecated layout)
+	IsLegacyPath bool
+}
+
+var _ plugins.Scaffolder = &wireScaffolder{}
+
+// wireScaffolder contains configuration for wiring an already scaffolded resource into cmd/main.go
+type wireScaffolder struct {
+	config   config.Config
+	resource resource.Resource
+	options  WireOptions
+
+	// fs is the filesystem that will be used by the scaffolder
+	fs machinery.Filesystem
+}
+
+// NewWireScaffolder returns a new Scaffolder that wires the scheme registration, controllers and
+// webhooks of an already scaffolded resource into cmd/main.go. Code already present is not duplicated.
+func NewWireScaffolder(cfg config.Config, res resource.Resource, options WireOptions) plugins.Scaffolder {
+	return &wireScaffolder{
+		config:   cfg,
+		resource: res,
+		options:  options,
+	}
+}
+
+// InjectFS implements cmdutil.Scaffolder
+func (s *wireScaffolder) InjectFS(fs machinery.Filesystem) {
+	s.fs = fs
+}
+
+// Scaffold implements cmdutil.Scaffolder
+func (s *wireScaffolder) Scaffold() error {
+	scaffold := machinery.NewScaffold(s.fs,
+		machinery.WithConfig(s.config),
+		machinery.WithResource(&s.resource),
+	)
+
+	updaters := []machinery.Builder{&cmd.MainUpdater{
+		WireResource: s.options.Resource,
+		WireWebhook:  s.options.Webhook,
+		IsLegacyPath: s.options.IsLegacyPath,
+	}}
+	for _, controllerName := range s.options.Controllers {
+		updaters = append(updaters, &cmd.MainUpdater{WireController: true, ControllerName: controllerName})
+	}
+
+	if err := scaffold.Execute(updaters...); err != nil {
+		return fmt.Errorf("error updating cmd/main.go: %w", err)
+	}
+
+	return nil
+}
@@ -40,6 +40,9 @@ type createWebhookSubcommand struct {
 	// For help text.
 	commandName string
 
+	// fs is used to know which flags were set explicitly
+	fs *pflag.FlagSet
+
 	options *goPlugin.Options
 
 	resource *resource.Resource
@@ -60,6 +63,23 @@ func (p *createWebhookSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, sub
 
 	subcmdMeta.Description = `Scaffold a webhook for an API resource. You can choose to scaffold defaulting,
 validating and/or conversion webhooks.
+
+Defaulting and validating webhooks can also be scaffolded for Kubernetes core types (e.g. Pods or
+Deployments) and for types of other projects (--external-api-path). Conversion webhooks are only
+available for the APIs of the project.
+
+Use --failure-policy and --side-effects to set these fields of the webhook configurations, and
+--namespace-selector and --object-selector to restrict the objects sent to the webhooks. Selectors
+use the label selector syntax (e.g. "env in (dev,prod),!legacy") and are scaffolded as kustomize
+patches under config/webhook/patches.
+
+Webhooks for core types also intercept the objects of the manager, e.g. its Pods, which would block
+its deployment. Unless a selector is provided, their namespace selector excludes the namespace of the
+manager.
+
+With --backend=cel, --programmatic-validation scaffolds a ValidatingAdmissionPolicy and its binding
+under config/admission-policy instead of a validation webhook. Its CEL expressions are evaluated by
+the API server, so no webhook server nor cert-manager is needed to validate the resource.
 `
 	subcmdMeta.Examples = fmt.Sprintf(`  # Create defaulting and validating webhooks for Group: ship, Version: v1beta1
   # and Kind: Frigate
@@ -83,10 +103,24 @@ validating and/or conversion webhooks.
   %[1]s create webhook --group ship --version v1beta1 --kind Frigate \
     --defaulting --programmatic-validation \
     --defaulting-path=/custom-mutate --validation-path=/custom-validate
+
+  # Create a validation webhook for Pods, called only for the Pods of the namespaces
+  # labeled with "policy=enforced", and ignored when the webhook is unavailable
+  %[1]s create webhook --group core --version v1 --kind Pod --programmatic-validation \
+    --namespace-selector=policy=enforced --failure-policy=Ignore
+
+  # Create a defaulting webhook for Deployments, skipping the Deployments labeled with "webhooks=skip"
+  %[1]s create webhook --group apps --version v1 --kind Deployment --defaulting \
+    --object-selector='webhooks notin (skip)'
+
+  # Validate Frigates with the CEL expressions of a ValidatingAdmissionPolicy instead of a webhook
+  %[1]s create webhook --group ship --version v1beta1 --kind Frigate \
+    --programmatic-validation --backend=cel
 `, cliMeta.CommandName)
 }
 
 func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
+	p.fs = fs
 	p.options = &goPlugin.Options{}
 
 	fs.BoolVar(&p.runMake, "make", true,
@@ -112,6 +146,23 @@ func (p *createWebhookSubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.StringVar(&p.options.ValidationPath, "validation-path", "",
 		"Custom path for the validation webhook (e.g., /my-custom-validate-path); only valid with --programmatic-validation")
 
+	fs.StringVar(&p.options.ValidationBackend, "backend", goPlugin.ValidationBackendWebhook,
+		"How the resource is validated with --programmatic-validation: webhook, or cel to scaffold "+
+			"a ValidatingAdmissionPolicy")
+
+	fs.StringVar(&p.options.FailurePolicy, "failure-policy", "",
+		"Failure policy of the defaulting and validation webhooks: Fail (default) or Ignore")
+
+	fs.StringVar(&p.options.SideEffects, "side-effects", "",
+		"Side effects of the defaulting and validation webhooks: None (default) or NoneOnDryRun")
+
+	fs.StringVar(&p.options.NamespaceSelector, "namespace-selector", "",
+		"Label selector of the namespaces whose objects are sent to the webhooks (e.g., 'env in (dev,prod)'); "+
+			"for core types, defaults to excluding the manager namespace unless a selector is provided")
+
+	fs.StringVar(&p.options.ObjectSelector, "object-selector", "",
+		"Label selector of the objects sent to the webhooks (e.g., 'app=web,!legacy')")
+
 	// TODO: remove for go/v5
 	fs.BoolVar(&p.isLegacyPath, "legacy", false,
 		"[DEPRECATED] If set, attempts to create resource under the API directory (legacy path). "+
@@ -165,17 +216,47 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 		return errors.New("'--external-api-module' requires '--external-api-path' to be specified")
 	}
 
+	var err error
+	if p.options.ValidationBackend, err = normalizeFlagValue("backend", p.options.ValidationBackend,
+		goPlugin.ValidationBackendWebhook, goPlugin.ValidationBackendCEL); err != nil {
+		return err
+	}
+	if p.options.ValidationBackend == goPlugin.ValidationBackendCEL {
+		if !p.options.DoValidation {
+			return errors.New("--backend=cel can only be used with --programmatic-validation")
+		}
+		if p.options.ValidationPath != "" {
+			return errors.New("--validation-path cannot be used with --backend=cel, " +
+				"a ValidatingAdmissionPolicy is not served by the manager")
+		}
+	}
+	if p.options.FailurePolicy, err = normalizeFlagValue("failure-policy", p.options.FailurePolicy,
+		resource.FailurePolicyFail, resource.FailurePolicyIgnore); err != nil {
+		return err
+	}
+	if p.options.SideEffects, err = normalizeFlagValue("side-effects", p.options.SideEffects,
+		resource.SideEffectsNone, resource.SideEffectsNoneOnDryRun); err != nil {
+		return err
+	}
+
 	p.options.UpdateResource(p.resource, p.config)
 
 	if err := p.resource.Validate(); err != nil {
 		return fmt.Errorf("error validating resource: %w", err)
 	}
 
-	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() && !p.resource.HasConversionWebhook() {
+	if !p.resource.HasDefaultingWebhook() && !p.resource.HasValidationWebhook() &&
+		!p.resource.HasValidatingAdmissionPolicy() && !p.resource.HasConversionWebhook() {
 		return fmt.Errorf("%s create webhook requires at least one of --defaulting,"+
 			" --programmatic-validation and --conversion to be true", p.commandName)
 	}
 
+	// Conversion webhooks are served for the CRDs of the project, other types cannot be converted by the manager
+	if p.resource.HasConversionWebhook() && (p.resource.Core || p.resource.External) {
+		return errors.New("conversion webhooks can only be scaffolded for the APIs of the project, " +
+			"not for core or external types")
+	}
+
 	// check if resource exist to create webhook
 	resValue, err := p.config.GetResource(p.resource.GVK)
 	res = &resValue
@@ -191,9 +272,15 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 		if p.resource.HasValidationWebhook() && res.Webhooks.Validation {
 			return fmt.Errorf("validation webhook already exists for this resource")
 		}
+		if p.resource.HasValidatingAdmissionPolicy() && res.Webhooks.ValidatingAdmissionPolicy {
+			return fmt.Errorf("ValidatingAdmissionPolicy already exists for this resource")
+		}
 		if p.resource.HasConversionWebhook() && res.Webhooks.Conversion {
 			return fmt.Errorf("conversion webhook already exists for this resource")
 		}
+		if err := checkWebhookSettings(p.resource.Webhooks, res.Webhooks); err != nil {
+			return err
+		}
 		// If we're here, user is adding a new webhook type to existing resource
 		// Merge the webhook configurations
 		if err := p.resource.Webhooks.Update(res.Webhooks); err != nil {
@@ -201,6 +288,18 @@ func (p *createWebhookSubcommand) InjectResource(res *resource.Resource) error {
 		}
 	}
 
+	// Checked even with --force, since the code of the previous validation would be left behind
+	if err := checkValidationBackend(p.resource, res); err != nil {
+		return err
+	}
+
+	// A ValidatingAdmissionPolicy is evaluated by the API server, so it cannot block the Pods of the manager
+	servedByManager := p.resource.HasDefaultingWebhook() || p.resource.HasValidationWebhook()
+	if p.resource.Core && servedByManager && !p.hasSelectorFlags() &&
+		(res.Webhooks == nil || !res.Webhooks.HasSelectors()) {
+		p.excludeManagerNamespace()
+	}
+
 	return nil
 }
 
@@ -242,6 +341,78 @@ func (p *createWebhookSubcommand) PostScaffold() error {
 	return nil
 }
 
+// hasSelectorFlags returns true if a namespace or object selector was provided, even if empty
+func (p *createWebhookSubcommand) hasSelectorFlags() bool {
+	if p.fs != nil && (p.fs.Changed("namespace-selector") || p.fs.Changed("object-selector")) {
+		return true
+	}
+	return p.options.NamespaceSelector != "" || p.options.ObjectSelector != ""
+}
+
+// excludeManagerNamespace sets the namespace selector of the webhooks of a core type to skip the objects
+// of the manager namespace. Otherwise, the webhooks would intercept the Pods of the manager, which cannot
+// start until the webhook server runs in them.
+func (p *createWebhookSubcommand) excludeManagerNamespace() {
+	projectName := p.config.GetProjectName()
+	if projectName == "" {
+		log.Warn("unable to exclude the manager namespace from the webhooks of core types: " +
+			"the project name is not set; use --namespace-selector or --object-selector")
+		return
+	}
+
+	p.resource.Webhooks.NamespaceSelector = fmt.Sprintf("kubernetes.io/metadata.name notin (%s-system)", projectName)
+	log.Info("Webhooks for core types skip the objects of the manager namespace; "+
+		"use --namespace-selector or --object-selector to change it",
+		"namespaceSelector", p.resource.Webhooks.NamespaceSelector)
+}
+
+// checkValidationBackend returns an error if the resource would be validated both by a validation webhook
+// and by a ValidatingAdmissionPolicy. Switching between them requires removing the existing one first.
+func checkValidationBackend(res, existing *resource.Resource) error {
+	if res.HasValidatingAdmissionPolicy() && existing.HasValidationWebhook() {
+		return errors.New("the resource is already validated by a validation webhook; remove it with " +
+			"'delete webhook --programmatic-validation' to validate it with a ValidatingAdmissionPolicy")
+	}
+	if res.HasValidationWebhook() && existing.HasValidatingAdmissionPolicy() {
+		return errors.New("the resource is already validated by a ValidatingAdmissionPolicy; remove it with " +
+			"'delete webhook --programmatic-validation' to validate it with a webhook")
+	}
+	return nil
+}
+
+// checkWebhookSettings returns an error if the admission settings provided for the webhooks of a resource
+// differ from the ones of its existing webhooks, as they are shared by all of them.
+func checkWebhookSettings(webhooks, existing *resource.Webhooks) error {
+	settings := []struct {
+		flag, value, existing string
+	}{
+		{"failure-policy", webhooks.FailurePolicy, existing.FailurePolicy},
+		{"side-effects", webhooks.SideEffects, existing.SideEffects},
+		{"namespace-selector", webhooks.NamespaceSelector, existing.NamespaceSelector},
+		{"object-selector", webhooks.ObjectSelector, existing.ObjectSelector},
+	}
+	for _, setting := range settings {
+		if setting.value != "" && setting.existing != "" && setting.value != setting.existing {
+			return fmt.Errorf("--%s=%q does not match %q of the existing webhooks of this resource; "+
+				"run with --force to scaffold them again", setting.flag, setting.value, setting.existing)
+		}
+	}
+	return nil
+}
+
+// normalizeFlagValue returns the allowed value that matches the value of the flag regardless of case
+func normalizeFlagValue(flag, value string, allowed ...string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(value, a) {
+			return a, nil
+		}
+	}
+	return "", fmt.Errorf("invalid value %q for --%s: must be one of %s", value, flag, strings.Join(allowed, ", "))
+}
+
 // Helper function to validate spoke versions
 func isValidVersion(version string, res *resource.Resource, cfg config.Config) bool {
 	// Fetch all resources in the config
@@ -82,6 +82,132 @@ var _ = Describe("createWebhookSubcommand", func() {
 		Expect(err.Error()).To(ContainSubstring("requires '--external-api-path'"))
 	})
 
+	Context("admission settings", func() {
+		BeforeEach(func() {
+			_ = cfg.SetProjectName("test")
+			subCmd.config = cfg
+			res = &resource.Resource{
+				GVK: resource.GVK{
+					Group:   "apps",
+					Version: "v1",
+					Kind:    "Deployment",
+				},
+				Plural:   "deployments",
+				Core:     true,
+				Webhooks: &resource.Webhooks{},
+			}
+		})
+
+		It("should normalize the failure policy and the side effects", func() {
+			subCmd.options.DoDefaulting = true
+			subCmd.options.FailurePolicy = "ignore"
+			subCmd.options.SideEffects = "noneondryrun"
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Webhooks.FailurePolicy).To(Equal(resource.FailurePolicyIgnore))
+			Expect(res.Webhooks.SideEffects).To(Equal(resource.SideEffectsNoneOnDryRun))
+		})
+
+		It("should reject an unknown failure policy", func() {
+			subCmd.options.DoDefaulting = true
+			subCmd.options.FailurePolicy = "Retry"
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid value "Retry" for --failure-policy`))
+		})
+
+		It("should reject conversion webhooks for core types", func() {
+			subCmd.options.DoConversion = true
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("conversion webhooks can only be scaffolded for the APIs of the project"))
+		})
+
+		It("should exclude the manager namespace from the webhooks of core types by default", func() {
+			subCmd.options.DoValidation = true
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Webhooks.NamespaceSelector).To(Equal("kubernetes.io/metadata.name notin (test-system)"))
+			Expect(res.Webhooks.ObjectSelector).To(BeEmpty())
+		})
+
+		It("should keep the selectors provided for core types", func() {
+			subCmd.options.DoValidation = true
+			subCmd.options.ObjectSelector = "app in (web)"
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.Webhooks.NamespaceSelector).To(BeEmpty())
+			Expect(res.Webhooks.ObjectSelector).To(Equal("app in (web)"))
+		})
+
+		It("should reject settings that differ from the ones of the existing webhooks", func() {
+			existing := *res
+			existing.Path = "k8s.io/api/apps/v1"
+			existing.Webhooks = &resource.Webhooks{
+				WebhookVersion: "v1",
+				Defaulting:     true,
+				FailurePolicy:  resource.FailurePolicyIgnore,
+			}
+			Expect(cfg.AddResource(existing)).To(Succeed())
+			subCmd.options.DoValidation = true
+			subCmd.options.FailurePolicy = resource.FailurePolicyFail
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`--failure-policy="Fail" does not match "Ignore"`))
+		})
+
+		It("should validate with a ValidatingAdmissionPolicy when the backend is CEL", func() {
+			subCmd.options.DoValidation = true
+			subCmd.options.ValidationBackend = "CEL"
+
+			Expect(subCmd.InjectResource(res)).To(Succeed())
+			Expect(res.HasValidatingAdmissionPolicy()).To(BeTrue())
+			Expect(res.HasValidationWebhook()).To(BeFalse())
+			Expect(res.Webhooks.NamespaceSelector).To(BeEmpty())
+		})
+
+		It("should reject the CEL backend without --programmatic-validation", func() {
+			subCmd.options.DoDefaulting = true
+			subCmd.options.ValidationBackend = goPlugin.ValidationBackendCEL
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("--backend=cel can only be used with --programmatic-validation"))
+		})
+
+		It("should reject an unknown backend", func() {
+			subCmd.options.DoValidation = true
+			subCmd.options.ValidationBackend = "rego"
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`invalid value "rego" for --backend`))
+		})
+
+		It("should reject a ValidatingAdmissionPolicy when a validation webhook exists", func() {
+			existing := *res
+			existing.Path = "k8s.io/api/apps/v1"
+			existing.Webhooks = &resource.Webhooks{WebhookVersion: "v1", Validation: true}
+			Expect(cfg.AddResource(existing)).To(Succeed())
+			subCmd.options.DoValidation = true
+			subCmd.options.ValidationBackend = goPlugin.ValidationBackendCEL
+			subCmd.force = true
+
+			err := subCmd.InjectResource(res)
+
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("already validated by a validation webhook"))
+		})
+	})
+
 	Context("isValidVersion", func() {
 		BeforeEach(func() {
 			res = &resource.Resource{
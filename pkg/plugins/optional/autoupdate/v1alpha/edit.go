@@ -19,6 +19,8 @@ package v1alpha
 import (
 	"fmt"
 	log "log/slog"
+	"slices"
+	"strings"
 
 	"github.com/spf13/pflag"
 
@@ -32,6 +34,7 @@ var _ plugin.EditSubcommand = &editSubcommand{}
 
 type editSubcommand struct {
 	config      config.Config
+	ci          string
 	useGHModels bool
 }
 
@@ -43,12 +46,18 @@ func (p *editSubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdMeta *
 
   # Edit a common project with GitHub Models enabled (requires repo permissions)
   %[1]s edit --plugins=%[2]s --use-gh-models
+
+  # Edit a common project to propose the updates from a scheduled GitLab CI pipeline
+  %[1]s edit --plugins=%[2]s --ci=gitlab
 `, cliMeta.CommandName, plugin.KeyFor(Plugin{}))
 }
 
 func (p *editSubcommand) BindFlags(fs *pflag.FlagSet) {
 	fs.BoolVar(&p.useGHModels, "use-gh-models", false,
 		"If set, enable GitHub Models AI summary in the scaffolded workflow (requires GitHub Models permissions)")
+	fs.StringVar(&p.ci, "ci", "",
+		fmt.Sprintf("CI system to scaffold the scheduled update for: %s. "+
+			"Defaults to the one previously used, or %s", strings.Join(scaffolds.CIs, ", "), scaffolds.CIGitHub))
 }
 
 func (p *editSubcommand) InjectConfig(c config.Config) error {
@@ -64,15 +73,33 @@ func (p *editSubcommand) PreScaffold(machinery.Filesystem) error {
 				"More info: https://book.kubebuilder.io/migrations",
 		)
 	}
+
+	if len(p.ci) == 0 {
+		p.ci = storedCI(p.config)
+	}
+	if len(p.ci) == 0 {
+		p.ci = scaffolds.CIGitHub
+	}
+	if !slices.Contains(scaffolds.CIs, p.ci) {
+		return fmt.Errorf("unsupported --ci %q, must be one of %s", p.ci, strings.Join(scaffolds.CIs, ", "))
+	}
+	if p.useGHModels && p.ci != scaffolds.CIGitHub {
+		return fmt.Errorf("--use-gh-models is only supported with --ci=%s", scaffolds.CIGitHub)
+	}
 	return nil
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	if err := insertPluginMetaToConfig(p.config, PluginConfig{UseGHModels: p.useGHModels}); err != nil {
+	pluginConfig := PluginConfig{UseGHModels: p.useGHModels}
+	// GitHub is the default, so it is not stored to keep the PROJECT file of the existing projects unchanged
+	if p.ci != scaffolds.CIGitHub {
+		pluginConfig.CI = p.ci
+	}
+	if err := insertPluginMetaToConfig(p.config, pluginConfig); err != nil {
 		return fmt.Errorf("error inserting project plugin meta to configuration: %w", err)
 	}
 
-	scaffolder := scaffolds.NewInitScaffolder(p.useGHModels)
+	scaffolder := scaffolds.NewInitScaffolder(p.ci, p.useGHModels)
 	scaffolder.InjectFS(fs)
 	if err := scaffolder.Scaffold(); err != nil {
 		return fmt.Errorf("error scaffolding edit subcommand: %w", err)
@@ -82,6 +109,17 @@ func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
 }
 
 func (p *editSubcommand) PostScaffold() error {
+	switch p.ci {
+	case scaffolds.CIGitLab:
+		log.Info("Make sure your .gitlab-ci.yml includes the .gitlab/ci/auto_update.yml job, " +
+			"then add a GITLAB_TOKEN CI/CD variable and a pipeline schedule")
+		return nil
+	case scaffolds.CITekton:
+		log.Info("Set the repository URL in .tekton/auto_update.yaml, " +
+			"then create the kubebuilder-auto-update secret and apply the file to your cluster")
+		return nil
+	}
+
 	// Inform users about GitHub Models if they didn't enable it
 	if !p.useGHModels {
 		log.Info("Consider enabling GitHub Models to get an AI summary to help with the update")
@@ -89,3 +127,14 @@ func (p *editSubcommand) PostScaffold() error {
 	}
 	return nil
 }
+
+// storedCI returns the CI system stored in the plugin configuration, if any
+func storedCI(target config.Config) string {
+	var cfg PluginConfig
+	key := plugin.GetPluginKeyForConfig(target.GetPluginChain(), Plugin{})
+	if err := target.DecodePluginConfig(key, &cfg); err != nil {
+		// The configuration may be stored with the canonical key, or not at all
+		_ = target.DecodePluginConfig(plugin.KeyFor(Plugin{}), &cfg)
+	}
+	return cfg.CI
+}
@@ -24,6 +24,7 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 )
 
 var _ = Describe("editSubcommand", func() {
@@ -55,4 +56,93 @@ var _ = Describe("editSubcommand", func() {
 
 		Expect(err).NotTo(HaveOccurred())
 	})
+
+	Context("with a CI system", func() {
+		BeforeEach(func() {
+			Expect(cfg.SetCliVersion("v4.0.0")).To(Succeed())
+		})
+
+		It("should default to GitHub Actions", func() {
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.ci).To(Equal("github"))
+
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+			Expect(afero.Exists(fs.FS, ".github/workflows/auto_update.yml")).To(BeTrue())
+
+			var pluginConfig PluginConfig
+			Expect(cfg.DecodePluginConfig(plugin.KeyFor(Plugin{}), &pluginConfig)).To(Succeed())
+			Expect(pluginConfig.CI).To(BeEmpty())
+		})
+
+		It("should scaffold a GitLab CI job and persist the choice", func() {
+			subCmd.ci = "gitlab"
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			job, err := afero.ReadFile(fs.FS, ".gitlab/ci/auto_update.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(job)).To(ContainSubstring("--forge gitlab"))
+			ci, err := afero.ReadFile(fs.FS, ".gitlab-ci.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(ci)).To(ContainSubstring("local: .gitlab/ci/auto_update.yml"))
+			Expect(afero.Exists(fs.FS, ".github/workflows/auto_update.yml")).To(BeFalse())
+
+			var pluginConfig PluginConfig
+			Expect(cfg.DecodePluginConfig(plugin.KeyFor(Plugin{}), &pluginConfig)).To(Succeed())
+			Expect(pluginConfig.CI).To(Equal("gitlab"))
+		})
+
+		It("should not overwrite an existing GitLab CI configuration", func() {
+			Expect(afero.WriteFile(fs.FS, ".gitlab-ci.yml", []byte("stages: [test]\n"), 0o644)).To(Succeed())
+			subCmd.ci = "gitlab"
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			ci, err := afero.ReadFile(fs.FS, ".gitlab-ci.yml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(ci)).To(Equal("stages: [test]\n"))
+		})
+
+		It("should scaffold a Tekton task", func() {
+			subCmd.ci = "tekton"
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			task, err := afero.ReadFile(fs.FS, ".tekton/auto_update.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(task)).To(ContainSubstring("kind: Task"))
+			Expect(string(task)).To(ContainSubstring("kind: CronJob"))
+		})
+
+		It("should keep the CI system previously chosen", func() {
+			Expect(cfg.EncodePluginConfig(plugin.KeyFor(Plugin{}), PluginConfig{CI: "tekton"})).To(Succeed())
+
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.ci).To(Equal("tekton"))
+		})
+
+		It("should switch to the CI system provided", func() {
+			Expect(cfg.EncodePluginConfig(plugin.KeyFor(Plugin{}), PluginConfig{CI: "tekton"})).To(Succeed())
+			subCmd.ci = "gitlab"
+			Expect(subCmd.PreScaffold(fs)).To(Succeed())
+			Expect(subCmd.Scaffold(fs)).To(Succeed())
+
+			var pluginConfig PluginConfig
+			Expect(cfg.DecodePluginConfig(plugin.KeyFor(Plugin{}), &pluginConfig)).To(Succeed())
+			Expect(pluginConfig.CI).To(Equal("gitlab"))
+		})
+
+		It("should reject unsupported CI systems", func() {
+			subCmd.ci = "jenkins"
+			err := subCmd.PreScaffold(fs)
+			Expect(err).To(MatchError(ContainSubstring(`unsupported --ci "jenkins"`)))
+		})
+
+		It("should only support GitHub Models with GitHub Actions", func() {
+			subCmd.ci = "gitlab"
+			subCmd.useGHModels = true
+			err := subCmd.PreScaffold(fs)
+			Expect(err).To(MatchError(ContainSubstring("--use-gh-models is only supported with --ci=github")))
+		})
+	})
 })
@@ -22,6 +22,7 @@ import (
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
@@ -48,13 +49,20 @@ By default, the workflow does NOT use GitHub Models. To enable AI-generated summ
   - Ensure your repository/organization has permissions to use GitHub Models.
   - Re-run: kubebuilder edit --plugins="autoupdate/v1-alpha" --use-gh-models
 
-Without this flag, the workflow will still work but won't include AI summaries (avoiding 403 Forbidden errors).`
+Without this flag, the workflow will still work but won't include AI summaries (avoiding 403 Forbidden errors).
+
+### Optional: GitLab CI or Tekton
+
+Use the --ci flag to scaffold the scheduled update for another CI system instead of GitHub Actions:
+  - --ci=gitlab: scaffolds the '.gitlab/ci/auto_update.yml' job, which opens a GitLab **Issue**. Include it in your '.gitlab-ci.yml', provide a 'GITLAB_TOKEN' CI/CD variable and create a pipeline schedule.
+  - --ci=tekton: scaffolds '.tekton/auto_update.yaml' with a Tekton Task and a CronJob running it weekly.
+The choice is stored in the PROJECT file, so 'kubebuilder alpha generate' re-creates the same pipeline.`
 
 const pluginName = "autoupdate." + plugins.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 1, Stage: stage.Alpha}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
 // Plugin implements the plugin.Full interface
@@ -67,6 +75,8 @@ var _ plugin.Edit = Plugin{}
 // PluginConfig defines the structure that will be used to track the data
 type PluginConfig struct {
 	UseGHModels bool `json:"useGHModels,omitempty"`
+	// CI is the CI system the scheduled update is scaffolded for, GitHub Actions when empty
+	CI string `json:"ci,omitempty"`
 }
 
 // Name returns the name of the plugin
@@ -83,7 +93,7 @@ func (p Plugin) GetEditSubcommand() plugin.EditSubcommand { return &p.editSubcom
 
 // Description returns a short description of the plugin
 func (Plugin) Description() string {
-	return "Proposes Kubebuilder scaffold updates via GitHub Actions"
+	return "Proposes Kubebuilder scaffold updates via GitHub Actions, GitLab CI or Tekton"
 }
 
 // DeprecationWarning define the deprecation message or return empty when plugin is not deprecated
@@ -96,6 +106,7 @@ func insertPluginMetaToConfig(target config.Config, cfg PluginConfig) error {
 	key := plugin.GetPluginKeyForConfig(target.GetPluginChain(), Plugin{})
 	canonicalKey := plugin.KeyFor(Plugin{})
 
+	ci := cfg.CI
 	if err := target.DecodePluginConfig(key, &cfg); err != nil {
 		switch {
 		case errors.As(err, &config.UnsupportedFieldError{}):
@@ -116,9 +127,15 @@ func insertPluginMetaToConfig(target config.Config, cfg PluginConfig) error {
 		}
 	}
 
+	// The CI system was already resolved from the stored one by the edit subcommand, so it is not overridden
+	cfg.CI = ci
+
 	if err := target.EncodePluginConfig(key, cfg); err != nil {
 		return fmt.Errorf("error encoding plugin configuration: %w", err)
 	}
 
 	return nil
 }
+
+// PluginConfig returns the configuration object tracking the options of the scheduled update
+func (Plugin) PluginConfig() any { return PluginConfig{} }
@@ -24,8 +24,20 @@ import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/github"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/gitlab"
+	"sigs.k8s.io/kubebuilder/v4/pkg/plugins/optional/autoupdate/v1alpha/scaffolds/internal/tekton"
 )
 
+// Supported CI systems to scaffold the scheduled update for
+const (
+	CIGitHub = "github"
+	CIGitLab = "gitlab"
+	CITekton = "tekton"
+)
+
+// CIs lists the supported CI systems
+var CIs = []string{CIGitHub, CIGitLab, CITekton}
+
 var _ plugins.Scaffolder = &editScaffolder{}
 
 type editScaffolder struct {
@@ -34,13 +46,17 @@ type editScaffolder struct {
 	// fs is the filesystem that will be used by the scaffolder
 	fs machinery.Filesystem
 
+	// ci is the CI system to scaffold the scheduled update for
+	ci string
+
 	// useGHModels determines if GitHub Models AI summary should be enabled
 	useGHModels bool
 }
 
 // NewInitScaffolder returns a new Scaffolder for project initialization operations
-func NewInitScaffolder(useGHModels bool) plugins.Scaffolder {
+func NewInitScaffolder(ci string, useGHModels bool) plugins.Scaffolder {
 	return &editScaffolder{
+		ci:          ci,
 		useGHModels: useGHModels,
 	}
 }
@@ -58,9 +74,17 @@ func (s *editScaffolder) Scaffold() error {
 		machinery.WithConfig(s.config),
 	)
 
-	err := scaffold.Execute(
-		&github.AutoUpdate{UseGHModels: s.useGHModels},
-	)
+	var templates []machinery.Builder
+	switch s.ci {
+	case CIGitLab:
+		templates = append(templates, &gitlab.AutoUpdate{}, &gitlab.CI{})
+	case CITekton:
+		templates = append(templates, &tekton.AutoUpdate{})
+	default:
+		templates = append(templates, &github.AutoUpdate{UseGHModels: s.useGHModels})
+	}
+
+	err := scaffold.Execute(templates...)
 	if err != nil {
 		return fmt.Errorf("failed to execute init scaffold: %w", err)
 	}
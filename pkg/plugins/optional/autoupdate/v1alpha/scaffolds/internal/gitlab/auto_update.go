@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &AutoUpdate{}
+
+// AutoUpdate scaffolds the GitLab CI job to propose the scaffold updates
+type AutoUpdate struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *AutoUpdate) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(".gitlab", "ci", "auto_update.yml")
+	}
+
+	f.TemplateBody = autoUpdateTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const autoUpdateTemplate = `# The 'kubebuilder alpha update' command requires write access to the repository to create a branch
+# with the update files and allow you to open a merge request using the link provided in the issue.
+# The branch created will be named in the format kubebuilder-update-from-<from-version>-to-<to-version> by default.
+# To protect your codebase, please ensure that you have protected branches configured for your
+# main branches. This will guarantee that no one can bypass a review and push directly to a branch like 'main'.
+#
+# How to set it up:
+# 1) Include this file in your '.gitlab-ci.yml':
+#      include:
+#        - local: .gitlab/ci/auto_update.yml
+# 2) Add a masked CI/CD variable named GITLAB_TOKEN holding a project access token with the
+#    'api' and 'write_repository' scopes, to push the update branch and create the tracking issue.
+# 3) Create a pipeline schedule for your main branch in Build > Pipeline schedules,
+#    e.g. "0 0 * * 2" to run every Tuesday at 00:00 UTC.
+auto-update:
+  image: golang:latest
+  rules:
+    - if: $CI_PIPELINE_SOURCE == "schedule"
+    - if: $CI_PIPELINE_SOURCE == "web"
+  variables:
+    # The full history is required to compute the update.
+    GIT_DEPTH: 0
+  script:
+    # Configure Git to create commits and push the update branch with the project access token.
+    - git config --global user.name "kubebuilder-auto-update"
+    - git config --global user.email "kubebuilder-auto-update@noreply.${CI_SERVER_HOST}"
+    - git remote set-url origin "https://oauth2:${GITLAB_TOKEN}@${CI_SERVER_HOST}/${CI_PROJECT_PATH}.git"
+    - git checkout -B "${CI_COMMIT_REF_NAME}" "${CI_COMMIT_SHA}"
+
+    # Install Kubebuilder.
+    - curl -L -o /usr/local/bin/kubebuilder "https://go.kubebuilder.io/dl/latest/$(go env GOOS)/$(go env GOARCH)"
+    - chmod +x /usr/local/bin/kubebuilder
+    - kubebuilder version
+
+    # Run the Kubebuilder alpha update command.
+    # More info: https://kubebuilder.io/reference/commands/alpha_update
+    # --force: Completes the merge even if conflicts occur, leaving conflict markers.
+    # --push: Automatically pushes the resulting output branch to the 'origin' remote.
+    # --restore-path: Preserves specified paths (e.g., CI configuration files) when squashing.
+    # --open-gh-issue --forge gitlab: Creates a GitLab Issue with a link for opening a merge request for review.
+    - >-
+      kubebuilder alpha update
+      --from-branch "${CI_COMMIT_REF_NAME}"
+      --force
+      --push
+      --restore-path .gitlab-ci.yml
+      --restore-path .gitlab
+      --open-gh-issue
+      --forge gitlab
+      --forge-url "${CI_SERVER_URL}"
+`
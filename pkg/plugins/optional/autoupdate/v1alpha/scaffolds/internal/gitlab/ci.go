@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gitlab
+
+import (
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &CI{}
+
+// CI scaffolds the GitLab CI configuration including the auto update job, if the project has none
+type CI struct {
+	machinery.TemplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *CI) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = ".gitlab-ci.yml"
+	}
+
+	f.TemplateBody = ciTemplate
+	// Existing configurations are left untouched, and need to include the job manually
+	f.IfExistsAction = machinery.SkipFile
+
+	return nil
+}
+
+const ciTemplate = `include:
+  - local: .gitlab/ci/auto_update.yml
+`
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tekton
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/machinery"
+)
+
+var _ machinery.Template = &AutoUpdate{}
+
+// AutoUpdate scaffolds the Tekton Task, and the CronJob running it, to propose the scaffold updates
+type AutoUpdate struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+}
+
+// SetTemplateDefaults implements machinery.Template
+func (f *AutoUpdate) SetTemplateDefaults() error {
+	if f.Path == "" {
+		f.Path = filepath.Join(".tekton", "auto_update.yaml")
+	}
+
+	f.TemplateBody = autoUpdateTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const autoUpdateTemplate = `# The 'kubebuilder alpha update' command requires write access to the repository to create a branch
+# with the update files and allow you to open a pull request using the link provided in the issue.
+# The branch created will be named in the format kubebuilder-update-from-<from-version>-to-<to-version> by default.
+# To protect your codebase, please ensure that you have branch protection rules configured for your
+# main branches. This will guarantee that no one can bypass a review and push directly to a branch like 'main'.
+#
+# This file defines a Tekton Task running the update, and a CronJob creating a TaskRun of it every week.
+# How to set it up:
+# 1) Install Tekton Pipelines in the cluster: https://tekton.dev/docs/installation/pipelines/
+# 2) Set the params of the TaskRun in the ConfigMap below, e.g. the URL of your repository.
+# 3) Create a secret holding a token allowed to push branches and create issues in the repository:
+#      kubectl create secret generic kubebuilder-auto-update --from-literal=token=<token>
+# 4) Apply this file: kubectl apply -f .tekton/auto_update.yaml
+apiVersion: tekton.dev/v1
+kind: Task
+metadata:
+  name: kubebuilder-auto-update
+spec:
+  params:
+    - name: repo-url
+      description: HTTPS URL of the Git repository, e.g. https://github.com/example/project.git
+    - name: branch
+      description: Branch with the current state of the project
+      default: main
+    - name: forge
+      description: "Forge where the issue is created: github, gitlab or gitea"
+      default: github
+  steps:
+    - name: update
+      image: golang:latest
+      env:
+        - name: REPO_URL
+          value: $(params.repo-url)
+        - name: BRANCH
+          value: $(params.branch)
+        - name: FORGE
+          value: $(params.forge)
+        # The token is used to push the update branch and by the forge to create the issue.
+        - name: TOKEN
+          valueFrom:
+            secretKeyRef:
+              name: kubebuilder-auto-update
+              key: token
+      script: |
+        #!/usr/bin/env bash
+        set -euo pipefail
+        export GH_TOKEN="${TOKEN}" GITLAB_TOKEN="${TOKEN}" GITEA_TOKEN="${TOKEN}"
+
+        # Clone the repository with the token to push the update branch.
+        git clone --branch "${BRANCH}" "https://oauth2:${TOKEN}@${REPO_URL#https://}" project
+        cd project
+        git config --global user.name "kubebuilder-auto-update"
+        git config --global user.email "kubebuilder-auto-update@noreply.example.com"
+
+        # Install Kubebuilder, and the GitHub CLI to create GitHub issues.
+        curl -L -o /usr/local/bin/kubebuilder "https://go.kubebuilder.io/dl/latest/$(go env GOOS)/$(go env GOARCH)"
+        chmod +x /usr/local/bin/kubebuilder
+        kubebuilder version
+        if [ "${FORGE}" = "github" ]; then
+          apt-get update && apt-get install -y gh
+        fi
+
+        # Run the Kubebuilder alpha update command.
+        # More info: https://kubebuilder.io/reference/commands/alpha_update
+        # --force: Completes the merge even if conflicts occur, leaving conflict markers.
+        # --push: Automatically pushes the resulting output branch to the 'origin' remote.
+        # --restore-path: Preserves specified paths (e.g., CI configuration files) when squashing.
+        # --open-gh-issue --forge: Creates an Issue with a link for opening a pull request for review.
+        kubebuilder alpha update \
+          --from-branch "${BRANCH}" \
+          --force \
+          --push \
+          --restore-path .tekton \
+          --open-gh-issue \
+          --forge "${FORGE}"
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: kubebuilder-auto-update
+data:
+  taskrun.yaml: |
+    apiVersion: tekton.dev/v1
+    kind: TaskRun
+    metadata:
+      generateName: kubebuilder-auto-update-
+    spec:
+      taskRef:
+        name: kubebuilder-auto-update
+      params:
+        - name: repo-url
+          value: https://github.com/example/project.git # TODO(user): set the URL of your repository
+        - name: branch
+          value: main
+        - name: forge
+          value: github
+---
+apiVersion: v1
+kind: ServiceAccount
+metadata:
+  name: kubebuilder-auto-update
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: Role
+metadata:
+  name: kubebuilder-auto-update
+rules:
+  - apiGroups: ["tekton.dev"]
+    resources: ["taskruns"]
+    verbs: ["create"]
+---
+apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: kubebuilder-auto-update
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: kubebuilder-auto-update
+subjects:
+  - kind: ServiceAccount
+    name: kubebuilder-auto-update
+---
+apiVersion: batch/v1
+kind: CronJob
+metadata:
+  name: kubebuilder-auto-update
+spec:
+  schedule: "0 0 * * 2" # Every Tuesday at 00:00 UTC
+  concurrencyPolicy: Forbid
+  jobTemplate:
+    spec:
+      template:
+        spec:
+          serviceAccountName: kubebuilder-auto-update
+          restartPolicy: Never
+          containers:
+            - name: create-taskrun
+              image: registry.k8s.io/kubectl:v1.35.0
+              args: ["create", "-f", "/taskrun/taskrun.yaml"]
+              volumeMounts:
+                - name: taskrun
+                  mountPath: /taskrun
+          volumes:
+            - name: taskrun
+              configMap:
+                name: kubebuilder-auto-update
+`
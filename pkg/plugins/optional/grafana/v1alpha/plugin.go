@@ -19,16 +19,18 @@ package v1alpha
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	golangv4 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4"
 )
 
 const pluginName = "grafana." + plugins.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 1, Stage: stage.Alpha}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
 // Plugin implements the plugin.Full interface
@@ -61,3 +63,11 @@ func (Plugin) Description() string {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// Requires returns the plugin that scaffolds the manager whose metrics the dashboards display
+func (Plugin) Requires() []string {
+	return []string{plugin.KeyFor(golangv4.Plugin{})}
+}
+
+// PluginConfig returns the empty configuration object recording that the dashboards were scaffolded
+func (Plugin) PluginConfig() any { return pluginConfig{} }
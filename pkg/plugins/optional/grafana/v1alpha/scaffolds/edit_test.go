@@ -458,6 +458,42 @@ customMetrics:
 			})
 		})
 
+		Context("when editing again a project tracked by the scaffold manifest", func() {
+			var (
+				runtimePath   = filepath.Join("grafana", "controller-runtime-metrics.json")
+				resourcesPath = filepath.Join("grafana", "controller-resources-metrics.json")
+			)
+
+			BeforeEach(func() {
+				fs.Manifest = &machinery.Manifest{}
+				scaffolder.InjectFS(fs)
+
+				err := scaffolder.Scaffold()
+				Expect(err).NotTo(HaveOccurred())
+				_, tracked := fs.Manifest.Get(runtimePath)
+				Expect(tracked).To(BeTrue())
+			})
+
+			It("should only overwrite the dashboards left as scaffolded", func() {
+				By("modifying the controller-runtime metrics dashboard")
+				Expect(os.WriteFile(runtimePath, []byte("{}\n"), 0o644)).To(Succeed())
+
+				By("tracking the controller resources metrics dashboard as scaffolded by a previous version")
+				Expect(os.WriteFile(resourcesPath, []byte("{}\n"), 0o644)).To(Succeed())
+				fs.Manifest.Record(resourcesPath, "grafana.kubebuilder.io/v1-alpha", "ResourcesManifest", []byte("{}\n"))
+
+				err := scaffolder.Scaffold()
+				Expect(err).NotTo(HaveOccurred())
+
+				content, err := os.ReadFile(runtimePath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(Equal("{}\n"))
+				content, err = os.ReadFile(resourcesPath)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(content)).To(ContainSubstring("controller_runtime"))
+			})
+		})
+
 		Context("when no custom metrics are configured", func() {
 			It("should not create custom metrics dashboard", func() {
 				By("scaffolding with default config")
@@ -40,7 +40,7 @@ func (f *ResourcesManifest) SetTemplateDefaults() error {
 	f.SetDelim("[[", "]]")
 	f.TemplateBody = controllerResourcesTemplate
 
-	f.IfExistsAction = machinery.OverwriteFile
+	f.IfExistsAction = machinery.OverwriteFileIfUnmodified
 
 	return nil
 }
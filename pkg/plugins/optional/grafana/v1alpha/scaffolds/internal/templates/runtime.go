@@ -39,7 +39,7 @@ func (f *RuntimeManifest) SetTemplateDefaults() error {
 	// Provide an alternative delimiter here to avoid overlaps.
 	f.SetDelim("[[", "]]")
 	f.TemplateBody = controllerRuntimeTemplate
-	f.IfExistsAction = machinery.OverwriteFile
+	f.IfExistsAction = machinery.OverwriteFileIfUnmodified
 
 	return nil
 }
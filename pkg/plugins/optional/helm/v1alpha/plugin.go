@@ -62,3 +62,11 @@ func (p Plugin) DeprecationWarning() string {
 	return "helm/v1-alpha plugin is deprecated, use helm/v2-alpha instead which " +
 		"provides dynamic Helm chart generation from kustomize output"
 }
+
+// ConflictsWith returns the plugins that generate the Helm chart differently
+func (Plugin) ConflictsWith() []string {
+	return []string{pluginName + "/v2-alpha"}
+}
+
+// PluginConfig returns the empty configuration object marking the project as distributed with a Helm chart
+func (Plugin) PluginConfig() any { return pluginConfig{} }
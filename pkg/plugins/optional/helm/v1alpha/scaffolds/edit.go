@@ -37,6 +37,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/spf13/afero"
 	"sigs.k8s.io/yaml"
 
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
@@ -289,7 +290,7 @@ func (s *editScaffolder) copyConfigFiles() error {
 		}
 
 		// Ensure destination directory exists
-		if err := os.MkdirAll(dir.DestDir, 0o755); err != nil {
+		if err := s.fs.FS.MkdirAll(dir.DestDir, 0o755); err != nil {
 			return fmt.Errorf("failed to create directory %q: %w", dir.DestDir, err)
 		}
 
@@ -310,7 +311,8 @@ func (s *editScaffolder) copyConfigFiles() error {
 				}
 			}
 
-			err := copyFileWithHelmLogic(srcFile, destFile, dir.SubDir, s.config.GetProjectName(), hasConvertionalWebhook)
+			err := copyFileWithHelmLogic(s.fs.FS, srcFile, destFile, dir.SubDir, s.config.GetProjectName(),
+				hasConvertionalWebhook)
 			if err != nil {
 				return err
 			}
@@ -322,7 +324,9 @@ func (s *editScaffolder) copyConfigFiles() error {
 
 // copyFileWithHelmLogic reads the source file, modifies the content for Helm, applies patches
 // to spec.conversion if applicable, and writes it to the destination
-func copyFileWithHelmLogic(srcFile, destFile, subDir, projectName string, hasConvertionalWebhook bool) error {
+func copyFileWithHelmLogic(fs afero.Fs, srcFile, destFile, subDir, projectName string,
+	hasConvertionalWebhook bool,
+) error {
 	if _, err := os.Stat(srcFile); os.IsNotExist(err) {
 		log.Info("Source file does not exist", "source_file", srcFile)
 		return fmt.Errorf("source file does not exist %q: %w", srcFile, err)
@@ -469,11 +473,11 @@ func copyFileWithHelmLogic(srcFile, destFile, subDir, projectName string, hasCon
 			"{{- if .Values.%s.enable }}\n%s{{- end -}}\n", subDir, contentStr)
 	}
 
-	if err = os.MkdirAll(filepath.Dir(destFile), 0o755); err != nil {
+	if err = fs.MkdirAll(filepath.Dir(destFile), 0o755); err != nil {
 		return fmt.Errorf("error creating directory %q: %w", filepath.Dir(destFile), err)
 	}
 
-	err = os.WriteFile(destFile, []byte(wrappedContent), 0o644)
+	err = afero.WriteFile(fs, destFile, []byte(wrappedContent), 0o644)
 	if err != nil {
 		log.Info("Error writing destination file", "destination_file", destFile)
 		return fmt.Errorf("error writing destination file %q: %w", destFile, err)
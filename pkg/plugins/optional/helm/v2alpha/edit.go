@@ -115,8 +115,9 @@ func (p *editSubcommand) InjectConfig(c config.Config) error {
 }
 
 func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
-	// If using default manifests file, ensure it exists by running make build-installer
-	if p.manifestsFile == DefaultManifestsFile {
+	// If using default manifests file, ensure it exists by running make build-installer,
+	// unless it is a dry run where the existing manifests file is used
+	if p.manifestsFile == DefaultManifestsFile && !fs.DryRun {
 		if err := p.ensureManifestsExist(); err != nil {
 			slog.Warn("Failed to generate default manifests file", "error", err, "file", p.manifestsFile)
 		}
@@ -177,7 +178,7 @@ func (p *editSubcommand) Scaffold(fs machinery.Filesystem) error {
 		slog.Info("adding Helm deployment targets to Makefile...")
 		// Extract namespace from manifests for accurate Makefile generation
 		namespace := p.extractNamespaceFromManifests()
-		if err := p.addHelmMakefileTargets(namespace); err != nil {
+		if err := p.addHelmMakefileTargets(fs, namespace); err != nil {
 			slog.Warn("failed to add Helm targets to Makefile", "error", err)
 		}
 	}
@@ -242,9 +243,9 @@ func (p *editSubcommand) PostScaffold() error {
 	return nil
 }
 
-func (p *editSubcommand) addHelmMakefileTargets(namespace string) error {
+func (p *editSubcommand) addHelmMakefileTargets(fs machinery.Filesystem, namespace string) error {
 	makefilePath := "Makefile"
-	if _, err := os.Stat(makefilePath); os.IsNotExist(err) {
+	if _, err := fs.FS.Stat(makefilePath); os.IsNotExist(err) {
 		return fmt.Errorf("makefile not found")
 	}
 
@@ -252,7 +253,7 @@ func (p *editSubcommand) addHelmMakefileTargets(namespace string) error {
 	helmTargets := getHelmMakefileTargets(p.config.GetProjectName(), namespace, p.outputDir)
 
 	// Append the targets if they don't already exist
-	if err := util.AppendCodeIfNotExist(makefilePath, helmTargets); err != nil {
+	if err := util.AppendCodeIfNotExistInFS(fs, makefilePath, helmTargets); err != nil {
 		return fmt.Errorf("failed to append Helm targets to Makefile: %w", err)
 	}
 
@@ -304,7 +304,7 @@ build: ## Build manager binary.
 			err := os.WriteFile("Makefile", []byte(makefileContent), 0o644)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = editCmd.addHelmMakefileTargets("test-project-system")
+			err = editCmd.addHelmMakefileTargets(machinery.Filesystem{FS: afero.NewOsFs()}, "test-project-system")
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify Helm targets were added
@@ -399,7 +399,7 @@ helm-rollback: ## Rollback to previous Helm release.
 			err := os.WriteFile("Makefile", []byte(makefileContent), 0o644)
 			Expect(err).NotTo(HaveOccurred())
 
-			err = editCmd.addHelmMakefileTargets("test-project-system")
+			err = editCmd.addHelmMakefileTargets(machinery.Filesystem{FS: afero.NewOsFs()}, "test-project-system")
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify targets were not duplicated
@@ -418,7 +418,7 @@ helm-rollback: ## Rollback to previous Helm release.
 		})
 
 		It("should return error when Makefile does not exist", func() {
-			err := editCmd.addHelmMakefileTargets("test-project-system")
+			err := editCmd.addHelmMakefileTargets(machinery.Filesystem{FS: afero.NewOsFs()}, "test-project-system")
 			Expect(err).To(HaveOccurred())
 			Expect(err.Error()).To(ContainSubstring("makefile not found"))
 		})
@@ -19,16 +19,19 @@ package v2alpha
 import (
 	"sigs.k8s.io/kubebuilder/v4/pkg/config"
 	cfgv3 "sigs.k8s.io/kubebuilder/v4/pkg/config/v3"
+	cfgv4 "sigs.k8s.io/kubebuilder/v4/pkg/config/v4"
 	"sigs.k8s.io/kubebuilder/v4/pkg/model/stage"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugin"
 	"sigs.k8s.io/kubebuilder/v4/pkg/plugins"
+	kustomizecommonv2 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/common/kustomize/v2"
+	golangv4 "sigs.k8s.io/kubebuilder/v4/pkg/plugins/golang/v4"
 )
 
 const pluginName = "helm." + plugins.DefaultNameQualifier
 
 var (
 	pluginVersion            = plugin.Version{Number: 2, Stage: stage.Alpha}
-	supportedProjectVersions = []config.Version{cfgv3.Version}
+	supportedProjectVersions = []config.Version{cfgv3.Version, cfgv4.Version}
 )
 
 // Plugin implements the plugin.Full interface
@@ -65,3 +68,16 @@ func (Plugin) Description() string {
 func (p Plugin) DeprecationWarning() string {
 	return ""
 }
+
+// Requires returns the plugins whose Makefile and kustomize output the Helm chart is generated from
+func (Plugin) Requires() []string {
+	return []string{plugin.KeyFor(golangv4.Plugin{}), plugin.KeyFor(kustomizecommonv2.Plugin{})}
+}
+
+// ConflictsWith returns the plugins that generate the Helm chart differently
+func (Plugin) ConflictsWith() []string {
+	return []string{pluginName + "/v1-alpha"}
+}
+
+// PluginConfig returns the configuration object tracking the manifests file and the output directory of the chart
+func (Plugin) PluginConfig() any { return pluginConfig{} }
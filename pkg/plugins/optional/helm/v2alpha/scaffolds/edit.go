@@ -68,17 +68,18 @@ func (s *chartScaffolder) Scaffold() error {
 		return fmt.Errorf("failed to create chart directory: %w", err)
 	}
 
-	if s.manifestsFile == defaultManifestsFile {
+	if s.manifestsFile == defaultManifestsFile && !s.fs.DryRun {
 		if err := s.generateKustomizeOutput(); err != nil {
 			return fmt.Errorf("failed to generate kustomize output: %w", err)
 		}
 	}
 
 	chartScaffolder := internal.NewChartScaffolder(internal.ChartScaffolderConfig{
-		ProjectName:   s.config.GetProjectName(),
-		ManifestsFile: s.manifestsFile,
-		OutputDir:     s.outputDir,
-		Force:         s.force,
+		ProjectName:     s.config.GetProjectName(),
+		ManifestsFile:   s.manifestsFile,
+		OutputDir:       s.outputDir,
+		Force:           s.force,
+		WatchNamespaces: s.config.GetWatchNamespaces(),
 	})
 
 	builders, err := chartScaffolder.PrepareTemplates(s.fs)
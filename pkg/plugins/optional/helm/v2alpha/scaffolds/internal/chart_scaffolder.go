@@ -35,6 +35,8 @@ type ChartScaffolderConfig struct {
 	ManifestsFile string
 	OutputDir     string
 	Force         bool
+	// WatchNamespaces are the namespaces watched by a namespace-scoped manager, if any
+	WatchNamespaces []string
 }
 
 // ChartScaffolder orchestrates the conversion of kustomize output to Helm charts.
@@ -96,6 +98,14 @@ func (s *ChartScaffolder) PrepareTemplates(_ machinery.Filesystem) ([]machinery.
 		Other:                     resources.Other,
 	}, s.config.ProjectName)
 
+	// Each watched namespace is expected to get the RoleBinding that kustomize deploys to it
+	for _, namespace := range s.config.WatchNamespaces {
+		if extraction.Features.RoleNamespaces["manager-rolebinding-"+namespace] != namespace {
+			slog.Warn("RoleBinding of the manager for a watched namespace not found in the manifests, "+
+				"regenerate them with 'make build-installer'", "namespace", namespace)
+		}
+	}
+
 	chartConverter := kustomize.NewChartConverter(
 		resources,
 		extraction.Metadata.DetectedPrefix,
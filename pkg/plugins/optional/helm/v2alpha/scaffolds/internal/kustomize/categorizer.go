@@ -26,7 +26,7 @@ import (
 
 // ResourceCategorizer groups Kubernetes resources by their logical function, matching the config/
 // directory structure used by kubebuilder. The groups are: crd, rbac, manager, metrics, webhook,
-// cert-manager, prometheus, and extras.
+// admission-policy, cert-manager, prometheus, and extras.
 //
 // This categorization determines how resources are organized in the final Helm chart templates.
 type ResourceCategorizer struct {
@@ -67,6 +67,10 @@ func (c *ResourceCategorizer) CategorizeByFunction() map[string][]*unstructured.
 		groups["webhook"] = webhookResources
 	}
 
+	if len(c.resources.ValidatingAdmissionPolicies) > 0 {
+		groups["admission-policy"] = c.resources.ValidatingAdmissionPolicies
+	}
+
 	certManagerResources := c.collectCertManagerResources()
 	if len(certManagerResources) > 0 {
 		groups["cert-manager"] = certManagerResources
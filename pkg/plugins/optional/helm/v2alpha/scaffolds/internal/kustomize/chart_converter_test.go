@@ -817,4 +817,42 @@ var _ = Describe("ChartConverter", func() {
 			Expect(exists).To(BeTrue())
 		})
 	})
+
+	Context("Admission Policy Directory", func() {
+		It("should place ValidatingAdmissionPolicies and their bindings in the admission-policy directory", func() {
+			policy := &unstructured.Unstructured{}
+			policy.SetAPIVersion("admissionregistration.k8s.io/v1")
+			policy.SetKind("ValidatingAdmissionPolicy")
+			policy.SetName("test-project-pod-v1-policy")
+
+			binding := &unstructured.Unstructured{}
+			binding.SetAPIVersion("admissionregistration.k8s.io/v1")
+			binding.SetKind("ValidatingAdmissionPolicyBinding")
+			binding.SetName("test-project-pod-v1-policy-binding")
+			binding.Object["spec"] = map[string]any{
+				"policyName":        "test-project-pod-v1-policy",
+				"validationActions": []any{"Deny"},
+			}
+
+			resources.ValidatingAdmissionPolicies = []*unstructured.Unstructured{policy, binding}
+
+			builders := converter.GetChartBuilders()
+			scaffold := machinery.NewScaffold(fs)
+			err := scaffold.Execute(builders...)
+			Expect(err).NotTo(HaveOccurred())
+
+			exists, err := afero.Exists(fs.FS, "dist/chart/templates/extras")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			files, err := afero.ReadDir(fs.FS, "dist/chart/templates/admission-policy")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(files).To(HaveLen(2))
+
+			content, err := afero.ReadFile(fs.FS, "dist/chart/templates/admission-policy/pod-v1-policy-binding.yaml")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(content)).To(ContainSubstring(
+				`policyName: {{ include "test-project.resourceName" (dict "suffix" "pod-v1-policy" "context" $) }}`))
+		})
+	})
 })
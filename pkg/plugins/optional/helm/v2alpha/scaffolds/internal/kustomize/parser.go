@@ -46,6 +46,9 @@ type ParsedResources struct {
 	WebhookConfigurations     []*unstructured.Unstructured
 	CustomResources           []*unstructured.Unstructured
 
+	// ValidatingAdmissionPolicies and their bindings, scaffolded with 'create webhook --backend=cel'
+	ValidatingAdmissionPolicies []*unstructured.Unstructured
+
 	// Cert-manager resources
 	Certificates []*unstructured.Unstructured
 	Issuer       *unstructured.Unstructured
@@ -86,17 +89,18 @@ func (p *Parser) Parse() (*ParsedResources, error) {
 func (p *Parser) ParseFromReader(reader io.Reader) (*ParsedResources, error) {
 	decoder := yaml.NewDecoder(reader)
 	resources := &ParsedResources{
-		CustomResourceDefinitions: make([]*unstructured.Unstructured, 0),
-		Roles:                     make([]*unstructured.Unstructured, 0),
-		ClusterRoles:              make([]*unstructured.Unstructured, 0),
-		RoleBindings:              make([]*unstructured.Unstructured, 0),
-		ClusterRoleBindings:       make([]*unstructured.Unstructured, 0),
-		Services:                  make([]*unstructured.Unstructured, 0),
-		Certificates:              make([]*unstructured.Unstructured, 0),
-		WebhookConfigurations:     make([]*unstructured.Unstructured, 0),
-		ServiceMonitors:           make([]*unstructured.Unstructured, 0),
-		CustomResources:           make([]*unstructured.Unstructured, 0),
-		Other:                     make([]*unstructured.Unstructured, 0),
+		CustomResourceDefinitions:   make([]*unstructured.Unstructured, 0),
+		Roles:                       make([]*unstructured.Unstructured, 0),
+		ClusterRoles:                make([]*unstructured.Unstructured, 0),
+		RoleBindings:                make([]*unstructured.Unstructured, 0),
+		ClusterRoleBindings:         make([]*unstructured.Unstructured, 0),
+		Services:                    make([]*unstructured.Unstructured, 0),
+		Certificates:                make([]*unstructured.Unstructured, 0),
+		WebhookConfigurations:       make([]*unstructured.Unstructured, 0),
+		ServiceMonitors:             make([]*unstructured.Unstructured, 0),
+		CustomResources:             make([]*unstructured.Unstructured, 0),
+		ValidatingAdmissionPolicies: make([]*unstructured.Unstructured, 0),
+		Other:                       make([]*unstructured.Unstructured, 0),
 	}
 
 	for {
@@ -154,6 +158,8 @@ func (p *Parser) categorizeResource(obj *unstructured.Unstructured, resources *P
 		resources.Issuer = obj
 	case kind == "ValidatingWebhookConfiguration" || kind == "MutatingWebhookConfiguration":
 		resources.WebhookConfigurations = append(resources.WebhookConfigurations, obj)
+	case kind == "ValidatingAdmissionPolicy" || kind == "ValidatingAdmissionPolicyBinding":
+		resources.ValidatingAdmissionPolicies = append(resources.ValidatingAdmissionPolicies, obj)
 	case kind == "ServiceMonitor" && apiVersion == "monitoring.coreos.com/v1":
 		resources.ServiceMonitors = append(resources.ServiceMonitors, obj)
 	default:
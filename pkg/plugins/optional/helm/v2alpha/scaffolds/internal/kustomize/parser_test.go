@@ -181,6 +181,51 @@ spec:
 		})
 	})
 
+	Context("with ValidatingAdmissionPolicy", func() {
+		BeforeEach(func() {
+			yamlContent := `---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicy
+metadata:
+  name: test-pod-v1-policy
+spec:
+  failurePolicy: Fail
+  matchConstraints:
+    resourceRules:
+    - apiGroups: [""]
+      apiVersions: ["v1"]
+      operations: ["CREATE", "UPDATE"]
+      resources: ["pods"]
+  validations:
+  - expression: "object.metadata.name.size() <= 63"
+---
+apiVersion: admissionregistration.k8s.io/v1
+kind: ValidatingAdmissionPolicyBinding
+metadata:
+  name: test-pod-v1-policy-binding
+spec:
+  policyName: test-pod-v1-policy
+  validationActions: [Deny]
+`
+			err := os.WriteFile(tempFile, []byte(yamlContent), 0o600)
+			Expect(err).NotTo(HaveOccurred())
+
+			parser = NewParser(tempFile)
+		})
+
+		It("should parse the policy and its binding", func() {
+			resources, err := parser.Parse()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(resources.ValidatingAdmissionPolicies).To(HaveLen(2))
+			Expect(resources.WebhookConfigurations).To(BeEmpty())
+			Expect(resources.Other).To(BeEmpty())
+
+			Expect(resources.ValidatingAdmissionPolicies[0].GetKind()).To(Equal("ValidatingAdmissionPolicy"))
+			Expect(resources.ValidatingAdmissionPolicies[1].GetKind()).To(Equal("ValidatingAdmissionPolicyBinding"))
+		})
+	})
+
 	Context("with ServiceMonitor", func() {
 		BeforeEach(func() {
 			yamlContent := `---
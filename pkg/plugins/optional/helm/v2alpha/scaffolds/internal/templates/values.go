@@ -37,6 +37,7 @@ var _ machinery.Template = &HelmValues{}
 type HelmValues struct {
 	machinery.TemplateMixin
 	machinery.ProjectNameMixin
+	machinery.WatchNamespacesMixin
 
 	// Extraction contains all extracted information from parsed resources
 	Extraction *extractor.Extraction
@@ -484,17 +485,31 @@ rbac:
   ## - false (default): ClusterRole/ClusterRoleBinding (all namespaces)
   ## - true: Role/RoleBinding (release namespace only)
   ##
-  namespaced: false
+`)
+	if len(f.WatchNamespaces) > 0 {
+		buf.WriteString(`  ## Keep false: the manager watches a list of namespaces, in which RoleBindings grant its ClusterRole
+  ##
+`)
+	}
+	buf.WriteString(`  namespaced: false
 
 `)
 
 	// Only add roleNamespaces if multi-namespace RBAC is detected
 	if f.Extraction != nil && len(f.Extraction.Features.RoleNamespaces) > 0 {
-		buf.WriteString(`  ## Multi-namespace RBAC role mappings (advanced use)
+		if len(f.WatchNamespaces) > 0 {
+			buf.WriteString(`  ## Namespaces of the RoleBindings of the manager, one for each namespace it watches
+  ## Keep them in sync with the WATCH_NAMESPACE environment variable under manager.env
+  ##
+  roleNamespaces:
+`)
+		} else {
+			buf.WriteString(`  ## Multi-namespace RBAC role mappings (advanced use)
   ## Maps role suffixes to target namespaces for multi-namespace deployments
   ##
   roleNamespaces:
 `)
+		}
 		// Sort keys for deterministic output (avoid nondeterministic map iteration)
 		keys := make([]string, 0, len(f.Extraction.Features.RoleNamespaces))
 		for k := range f.Extraction.Features.RoleNamespaces {
@@ -143,6 +143,31 @@ var _ = Describe("HelmValues", func() {
 				Expect(result).To(ContainSubstring(`"123": "numeric-namespace"`))
 			})
 		})
+
+		Context("when the manager watches a list of namespaces", func() {
+			It("should describe the roleNamespaces as the RoleBindings of the watched namespaces", func() {
+				values := &HelmValues{
+					Extraction: &extractor.Extraction{
+						Features: extractor.FeatureSet{
+							RoleNamespaces: map[string]string{
+								"manager-rolebinding-tenant-a": "tenant-a",
+								"manager-rolebinding-tenant-b": "tenant-b",
+							},
+						},
+					},
+				}
+				values.ProjectName = testProjectName
+				values.WatchNamespaces = []string{"tenant-a", "tenant-b"}
+
+				result := values.generateValues()
+
+				Expect(result).To(ContainSubstring(`"manager-rolebinding-tenant-a": "tenant-a"`))
+				Expect(result).To(ContainSubstring(`"manager-rolebinding-tenant-b": "tenant-b"`))
+				Expect(result).To(ContainSubstring("one for each namespace it watches"))
+				Expect(result).To(ContainSubstring("Keep false: the manager watches a list of namespaces"))
+				Expect(result).NotTo(ContainSubstring("Multi-namespace RBAC role mappings"))
+			})
+		})
 	})
 
 	Describe("Custom ports extraction", func() {
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/kubebuilder/v4/pkg/config"
+	"sigs.k8s.io/kubebuilder/v4/pkg/model/resource"
+)
+
+// LookupResource returns the resource tracked in the project configuration that matches the provided GVK.
+// As the CLI fills the domain with the project one, resources tracked with another domain, such as
+// external or core types, are matched by group, version and kind when there is a single candidate.
+// The API and Webhooks of the returned resource are never nil, like the ones created by the CLI.
+func LookupResource(cfg config.Config, gvk resource.GVK) (resource.Resource, error) {
+	res, err := cfg.GetResource(gvk)
+	if err != nil {
+		resources, errList := cfg.GetResources()
+		if errList != nil {
+			return resource.Resource{}, fmt.Errorf("error getting resources: %w", errList)
+		}
+
+		var matches []resource.Resource
+		for _, r := range resources {
+			if r.Group == gvk.Group && r.Version == gvk.Version && r.Kind == gvk.Kind {
+				matches = append(matches, r)
+			}
+		}
+		if len(matches) != 1 {
+			return resource.Resource{}, fmt.Errorf("unable to find resource %s/%s/%s in the PROJECT file: %w",
+				gvk.Group, gvk.Version, gvk.Kind, err)
+		}
+		res = matches[0]
+	}
+
+	if res.API == nil {
+		res.API = &resource.API{}
+	}
+	if res.Webhooks == nil {
+		res.Webhooks = &resource.Webhooks{}
+	}
+
+	return res, nil
+}
@@ -331,6 +331,136 @@ var _ = Describe("Manager", Ordered, func() {
 			Eventually(verifyCAInjection).Should(Succeed())
 		})
 
+		It("should call the validation webhook for Pod objects", func() {
+			testNamespace := "e2e-validating-pod"
+			By("creating a namespace for the Pod sent to the validation webhook")
+			cmd := exec.Command("kubectl", "create", "ns", testNamespace)
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create namespace")
+			DeferCleanup(func() {
+				cmd := exec.Command("kubectl", "delete", "ns", testNamespace, "--ignore-not-found")
+				_, _ = utils.Run(cmd)
+			})
+
+			By("writing the manifest of the Pod")
+			manifest := filepath.Join("/tmp", testNamespace+".yaml")
+			err = os.WriteFile(manifest, []byte(`apiVersion: v1
+kind: Pod
+metadata:
+  name: webhook-test
+spec:
+  containers:
+  - name: test
+    image: busybox
+`), os.FileMode(0o644))
+			Expect(err).NotTo(HaveOccurred(), "Failed to write the Pod manifest")
+
+			By("creating the Pod with a server-side dry run, which calls the webhook without persisting it")
+			verifyWebhookCalled := func(g Gomega) {
+				// The webhook may reject the object: only check that it was called
+				cmd := exec.Command("kubectl", "create", "--dry-run=server", "-n", testNamespace, "-f", manifest)
+				_, _ = utils.Run(cmd)
+
+				cmd = exec.Command("kubectl", "logs", controllerPodName, "-n", namespace)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("Validation for Pod upon creation"))
+			}
+			Eventually(verifyWebhookCalled).Should(Succeed())
+		})
+
+		It("should call the defaulting webhook for Deployment objects", func() {
+			testNamespace := "e2e-mutating-deployment"
+			By("creating a namespace for the Deployment sent to the defaulting webhook")
+			cmd := exec.Command("kubectl", "create", "ns", testNamespace)
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create namespace")
+			DeferCleanup(func() {
+				cmd := exec.Command("kubectl", "delete", "ns", testNamespace, "--ignore-not-found")
+				_, _ = utils.Run(cmd)
+			})
+
+			By("writing the manifest of the Deployment")
+			manifest := filepath.Join("/tmp", testNamespace+".yaml")
+			err = os.WriteFile(manifest, []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: webhook-test
+spec:
+  selector:
+    matchLabels:
+      app: webhook-test
+  template:
+    metadata:
+      labels:
+        app: webhook-test
+    spec:
+      containers:
+      - name: test
+        image: busybox
+`), os.FileMode(0o644))
+			Expect(err).NotTo(HaveOccurred(), "Failed to write the Deployment manifest")
+
+			By("creating the Deployment with a server-side dry run, which calls the webhook without persisting it")
+			verifyWebhookCalled := func(g Gomega) {
+				// The webhook may reject the object: only check that it was called
+				cmd := exec.Command("kubectl", "create", "--dry-run=server", "-n", testNamespace, "-f", manifest)
+				_, _ = utils.Run(cmd)
+
+				cmd = exec.Command("kubectl", "logs", controllerPodName, "-n", namespace)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("Defaulting for Deployment"))
+			}
+			Eventually(verifyWebhookCalled).Should(Succeed())
+		})
+
+		It("should call the validation webhook for Deployment objects", func() {
+			testNamespace := "e2e-validating-deployment"
+			By("creating a namespace for the Deployment sent to the validation webhook")
+			cmd := exec.Command("kubectl", "create", "ns", testNamespace)
+			_, err := utils.Run(cmd)
+			Expect(err).NotTo(HaveOccurred(), "Failed to create namespace")
+			DeferCleanup(func() {
+				cmd := exec.Command("kubectl", "delete", "ns", testNamespace, "--ignore-not-found")
+				_, _ = utils.Run(cmd)
+			})
+
+			By("writing the manifest of the Deployment")
+			manifest := filepath.Join("/tmp", testNamespace+".yaml")
+			err = os.WriteFile(manifest, []byte(`apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: webhook-test
+spec:
+  selector:
+    matchLabels:
+      app: webhook-test
+  template:
+    metadata:
+      labels:
+        app: webhook-test
+    spec:
+      containers:
+      - name: test
+        image: busybox
+`), os.FileMode(0o644))
+			Expect(err).NotTo(HaveOccurred(), "Failed to write the Deployment manifest")
+
+			By("creating the Deployment with a server-side dry run, which calls the webhook without persisting it")
+			verifyWebhookCalled := func(g Gomega) {
+				// The webhook may reject the object: only check that it was called
+				cmd := exec.Command("kubectl", "create", "--dry-run=server", "-n", testNamespace, "-f", manifest)
+				_, _ = utils.Run(cmd)
+
+				cmd = exec.Command("kubectl", "logs", controllerPodName, "-n", namespace)
+				output, err := utils.Run(cmd)
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(output).To(ContainSubstring("Validation for Deployment upon creation"))
+			}
+			Eventually(verifyWebhookCalled).Should(Succeed())
+		})
+
 		It("should have CA injection for Wordpress conversion webhook", func() {
 			By("checking CA injection for Wordpress conversion webhook")
 			verifyCAInjection := func(g Gomega) {